@@ -3,12 +3,22 @@ package internal
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"io"
 
+	"github.com/TrueCloudLab/frostfs-api-go/v2/acl"
+	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/refs"
+	rpcapi "github.com/TrueCloudLab/frostfs-api-go/v2/rpc"
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	sessionV2 "github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/signature"
+	deletesvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/delete"
 	"github.com/TrueCloudLab/frostfs-sdk-go/accounting"
 	"github.com/TrueCloudLab/frostfs-sdk-go/client"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/eacl"
@@ -487,6 +497,144 @@ func DeleteObject(prm DeleteObjectPrm) (*DeleteObjectRes, error) {
 	}, nil
 }
 
+// DeleteObjectsPrm groups parameters of DeleteObjects operation.
+type DeleteObjectsPrm struct {
+	DeleteObjectPrm
+
+	key *ecdsa.PrivateKey
+
+	members []oid.ID
+}
+
+// SetPrivateKey sets the key to sign the request with.
+func (x *DeleteObjectsPrm) SetPrivateKey(key *ecdsa.PrivateKey) {
+	x.key = key
+}
+
+// SetMembers sets the objects to be removed by the same tombstone
+// as the object set by SetAddress.
+func (x *DeleteObjectsPrm) SetMembers(ids []oid.ID) {
+	x.members = ids
+}
+
+// DeleteObjectsRes groups the resulting values of DeleteObjects operation.
+type DeleteObjectsRes struct {
+	DeleteObjectRes
+
+	skipped []deletesvc.SkippedObject
+}
+
+// Skipped returns the requested objects that are not removed
+// with the reasons.
+func (x DeleteObjectsRes) Skipped() []deletesvc.SkippedObject {
+	return x.skipped
+}
+
+// DeleteObjects marks several objects from the same container to be removed
+// from FrostFS through a single tombstone placement.
+//
+// Returns any error which prevented the operation from completing correctly in error return.
+func DeleteObjects(prm DeleteObjectsPrm) (*DeleteObjectsRes, error) {
+	var addrV2 refs.Address
+	prm.objAddr.WriteToV2(&addrV2)
+
+	body := new(objectV2.DeleteRequestBody)
+	body.SetAddress(&addrV2)
+
+	xs := prm.xHeaders
+	if len(prm.members) != 0 {
+		xs = append(xs[:len(xs):len(xs)], deletesvc.XHeaderMembers, deletesvc.FormatMembers(prm.members))
+	}
+
+	xhdrs := make([]sessionV2.XHeader, len(xs)/2)
+	for i := range xhdrs {
+		xhdrs[i].SetKey(xs[2*i])
+		xhdrs[i].SetValue(xs[2*i+1])
+	}
+
+	var ver refs.Version
+	version.Current().WriteToV2(&ver)
+
+	meta := new(sessionV2.RequestMetaHeader)
+	meta.SetVersion(&ver)
+	meta.SetTTL(2)
+	meta.SetXHeaders(xhdrs)
+
+	if prm.sessionToken != nil {
+		var tok sessionV2.Token
+		prm.sessionToken.WriteToV2(&tok)
+
+		meta.SetSessionToken(&tok)
+	}
+
+	if prm.bearerToken != nil {
+		var tok acl.BearerToken
+		prm.bearerToken.WriteToV2(&tok)
+
+		meta.SetBearerToken(&tok)
+	}
+
+	req := new(objectV2.DeleteRequest)
+	req.SetBody(body)
+	req.SetMetaHeader(meta)
+
+	err := signature.SignServiceMessage(prm.key, req)
+	if err != nil {
+		return nil, fmt.Errorf("sign request: %w", err)
+	}
+
+	var resp *objectV2.DeleteResponse
+
+	err = prm.cli.ExecRaw(func(c *rawclient.Client) error {
+		resp, err = rpcapi.DeleteObject(c, req)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("remove objects via client: %w", err)
+	}
+
+	err = signature.VerifyServiceMessage(resp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response signature: %w", err)
+	}
+
+	err = apistatus.ErrFromStatus(apistatus.FromStatusV2(resp.GetMetaHeader().GetStatus()))
+	if err != nil {
+		return nil, fmt.Errorf("remove objects via client: %w", err)
+	}
+
+	idTomb := resp.GetBody().GetTombstone().GetObjectID()
+	if idTomb == nil {
+		return nil, errors.New("missing tombstone in response")
+	}
+
+	var res DeleteObjectsRes
+
+	err = res.tomb.ReadFromV2(*idTomb)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tombstone in response: %w", err)
+	}
+
+	// the header is set by the serving node, so it
+	// may be wrapped as origin by the intermediate ones
+	for meta := resp.GetMetaHeader(); meta != nil; meta = meta.GetOrigin() {
+		for _, x := range meta.GetXHeaders() {
+			if x.GetKey() != deletesvc.XHeaderSkipped {
+				continue
+			}
+
+			res.skipped, err = deletesvc.ParseSkipped(x.GetValue())
+			if err != nil {
+				return nil, fmt.Errorf("invalid skipped objects in response: %w", err)
+			}
+
+			return &res, nil
+		}
+	}
+
+	return &res, nil
+}
+
 // GetObjectPrm groups parameters of GetObject operation.
 type GetObjectPrm struct {
 	commonObjectPrm
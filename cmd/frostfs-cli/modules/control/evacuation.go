@@ -0,0 +1,197 @@
+package control
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+)
+
+var evacuationShardCmd = &cobra.Command{
+	Use:   "evacuation",
+	Short: "Objects evacuation from shard",
+	Long:  "Objects evacuation from shard to other shards in the background",
+}
+
+var startEvacuationShardCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start evacuate objects from shard",
+	Long: "Start evacuate objects from shard to other shards in the background. " +
+		"Interrupted evacuation is resumed from the last checkpoint.",
+	Run: startEvacuateShard,
+}
+
+var getEvacuationShardStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get evacuate objects from shard status",
+	Long:  "Get evacuate objects from shard to other shards status",
+	Run:   getEvacuateShardStatus,
+}
+
+var stopEvacuationShardCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop running evacuate process",
+	Long:  "Stop running evacuate process from shard to other shards, progress is kept to be resumed later",
+	Run:   stopEvacuateShardStatus,
+}
+
+func startEvacuateShard(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.StartShardEvacuationRequest{Body: new(control.StartShardEvacuationRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+	req.Body.IgnoreErrors, _ = cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StartShardEvacuationResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.StartShardEvacuation(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "Start evacuate shards failed, rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard evacuation has been successfully started.")
+}
+
+func getEvacuateShardStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.GetShardEvacuationStatusRequest{Body: new(control.GetShardEvacuationStatusRequest_Body)}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetShardEvacuationStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.GetShardEvacuationStatus(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "Get evacuate shards status failed, rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	printStatus(cmd, resp)
+}
+
+func stopEvacuateShardStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.StopShardEvacuationRequest{Body: new(control.StopShardEvacuationRequest_Body)}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StopShardEvacuationResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.StopShardEvacuation(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "Stop evacuate shards failed, rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Evacuation stopped.")
+}
+
+func printStatus(cmd *cobra.Command, resp *control.GetShardEvacuationStatusResponse) {
+	body := resp.GetBody()
+	if body.GetStatus() == control.GetShardEvacuationStatusResponse_Body_EVACUATE_SHARD_STATUS_UNDEFINED {
+		cmd.Println("There is no running or completed evacuation.")
+		return
+	}
+
+	sb := &strings.Builder{}
+	sb.WriteString(fmt.Sprintf("Shard IDs: %s. Status: %s. Evacuated %d of %d objects (%d bytes), %d skipped, %d failed.",
+		evacuationShardIDs(body),
+		evacuationStatus(body),
+		body.GetEvacuatedObjects(),
+		body.GetTotalObjects(),
+		body.GetEvacuatedBytes(),
+		body.GetSkippedObjects(),
+		body.GetFailedObjects(),
+	))
+	appendStartedAt(sb, body)
+	appendDuration(sb, "Duration", body.GetDuration())
+	appendDuration(sb, "Estimated time left", body.GetEta())
+	appendError(sb, body)
+
+	cmd.Println(sb.String())
+}
+
+func evacuationShardIDs(body *control.GetShardEvacuationStatusResponse_Body) string {
+	ids := make([]string, 0, len(body.GetShard_ID()))
+	for _, id := range body.GetShard_ID() {
+		ids = append(ids, base58.Encode(id))
+	}
+	return strings.Join(ids, ", ")
+}
+
+func evacuationStatus(body *control.GetShardEvacuationStatusResponse_Body) string {
+	switch body.GetStatus() {
+	case control.GetShardEvacuationStatusResponse_Body_COMPLETED:
+		return "completed"
+	case control.GetShardEvacuationStatusResponse_Body_RUNNING:
+		return "running"
+	default:
+		return "undefined"
+	}
+}
+
+func appendStartedAt(sb *strings.Builder, body *control.GetShardEvacuationStatusResponse_Body) {
+	if body.GetStartedAt() != nil {
+		sb.WriteString(" Started at: ")
+		sb.WriteString(time.Unix(body.GetStartedAt().GetValue(), 0).UTC().Format(time.RFC3339))
+		sb.WriteString(" UTC.")
+	}
+}
+
+func appendDuration(sb *strings.Builder, name string, d *control.GetShardEvacuationStatusResponse_Body_Duration) {
+	if d != nil {
+		sb.WriteString(fmt.Sprintf(" %s: %s.", name, time.Duration(d.GetSeconds())*time.Second))
+	}
+}
+
+func appendError(sb *strings.Builder, body *control.GetShardEvacuationStatusResponse_Body) {
+	if len(body.GetErrorMessage()) > 0 {
+		sb.WriteString(" Error: ")
+		sb.WriteString(body.GetErrorMessage())
+		sb.WriteString(".")
+	}
+}
+
+func initControlEvacuationShardCmd() {
+	evacuationShardCmd.AddCommand(startEvacuationShardCmd)
+	evacuationShardCmd.AddCommand(getEvacuationShardStatusCmd)
+	evacuationShardCmd.AddCommand(stopEvacuationShardCmd)
+
+	initControlStartEvacuationShardCmd()
+	initControlFlags(getEvacuationShardStatusCmd)
+	initControlFlags(stopEvacuationShardCmd)
+}
+
+func initControlStartEvacuationShardCmd() {
+	initControlFlags(startEvacuationShardCmd)
+
+	flags := startEvacuationShardCmd.Flags()
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	flags.Bool(shardAllFlag, false, "Process all shards")
+	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+
+	startEvacuationShardCmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
+}
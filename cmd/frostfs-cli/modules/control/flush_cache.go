@@ -15,11 +15,14 @@ var flushCacheCmd = &cobra.Command{
 	Run:   flushCache,
 }
 
+const flushCacheDisableFlag = "disable"
+
 func flushCache(cmd *cobra.Command, _ []string) {
 	pk := key.Get(cmd)
 
 	req := &control.FlushCacheRequest{Body: new(control.FlushCacheRequest_Body)}
 	req.Body.Shard_ID = getShardIDList(cmd)
+	req.Body.Disable, _ = cmd.Flags().GetBool(flushCacheDisableFlag)
 
 	signRequest(cmd, pk, req)
 
@@ -35,7 +38,11 @@ func flushCache(cmd *cobra.Command, _ []string) {
 
 	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
 
-	cmd.Println("Write-cache has been flushed.")
+	if req.Body.Disable {
+		cmd.Println("Write-cache has been flushed and disabled until the shard mode is set to read-write.")
+	} else {
+		cmd.Println("Write-cache has been flushed.")
+	}
 }
 
 func initControlFlushCacheCmd() {
@@ -44,6 +51,7 @@ func initControlFlushCacheCmd() {
 	ff := flushCacheCmd.Flags()
 	ff.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
 	ff.Bool(shardAllFlag, false, "Process all shards")
+	ff.Bool(flushCacheDisableFlag, false, "Disable write-cache after the flush until the shard mode is set to read-write")
 
 	flushCacheCmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
 }
@@ -0,0 +1,135 @@
+package control
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+)
+
+const rebuildFillPercentFlag = "fill-percent"
+
+var rebuildShardCmd = &cobra.Command{
+	Use:   "rebuild",
+	Short: "Rebuild blobovniczas of the shard",
+	Long: "Move objects out of the sparse blobovniczas and the ones not matching " +
+		"the current configuration, then remove them to free disk space",
+}
+
+var startRebuildShardCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start blobovniczas rebuild",
+	Long:  "Start blobovniczas rebuild in the background",
+	Run:   startRebuildShard,
+}
+
+var getRebuildShardStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get blobovniczas rebuild status",
+	Long:  "Get the status of the last started blobovniczas rebuild",
+	Run:   getRebuildShardStatus,
+}
+
+func startRebuildShard(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	fillPercent, _ := cmd.Flags().GetUint32(rebuildFillPercentFlag)
+	if fillPercent > 100 {
+		commonCmd.ExitOnErr(cmd, "", fmt.Errorf("--%s must not exceed 100", rebuildFillPercentFlag))
+	}
+
+	req := &control.StartShardRebuildRequest{Body: new(control.StartShardRebuildRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+	req.Body.FillPercent = fillPercent
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StartShardRebuildResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.StartShardRebuild(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "Start shards rebuild failed, rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard rebuild has been successfully started.")
+}
+
+func getRebuildShardStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.GetShardRebuildStatusRequest{Body: new(control.GetShardRebuildStatusRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetShardRebuildStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.GetShardRebuildStatus(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "Get shards rebuild status failed, rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	for _, st := range resp.GetBody().GetResults() {
+		printRebuildStatus(cmd, st)
+	}
+}
+
+func printRebuildStatus(cmd *cobra.Command, st *control.GetShardRebuildStatusResponse_Body_Status) {
+	cmd.Printf("Shard %s: ", base58.Encode(st.GetShard_ID()))
+
+	switch {
+	case st.GetStartedAt() == 0:
+		cmd.Println("rebuild was not started.")
+		return
+	case st.GetRunning():
+		cmd.Printf("running, started at %s UTC.\n", formatUnix(st.GetStartedAt()))
+		return
+	}
+
+	cmd.Printf("completed at %s UTC, started at %s UTC. Moved %d objects, removed %d blobovniczas.",
+		formatUnix(st.GetFinishedAt()),
+		formatUnix(st.GetStartedAt()),
+		st.GetObjectsMoved(),
+		st.GetFilesRemoved())
+	if msg := st.GetErrorMessage(); msg != "" {
+		cmd.Printf(" Error: %s.", msg)
+	}
+	cmd.Println()
+}
+
+func formatUnix(ts int64) string {
+	return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+}
+
+func initControlRebuildShardCmd() {
+	rebuildShardCmd.AddCommand(startRebuildShardCmd)
+	rebuildShardCmd.AddCommand(getRebuildShardStatusCmd)
+
+	for _, cmd := range []*cobra.Command{startRebuildShardCmd, getRebuildShardStatusCmd} {
+		initControlFlags(cmd)
+
+		flags := cmd.Flags()
+		flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+		flags.Bool(shardAllFlag, false, "Process all shards")
+
+		cmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
+	}
+
+	startRebuildShardCmd.Flags().Uint32(rebuildFillPercentFlag, 0,
+		"Rebuild blobovniczas with less live data percentage, 0 rebuilds only blobovniczas not matching the configuration")
+}
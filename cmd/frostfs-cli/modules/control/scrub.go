@@ -0,0 +1,162 @@
+package control
+
+import (
+	"github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+)
+
+const scrubRateFlag = "rate"
+
+var scrubShardCmd = &cobra.Command{
+	Use:   "scrub",
+	Short: "Check integrity of the objects stored in the shard",
+	Long: "Read all the objects stored in the shard, verify their payload checksums and " +
+		"signatures against the metabase, and mark corrupted objects for removal " +
+		"so that they are replicated again from the healthy nodes",
+}
+
+var startScrubShardCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start objects integrity check",
+	Long:  "Start objects integrity check in the background",
+	Run:   startScrubShard,
+}
+
+var stopScrubShardCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop objects integrity check",
+	Long:  "Stop the running objects integrity check",
+	Run:   stopScrubShard,
+}
+
+var getScrubShardStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get objects integrity check status",
+	Long:  "Get the status of the last started objects integrity check",
+	Run:   getScrubShardStatus,
+}
+
+func startScrubShard(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	rate, _ := cmd.Flags().GetUint32(scrubRateFlag)
+
+	req := &control.StartShardScrubRequest{Body: new(control.StartShardScrubRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+	req.Body.RateLimit = rate
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StartShardScrubResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.StartShardScrub(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "Start shards scrub failed, rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard scrub has been successfully started.")
+}
+
+func stopScrubShard(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.StopShardScrubRequest{Body: new(control.StopShardScrubRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StopShardScrubResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.StopShardScrub(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "Stop shards scrub failed, rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard scrub has been successfully stopped.")
+}
+
+func getScrubShardStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.GetShardScrubStatusRequest{Body: new(control.GetShardScrubStatusRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetShardScrubStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.GetShardScrubStatus(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "Get shards scrub status failed, rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	for _, st := range resp.GetBody().GetResults() {
+		printScrubStatus(cmd, st)
+	}
+}
+
+func printScrubStatus(cmd *cobra.Command, st *control.GetShardScrubStatusResponse_Body_Status) {
+	cmd.Printf("Shard %s: ", base58.Encode(st.GetShard_ID()))
+
+	switch {
+	case st.GetStartedAt() == 0:
+		cmd.Println("scrub was not started.")
+		return
+	case st.GetRunning():
+		cmd.Printf("running, started at %s UTC. Checked %d objects, found %d corrupted.\n",
+			formatUnix(st.GetStartedAt()),
+			st.GetObjectsChecked(),
+			st.GetObjectsCorrupted())
+	default:
+		cmd.Printf("completed at %s UTC, started at %s UTC. Checked %d objects, found %d corrupted.",
+			formatUnix(st.GetFinishedAt()),
+			formatUnix(st.GetStartedAt()),
+			st.GetObjectsChecked(),
+			st.GetObjectsCorrupted())
+		if msg := st.GetErrorMessage(); msg != "" {
+			cmd.Printf(" Error: %s.", msg)
+		}
+		cmd.Println()
+	}
+
+	for _, addr := range st.GetCorruptedObjects() {
+		cmd.Printf("  corrupted: %s\n", addr)
+	}
+}
+
+func initControlScrubShardCmd() {
+	scrubShardCmd.AddCommand(startScrubShardCmd)
+	scrubShardCmd.AddCommand(stopScrubShardCmd)
+	scrubShardCmd.AddCommand(getScrubShardStatusCmd)
+
+	for _, cmd := range []*cobra.Command{startScrubShardCmd, stopScrubShardCmd, getScrubShardStatusCmd} {
+		initControlFlags(cmd)
+
+		flags := cmd.Flags()
+		flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+		flags.Bool(shardAllFlag, false, "Process all shards")
+
+		cmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
+	}
+
+	startScrubShardCmd.Flags().Uint32(scrubRateFlag, 100, "Maximum number of objects checked per second on each shard, 0 means no limit")
+}
@@ -17,6 +17,12 @@ func initControlShardsCmd() {
 	shardsCmd.AddCommand(restoreShardCmd)
 	shardsCmd.AddCommand(evacuateShardCmd)
 	shardsCmd.AddCommand(flushCacheCmd)
+	shardsCmd.AddCommand(evacuationShardCmd)
+	shardsCmd.AddCommand(rebuildShardCmd)
+	shardsCmd.AddCommand(scrubShardCmd)
+	shardsCmd.AddCommand(addShardCmd)
+	shardsCmd.AddCommand(removeShardCmd)
+	shardsCmd.AddCommand(gcShardCmd)
 
 	initControlShardsListCmd()
 	initControlSetShardModeCmd()
@@ -24,4 +30,10 @@ func initControlShardsCmd() {
 	initControlRestoreShardCmd()
 	initControlEvacuateShardCmd()
 	initControlFlushCacheCmd()
+	initControlEvacuationShardCmd()
+	initControlRebuildShardCmd()
+	initControlScrubShardCmd()
+	initControlAddShardCmd()
+	initControlRemoveShardCmd()
+	initControlGCShardCmd()
 }
@@ -0,0 +1,58 @@
+package control
+
+import (
+	"os"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+)
+
+const addShardFilepathFlag = "path"
+
+var addShardCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a shard to the running node",
+	Long: "Create a shard from the configuration section in YAML format, attach it to the running node " +
+		"and persist the configuration change. The section has the same format as a single element " +
+		"of the storage.shard section of the node configuration",
+	Run: addShard,
+}
+
+func addShard(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	p, _ := cmd.Flags().GetString(addShardFilepathFlag)
+	data, err := os.ReadFile(p)
+	commonCmd.ExitOnErr(cmd, "can't read shard configuration: %w", err)
+
+	req := &control.AddShardRequest{Body: new(control.AddShardRequest_Body)}
+	req.Body.Config = data
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.AddShardResponse
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.AddShard(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Printf("Shard %s has been successfully added.\n", base58.Encode(resp.GetBody().GetShard_ID()))
+}
+
+func initControlAddShardCmd() {
+	initControlFlags(addShardCmd)
+
+	flags := addShardCmd.Flags()
+	flags.String(addShardFilepathFlag, "", "Path to the shard configuration file in YAML format")
+
+	_ = addShardCmd.MarkFlagRequired(addShardFilepathFlag)
+}
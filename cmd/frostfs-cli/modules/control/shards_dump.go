@@ -11,12 +11,14 @@ import (
 const (
 	dumpFilepathFlag     = "path"
 	dumpIgnoreErrorsFlag = "no-errors"
+	dumpCompressFlag     = "compress"
+	dumpBaseFlag         = "base"
 )
 
 var dumpShardCmd = &cobra.Command{
 	Use:   "dump",
 	Short: "Dump objects from shard",
-	Long:  "Dump objects from shard to a file",
+	Long:  "Dump objects from shard to a file. If the metabase is available, graveyard, garbage marks and locks are dumped too and the shard may stay writable.",
 	Run:   dumpShard,
 }
 
@@ -32,6 +34,12 @@ func dumpShard(cmd *cobra.Command, _ []string) {
 	ignore, _ := cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
 	body.SetIgnoreErrors(ignore)
 
+	compress, _ := cmd.Flags().GetBool(dumpCompressFlag)
+	body.SetCompress(compress)
+
+	base, _ := cmd.Flags().GetString(dumpBaseFlag)
+	body.SetBasePath(base)
+
 	req := new(control.DumpShardRequest)
 	req.SetBody(body)
 
@@ -50,6 +58,7 @@ func dumpShard(cmd *cobra.Command, _ []string) {
 	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
 
 	cmd.Println("Shard has been dumped successfully.")
+	cmd.Printf("Objects: %d, checkpoint: %d\n", resp.GetBody().GetCount(), resp.GetBody().GetCheckpoint())
 }
 
 func initControlDumpShardCmd() {
@@ -59,6 +68,8 @@ func initControlDumpShardCmd() {
 	flags.String(shardIDFlag, "", "Shard ID in base58 encoding")
 	flags.String(dumpFilepathFlag, "", "File to write objects to")
 	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	flags.Bool(dumpCompressFlag, false, "Compress the dump with zstd")
+	flags.String(dumpBaseFlag, "", "Previous dump of the shard, only the objects put after it are dumped")
 
 	_ = dumpShardCmd.MarkFlagRequired(shardIDFlag)
 	_ = dumpShardCmd.MarkFlagRequired(dumpFilepathFlag)
@@ -0,0 +1,147 @@
+package control
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const (
+	gcIntervalFlag  = "interval"
+	gcBatchSizeFlag = "batch-size"
+)
+
+var gcShardCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Control the shard garbage collector",
+	Long: "Control the shard garbage collector. The garbage collector state " +
+		"is printed by the `shards list` command",
+}
+
+var runGCShardCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Start full garbage collection pass",
+	Long: "Start full garbage collection pass in the background: handle the objects " +
+		"expired at the current epoch and remove all the objects marked as garbage. " +
+		"The pass is performed even if the garbage collection is paused",
+	Run: runGCShard,
+}
+
+var pauseGCShardCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Pause garbage collection",
+	Long:  "Pause periodic garbage removal and handling of the expired objects",
+	Run:   pauseGCShard,
+}
+
+var resumeGCShardCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume garbage collection",
+	Long:  "Resume periodic garbage removal and handling of the expired objects",
+	Run:   resumeGCShard,
+}
+
+var setGCShardCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Change garbage collector parameters",
+	Long: "Change garbage collector parameters. The values are not persisted " +
+		"and are reset to the configured ones on the shard reinitialization",
+	Run: setGCShardParams,
+}
+
+func runGCShard(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.RunShardGCRequest{Body: new(control.RunShardGCRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.RunShardGCResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.RunShardGC(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "Run shards garbage collection failed, rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard garbage collection has been successfully started.")
+}
+
+func pauseGCShard(cmd *cobra.Command, _ []string) {
+	sendGCShardParams(cmd, &control.SetShardGCParamsRequest_Body{Pause: true})
+}
+
+func resumeGCShard(cmd *cobra.Command, _ []string) {
+	sendGCShardParams(cmd, &control.SetShardGCParamsRequest_Body{Resume: true})
+}
+
+func setGCShardParams(cmd *cobra.Command, _ []string) {
+	interval, _ := cmd.Flags().GetDuration(gcIntervalFlag)
+	if interval < 0 {
+		commonCmd.ExitOnErr(cmd, "", errors.New("interval must not be negative"))
+	}
+
+	body := new(control.SetShardGCParamsRequest_Body)
+	body.Interval = uint64(interval.Milliseconds())
+	body.BatchSize, _ = cmd.Flags().GetUint32(gcBatchSizeFlag)
+
+	if body.Interval == 0 && body.BatchSize == 0 {
+		commonCmd.ExitOnErr(cmd, "", fmt.Errorf("either --%s or --%s flag must be provided", gcIntervalFlag, gcBatchSizeFlag))
+	}
+
+	sendGCShardParams(cmd, body)
+}
+
+func sendGCShardParams(cmd *cobra.Command, body *control.SetShardGCParamsRequest_Body) {
+	pk := key.Get(cmd)
+
+	req := &control.SetShardGCParamsRequest{Body: body}
+	req.Body.Shard_ID = getShardIDList(cmd)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.SetShardGCParamsResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.SetShardGCParams(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "Set shards garbage collector parameters failed, rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard garbage collector has been successfully updated.")
+}
+
+func initControlGCShardCmd() {
+	gcShardCmd.AddCommand(runGCShardCmd)
+	gcShardCmd.AddCommand(pauseGCShardCmd)
+	gcShardCmd.AddCommand(resumeGCShardCmd)
+	gcShardCmd.AddCommand(setGCShardCmd)
+
+	for _, cmd := range []*cobra.Command{runGCShardCmd, pauseGCShardCmd, resumeGCShardCmd, setGCShardCmd} {
+		initControlFlags(cmd)
+
+		flags := cmd.Flags()
+		flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+		flags.Bool(shardAllFlag, false, "Process all shards")
+
+		cmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
+	}
+
+	flags := setGCShardCmd.Flags()
+	flags.Duration(gcIntervalFlag, 0, "Interval between the garbage removal runs, 0 leaves it unchanged")
+	flags.Uint32(gcBatchSizeFlag, 0, "Maximum number of objects removed in a single run, 0 leaves it unchanged")
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
@@ -54,6 +55,7 @@ func listShards(cmd *cobra.Command, _ []string) {
 		prettyPrintShardsJSON(cmd, resp.GetBody().GetShards())
 	} else {
 		prettyPrintShards(cmd, resp.GetBody().GetShards())
+		prettyPrintQuotas(cmd, resp.GetBody().GetQuotas())
 	}
 }
 
@@ -67,6 +69,7 @@ func prettyPrintShardsJSON(cmd *cobra.Command, ii []*control.ShardInfo) {
 			"blobstor":    i.GetBlobstor(),
 			"writecache":  i.GetWritecachePath(),
 			"error_count": i.GetErrorCount(),
+			"gc":          gcStatusToJSON(i.GetGc()),
 		})
 	}
 
@@ -100,13 +103,81 @@ func prettyPrintShards(cmd *cobra.Command, ii []*control.ShardInfo) {
 			sb.String()+
 			pathPrinter("Write-cache", i.GetWritecachePath())+
 			pathPrinter("Pilorama", i.GetPiloramaPath())+
-			fmt.Sprintf("Error count: %d\n", i.GetErrorCount()),
+			fmt.Sprintf("Error count: %d\n", i.GetErrorCount())+
+			gcStatusToString(i.GetGc()),
 			base58.Encode(i.Shard_ID),
 			shardModeToString(i.GetMode()),
 		)
 	}
 }
 
+func gcStatusToJSON(st *control.GCStatus) map[string]any {
+	if st == nil {
+		return nil
+	}
+
+	return map[string]any{
+		"paused":             st.GetPaused(),
+		"interval":           (time.Duration(st.GetInterval()) * time.Millisecond).String(),
+		"batch_size":         st.GetBatchSize(),
+		"queue_length":       st.GetQueueLength(),
+		"removed":            st.GetRemoved(),
+		"expired_objects":    st.GetExpiredObjects(),
+		"expired_locks":      st.GetExpiredLocks(),
+		"expired_tombstones": st.GetExpiredTombstones(),
+		"last_run":           st.GetLastRun(),
+	}
+}
+
+func gcStatusToString(st *control.GCStatus) string {
+	if st == nil {
+		return ""
+	}
+
+	state := "active"
+	if st.GetPaused() {
+		state = "paused"
+	}
+
+	lastRun := "never"
+	if st.GetLastRun() != 0 {
+		lastRun = formatUnix(st.GetLastRun()) + " UTC"
+	}
+
+	return fmt.Sprintf("GC: %s, interval %s, batch size %d\n"+
+		"\tQueue length: %d\n"+
+		"\tRemoved: %d\n"+
+		"\tExpired objects: %d, locks: %d, tombstones: %d\n"+
+		"\tLast run: %s\n",
+		state, time.Duration(st.GetInterval())*time.Millisecond, st.GetBatchSize(),
+		st.GetQueueLength(),
+		st.GetRemoved(),
+		st.GetExpiredObjects(), st.GetExpiredLocks(), st.GetExpiredTombstones(),
+		lastRun)
+}
+
+func prettyPrintQuotas(cmd *cobra.Command, qq []*control.QuotaInfo) {
+	for _, q := range qq {
+		limitPrinter := func(name string, v uint64) string {
+			if v == 0 {
+				return ""
+			}
+
+			return fmt.Sprintf("%s: %d\n", name, v)
+		}
+
+		if len(q.GetContainer_ID()) != 0 {
+			cmd.Printf("Container quota %s:\n", base58.Encode(q.GetContainer_ID()))
+		} else {
+			cmd.Printf("Owner quota %s:\n", base58.Encode(q.GetOwner_ID()))
+		}
+
+		cmd.Print(limitPrinter("Soft limit", q.GetSoftLimit()) +
+			limitPrinter("Hard limit", q.GetHardLimit()) +
+			fmt.Sprintf("Size: %d\n", q.GetSize()))
+	}
+}
+
 func shardModeToString(m control.ShardMode) string {
 	strMode, ok := lookUpShardModeString(m)
 	if ok {
@@ -0,0 +1,59 @@
+package control
+
+import (
+	"github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const removeShardEvacuateFlag = "evacuate"
+
+var removeShardCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Remove shards from the running node",
+	Long: "Detach shards from the running node and persist the configuration change. " +
+		"Objects can be evacuated to the other shards or nodes before the removal",
+	Run: removeShard,
+}
+
+func removeShard(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.RemoveShardRequest{Body: new(control.RemoveShardRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+	req.Body.Evacuate, _ = cmd.Flags().GetBool(removeShardEvacuateFlag)
+	req.Body.IgnoreErrors, _ = cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.RemoveShardResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.RemoveShard(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	if req.Body.Evacuate {
+		cmd.Printf("Objects moved: %d\n", resp.GetBody().GetEvacuated())
+	}
+
+	cmd.Println("Shards have been successfully removed.")
+}
+
+func initControlRemoveShardCmd() {
+	initControlFlags(removeShardCmd)
+
+	flags := removeShardCmd.Flags()
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	flags.Bool(removeShardEvacuateFlag, false, "Evacuate objects from the shards before the removal")
+	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects during the evacuation")
+
+	_ = removeShardCmd.MarkFlagRequired(shardIDFlag)
+}
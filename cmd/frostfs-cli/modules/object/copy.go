@@ -0,0 +1,90 @@
+package object
+
+import (
+	internalclient "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	copysvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/copy"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	"github.com/spf13/cobra"
+)
+
+const (
+	destCIDFlag           = "dest-cid"
+	rewriteAttributesFlag = "rewrite-attributes"
+)
+
+var objectCopyCmd = &cobra.Command{
+	Use:   "copy",
+	Short: "Copy object within or between containers",
+	Long: `Copy object within or between containers.
+The payload is copied by the storage node without transferring it through the client.
+The copy keeps the attributes of the source object replaced by the given ones
+or has only the given attributes if --rewrite-attributes is set.`,
+	Run: copyObject,
+}
+
+func initObjectCopyCmd() {
+	commonflags.Init(objectCopyCmd)
+	initFlagSession(objectCopyCmd, "PUT")
+
+	flags := objectCopyCmd.Flags()
+
+	flags.String(commonflags.CIDFlag, "", "Source container ID")
+	_ = objectCopyCmd.MarkFlagRequired(commonflags.CIDFlag)
+
+	flags.String(commonflags.OIDFlag, "", "Source object ID")
+	_ = objectCopyCmd.MarkFlagRequired(commonflags.OIDFlag)
+
+	flags.String(destCIDFlag, "", "Destination container ID, source container if not set")
+	flags.String("attributes", "", "User attributes in form of Key1=Value1,Key2=Value2")
+	flags.Bool(rewriteAttributesFlag, false, "Do not keep attributes of the source object")
+}
+
+func copyObject(cmd *cobra.Command, _ []string) {
+	var srcCnr cid.ID
+	var srcObj oid.ID
+
+	src := readObjectAddress(cmd, &srcCnr, &srcObj)
+
+	cnr := srcCnr
+	if destVal, _ := cmd.Flags().GetString(destCIDFlag); destVal != "" {
+		err := cnr.DecodeString(destVal)
+		commonCmd.ExitOnErr(cmd, "decode destination container ID string: %w", err)
+	}
+
+	attrs, err := parseAttributes(cmd.Flag("attributes").Value.String(), 0)
+	commonCmd.ExitOnErr(cmd, "can't parse object attributes: %w", err)
+
+	pk := key.GetOrGenerate(cmd)
+
+	var ownerID user.ID
+	user.IDFromKey(&ownerID, pk.PublicKey)
+
+	hdr := object.New()
+	hdr.SetContainerID(cnr)
+	hdr.SetOwnerID(&ownerID)
+	hdr.SetAttributes(attrs...)
+
+	var prm internalclient.PutObjectPrm
+	ReadOrOpenSession(cmd, &prm, pk, cnr, nil)
+	Prepare(cmd, &prm)
+	prm.SetHeader(hdr)
+
+	xs := append(parseXHeaders(cmd), copysvc.XHeaderSource, src.EncodeToString())
+	if rewrite, _ := cmd.Flags().GetBool(rewriteAttributesFlag); rewrite {
+		xs = append(xs, copysvc.XHeaderRewriteAttributes, "true")
+	}
+
+	prm.SetXHeaders(xs)
+
+	res, err := internalclient.PutObject(prm)
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	cmd.Println("Object copied successfully.")
+	cmd.Printf("  OID: %s\n  CID: %s\n", res.ID(), cnr)
+}
@@ -7,17 +7,25 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
 	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	deletesvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/delete"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/spf13/cobra"
 )
 
+const (
+	deleteFiltersFlag   = "filters"
+	deleteBatchSizeFlag = "batch-size"
+)
+
 var objectDelCmd = &cobra.Command{
 	Use:     "delete",
 	Aliases: []string{"del"},
 	Short:   "Delete object from FrostFS",
-	Long:    "Delete object from FrostFS",
-	Run:     deleteObject,
+	Long: `Delete object from FrostFS.
+Several objects set by repeated --oid flag or matching the --filters are removed
+in batches of --batch-size objects, each batch is removed by a single tombstone.`,
+	Run: deleteObject,
 }
 
 func initObjectDeleteCmd() {
@@ -27,7 +35,9 @@ func initObjectDeleteCmd() {
 	flags := objectDelCmd.Flags()
 
 	flags.String(commonflags.CIDFlag, "", commonflags.CIDFlagUsage)
-	flags.String(commonflags.OIDFlag, "", commonflags.OIDFlagUsage)
+	flags.StringSlice(commonflags.OIDFlag, nil, "Object ID, repeated to remove several objects")
+	flags.StringSlice(deleteFiltersFlag, nil, "Remove user objects matching repeated filter expressions or files with protobuf JSON, see 'object search'")
+	flags.Uint(deleteBatchSizeFlag, 1000, "Maximum number of objects removed by a single tombstone")
 	flags.Bool(binaryFlag, false, "Deserialize object structure from given file.")
 	flags.String(fileFlag, "", "File with object payload")
 }
@@ -50,12 +60,29 @@ func deleteObject(cmd *cobra.Command, _ []string) {
 			commonCmd.ExitOnErr(cmd, "", fmt.Errorf("required flag \"%s\" not set", commonflags.CIDFlag))
 		}
 
-		oidVal, _ := cmd.Flags().GetString(commonflags.OIDFlag)
-		if oidVal == "" {
-			commonCmd.ExitOnErr(cmd, "", fmt.Errorf("required flag \"%s\" not set", commonflags.OIDFlag))
+		readCID(cmd, &cnr)
+
+		oidVals, _ := cmd.Flags().GetStringSlice(commonflags.OIDFlag)
+		filters, _ := cmd.Flags().GetStringSlice(deleteFiltersFlag)
+
+		if len(oidVals) == 0 && len(filters) == 0 {
+			commonCmd.ExitOnErr(cmd, "", fmt.Errorf("either \"%s\" or \"%s\" flag must be set", commonflags.OIDFlag, deleteFiltersFlag))
+		}
+
+		ids := make([]oid.ID, len(oidVals))
+		for i := range oidVals {
+			err := ids[i].DecodeString(oidVals[i])
+			commonCmd.ExitOnErr(cmd, fmt.Sprintf("decode object ID string #%d: %%w", i+1), err)
+		}
+
+		if len(ids) != 1 || len(filters) != 0 {
+			deleteObjects(cmd, cnr, ids, filters)
+			return
 		}
 
-		objAddr = readObjectAddress(cmd, &cnr, &obj)
+		obj = ids[0]
+		objAddr.SetContainer(cnr)
+		objAddr.SetObject(obj)
 	}
 
 	pk := key.GetOrGenerate(cmd)
@@ -73,3 +100,89 @@ func deleteObject(cmd *cobra.Command, _ []string) {
 	cmd.Println("Object removed successfully.")
 	cmd.Printf("  ID: %s\n  CID: %s\n", tomb, cnr)
 }
+
+// deleteObjects removes the objects and the user objects matching the filters
+// in batches and prints the outcome for each object.
+func deleteObjects(cmd *cobra.Command, cnr cid.ID, ids []oid.ID, filters []string) {
+	batchSize, _ := cmd.Flags().GetUint(deleteBatchSizeFlag)
+	if batchSize == 0 || batchSize > deletesvc.MaxMembers+1 {
+		commonCmd.ExitOnErr(cmd, "", fmt.Errorf("\"%s\" must be in range [1, %d]", deleteBatchSizeFlag, deletesvc.MaxMembers+1))
+	}
+
+	pk := key.GetOrGenerate(cmd)
+	cli := internalclient.GetSDKClientByFlag(cmd, pk, commonflags.RPC)
+
+	if len(filters) != 0 {
+		fs, err := parseFilterExpressions(filters)
+		commonCmd.ExitOnErr(cmd, "", err)
+
+		fs.AddRootFilter()
+
+		var searchPrm internalclient.SearchObjectsPrm
+		searchPrm.SetClient(cli)
+		Prepare(cmd, &searchPrm)
+		searchPrm.SetContainerID(cnr)
+		searchPrm.SetFilters(fs)
+
+		res, err := internalclient.SearchObjects(searchPrm)
+		commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+		ids = append(ids, res.IDList()...)
+	}
+
+	if len(ids) == 0 {
+		cmd.Println("No objects to remove.")
+		return
+	}
+
+	// session is opened for the whole container and reused by all batches
+	var prm internalclient.DeleteObjectsPrm
+	ReadOrOpenSessionViaClient(cmd, &prm, cli, pk, cnr, nil)
+	Prepare(cmd, &prm)
+	prm.SetPrivateKey(pk)
+
+	var removed int
+
+	for start := 0; start < len(ids); start += int(batchSize) {
+		end := start + int(batchSize)
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		batch := ids[start:end]
+
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(batch[0])
+
+		prm.SetAddress(addr)
+		prm.SetMembers(batch[1:])
+
+		res, err := internalclient.DeleteObjects(prm)
+		if err != nil {
+			for i := range batch {
+				cmd.Printf("%s: not removed: %v\n", batch[i], err)
+			}
+
+			continue
+		}
+
+		skipped := make(map[oid.ID]string, len(res.Skipped()))
+		for _, obj := range res.Skipped() {
+			skipped[obj.ID] = obj.Reason
+		}
+
+		for i := range batch {
+			if reason, ok := skipped[batch[i]]; ok {
+				cmd.Printf("%s: not removed (%s)\n", batch[i], reason)
+			} else {
+				removed++
+				cmd.Printf("%s: removed\n", batch[i])
+			}
+		}
+
+		cmd.Printf("Tombstone: %s\n", res.Tombstone())
+	}
+
+	cmd.Printf("Removed %d of %d objects.\n", removed, len(ids))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
 	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	"github.com/TrueCloudLab/frostfs-sdk-go/user"
@@ -25,6 +26,7 @@ import (
 const (
 	noProgressFlag   = "no-progress"
 	notificationFlag = "notify"
+	uploadIDFlag     = "upload-id"
 )
 
 var putExpiredOn uint64
@@ -56,6 +58,7 @@ func initObjectPutCmd() {
 
 	flags.String(notificationFlag, "", "Object notification in the form of *epoch*:*topic*; '-' topic means using default")
 	flags.Bool(binaryFlag, false, "Deserialize object structure from given file.")
+	flags.String(uploadIDFlag, "", "Identifier of the resumable upload, the interrupted upload is continued by the command with the same identifier")
 }
 
 func putObject(cmd *cobra.Command, _ []string) {
@@ -65,6 +68,11 @@ func putObject(cmd *cobra.Command, _ []string) {
 	if !binary && cidVal == "" {
 		commonCmd.ExitOnErr(cmd, "", fmt.Errorf("required flag \"%s\" not set", commonflags.CIDFlag))
 	}
+	uploadID, _ := cmd.Flags().GetString(uploadIDFlag)
+	if binary && uploadID != "" {
+		commonCmd.ExitOnErr(cmd, "", fmt.Errorf("flag \"%s\" is not supported for the binary objects", uploadIDFlag))
+	}
+
 	pk := key.GetOrGenerate(cmd)
 
 	var ownerID user.ID
@@ -132,7 +140,16 @@ func putObject(cmd *cobra.Command, _ []string) {
 	Prepare(cmd, &prm)
 	prm.SetHeader(obj)
 
-	var p *pb.ProgressBar
+	var (
+		p         *pb.ProgressBar
+		pStarted  bool
+		startProg = func(*object.Object) {
+			if !pStarted {
+				pStarted = true
+				p.Start()
+			}
+		}
+	)
 
 	noProgress, _ := cmd.Flags().GetBool(noProgressFlag)
 	if noProgress {
@@ -142,7 +159,7 @@ func putObject(cmd *cobra.Command, _ []string) {
 			p = pb.New(len(obj.Payload()))
 			p.Output = cmd.OutOrStdout()
 			prm.SetPayloadReader(p.NewProxyReader(payloadReader))
-			prm.SetHeaderCallback(func(o *object.Object) { p.Start() })
+			prm.SetHeaderCallback(startProg)
 		} else {
 			fi, err := f.Stat()
 			if err != nil {
@@ -152,14 +169,47 @@ func putObject(cmd *cobra.Command, _ []string) {
 				p = pb.New64(fi.Size())
 				p.Output = cmd.OutOrStdout()
 				prm.SetPayloadReader(p.NewProxyReader(f))
-				prm.SetHeaderCallback(func(o *object.Object) {
-					p.Start()
-				})
+				prm.SetHeaderCallback(startProg)
 			}
 		}
 	}
 
-	res, err := internalclient.PutObject(prm)
+	var (
+		res    *internalclient.PutObjectRes
+		offset uint64
+	)
+
+	for {
+		if uploadID != "" {
+			prm.SetXHeaders(append(parseXHeaders(cmd),
+				putsvc.XHeaderUploadID, uploadID,
+				putsvc.XHeaderUploadOffset, strconv.FormatUint(offset, 10),
+			))
+		}
+
+		res, err = internalclient.PutObject(prm)
+		if err == nil || uploadID == "" {
+			break
+		}
+
+		acked, ok := putsvc.UploadOffset(err)
+		if !ok || acked == offset {
+			break
+		}
+
+		// the node has already stored the payload up to the acknowledged offset
+		_, err = f.Seek(int64(acked), io.SeekStart)
+		commonCmd.ExitOnErr(cmd, "can't seek payload file: %w", err)
+
+		cmd.Printf("Resuming upload %s from the payload offset %d\n", uploadID, acked)
+
+		if p != nil {
+			p.Set64(int64(acked))
+		}
+
+		offset = acked
+	}
+
 	if p != nil {
 		p.Finish()
 	}
@@ -170,21 +220,9 @@ func putObject(cmd *cobra.Command, _ []string) {
 }
 
 func parseObjectAttrs(cmd *cobra.Command) ([]object.Attribute, error) {
-	var rawAttrs []string
-
-	raw := cmd.Flag("attributes").Value.String()
-	if len(raw) != 0 {
-		rawAttrs = strings.Split(raw, ",")
-	}
-
-	attrs := make([]object.Attribute, len(rawAttrs), len(rawAttrs)+2) // name + timestamp attributes
-	for i := range rawAttrs {
-		k, v, found := strings.Cut(rawAttrs[i], "=")
-		if !found {
-			return nil, fmt.Errorf("invalid attribute format: %s", rawAttrs[i])
-		}
-		attrs[i].SetKey(k)
-		attrs[i].SetValue(v)
+	attrs, err := parseAttributes(cmd.Flag("attributes").Value.String(), 2) // name + timestamp attributes
+	if err != nil {
+		return nil, err
 	}
 
 	disableFilename, _ := cmd.Flags().GetBool("disable-filename")
@@ -207,6 +245,28 @@ func parseObjectAttrs(cmd *cobra.Command) ([]object.Attribute, error) {
 	return attrs, nil
 }
 
+// parseAttributes parses attributes in form of Key1=Value1,Key2=Value2
+// reserving the capacity for the extra ones.
+func parseAttributes(raw string, extra int) ([]object.Attribute, error) {
+	var rawAttrs []string
+
+	if len(raw) != 0 {
+		rawAttrs = strings.Split(raw, ",")
+	}
+
+	attrs := make([]object.Attribute, len(rawAttrs), len(rawAttrs)+extra)
+	for i := range rawAttrs {
+		k, v, found := strings.Cut(rawAttrs[i], "=")
+		if !found {
+			return nil, fmt.Errorf("invalid attribute format: %s", rawAttrs[i])
+		}
+		attrs[i].SetKey(k)
+		attrs[i].SetValue(v)
+	}
+
+	return attrs, nil
+}
+
 func parseObjectNotifications(cmd *cobra.Command) (*object.NotificationInfo, error) {
 	const (
 		separator       = ":"
@@ -27,7 +27,8 @@ func init() {
 		objectHeadCmd,
 		objectHashCmd,
 		objectRangeCmd,
-		objectLockCmd}
+		objectLockCmd,
+		objectCopyCmd}
 
 	Cmd.AddCommand(objectChildCommands...)
 
@@ -44,4 +45,5 @@ func init() {
 	initObjectHashCmd()
 	initObjectRangeCmd()
 	initCommandObjectLock()
+	initObjectCopyCmd()
 }
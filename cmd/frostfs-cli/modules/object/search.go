@@ -3,18 +3,25 @@ package object
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	internalclient "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/client"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
 	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	searchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/search"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oidSDK "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/spf13/cobra"
 )
 
+const (
+	searchLimitFlag  = "limit"
+	searchCursorFlag = "cursor"
+)
+
 var (
 	searchFilters []string
 
@@ -41,6 +48,8 @@ func initObjectSearchCmd() {
 	flags.Bool("root", false, "Search for user objects")
 	flags.Bool("phy", false, "Search physically stored objects")
 	flags.String(commonflags.OIDFlag, "", "Search object by identifier")
+	flags.Uint32(searchLimitFlag, 0, "Maximum number of objects to return in the ascending order of their identifiers, 0 means no limit")
+	flags.String(searchCursorFlag, "", "Cursor printed by the previous search with the limit to get the next objects")
 }
 
 func searchObject(cmd *cobra.Command, _ []string) {
@@ -61,6 +70,15 @@ func searchObject(cmd *cobra.Command, _ []string) {
 	prm.SetContainerID(cnr)
 	prm.SetFilters(sf)
 
+	limit, _ := cmd.Flags().GetUint32(searchLimitFlag)
+	if limit > 0 {
+		xs := append(parseXHeaders(cmd), searchsvc.XHeaderLimit, strconv.FormatUint(uint64(limit), 10))
+		if cursor, _ := cmd.Flags().GetString(searchCursorFlag); cursor != "" {
+			xs = append(xs, searchsvc.XHeaderCursor, cursor)
+		}
+		prm.SetXHeaders(xs)
+	}
+
 	res, err := internalclient.SearchObjects(prm)
 	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
 
@@ -70,6 +88,10 @@ func searchObject(cmd *cobra.Command, _ []string) {
 	for i := range ids {
 		cmd.Println(ids[i].String())
 	}
+
+	if cursor := searchsvc.NextCursor(ids, int(limit)); cursor != "" {
+		cmd.Printf("Next cursor: %s\n", cursor)
+	}
 }
 
 var searchUnaryOpVocabulary = map[string]object.SearchMatchType{
@@ -83,10 +105,40 @@ var searchBinaryOpVocabulary = map[string]object.SearchMatchType{
 }
 
 func parseSearchFilters(cmd *cobra.Command) (object.SearchFilters, error) {
+	fs, err := parseFilterExpressions(searchFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	root, _ := cmd.Flags().GetBool("root")
+	if root {
+		fs.AddRootFilter()
+	}
+
+	phy, _ := cmd.Flags().GetBool("phy")
+	if phy {
+		fs.AddPhyFilter()
+	}
+
+	oid, _ := cmd.Flags().GetString(commonflags.OIDFlag)
+	if oid != "" {
+		var id oidSDK.ID
+		if err := id.DecodeString(oid); err != nil {
+			return nil, fmt.Errorf("could not parse object ID: %w", err)
+		}
+
+		fs.AddObjectIDFilter(object.MatchStringEqual, id)
+	}
+
+	return fs, nil
+}
+
+// parseFilterExpressions parses filter expressions or files with protobuf JSON.
+func parseFilterExpressions(exprs []string) (object.SearchFilters, error) {
 	var fs object.SearchFilters
 
-	for i := range searchFilters {
-		words := strings.Fields(searchFilters[i])
+	for i := range exprs {
+		words := strings.Fields(exprs[i])
 
 		switch len(words) {
 		default:
@@ -121,25 +173,5 @@ func parseSearchFilters(cmd *cobra.Command) (object.SearchFilters, error) {
 		}
 	}
 
-	root, _ := cmd.Flags().GetBool("root")
-	if root {
-		fs.AddRootFilter()
-	}
-
-	phy, _ := cmd.Flags().GetBool("phy")
-	if phy {
-		fs.AddPhyFilter()
-	}
-
-	oid, _ := cmd.Flags().GetString(commonflags.OIDFlag)
-	if oid != "" {
-		var id oidSDK.ID
-		if err := id.DecodeString(oid); err != nil {
-			return nil, fmt.Errorf("could not parse object ID: %w", err)
-		}
-
-		fs.AddObjectIDFilter(object.MatchStringEqual, id)
-	}
-
 	return fs, nil
 }
@@ -0,0 +1,69 @@
+package meta
+
+import (
+	"time"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	"github.com/spf13/cobra"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+const flagDryRun = "dry-run"
+
+var vDryRun bool
+
+var migrateCMD = &cobra.Command{
+	Use:   "migrate",
+	Short: "Metabase schema migration",
+	Long: `Upgrade metabase to the version supported by this binary.
+With --dry-run only the list of required migration steps is printed and the metabase is not modified.`,
+	Run: migrateFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(migrateCMD, &vPath)
+	migrateCMD.Flags().BoolVar(&vDryRun, flagDryRun, false, "Print migration steps without applying them")
+}
+
+func migrateFunc(cmd *cobra.Command, _ []string) {
+	var db *meta.DB
+	if vDryRun {
+		db = openMeta(cmd)
+	} else {
+		l, err := zap.NewDevelopment()
+		common.ExitOnErr(cmd, common.Errf("could not create logger: %w", err))
+
+		db = meta.New(
+			meta.WithPath(vPath),
+			meta.WithBoltDBOptions(&bbolt.Options{
+				Timeout: 100 * time.Millisecond,
+			}),
+			meta.WithEpochState(epochState{}),
+			meta.WithLogger(&logger.Logger{Logger: l}),
+		)
+		common.ExitOnErr(cmd, common.Errf("could not open metabase: %w", db.Open(false)))
+	}
+	defer db.Close()
+
+	steps, err := db.MigrationPlan()
+	common.ExitOnErr(cmd, common.Errf("could not plan migration: %w", err))
+
+	if len(steps) == 0 {
+		cmd.Println("Metabase is up to date.")
+		return
+	}
+
+	for _, s := range steps {
+		cmd.Printf("%d -> %d: %s\n", s.From, s.To, s.Description)
+	}
+
+	if vDryRun {
+		return
+	}
+
+	common.ExitOnErr(cmd, common.Errf("could not migrate metabase: %w", db.Migrate()))
+	cmd.Println("Metabase has been migrated successfully.")
+}
@@ -1,9 +1,12 @@
 package writecache
 
 import (
+	"errors"
+
 	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/spf13/cobra"
 )
 
@@ -21,11 +24,17 @@ func init() {
 }
 
 func inspectFunc(cmd *cobra.Command, _ []string) {
-	db := openWC(cmd)
-	defer db.Close()
+	var data []byte
+	if writecache.IsLog(vPath) {
+		data = getFromLog(cmd)
+	} else {
+		db := openWC(cmd)
+		defer db.Close()
 
-	data, err := writecache.Get(db, []byte(vAddress))
-	common.ExitOnErr(cmd, common.Errf("could not fetch object: %w", err))
+		var err error
+		data, err = writecache.Get(db, []byte(vAddress))
+		common.ExitOnErr(cmd, common.Errf("could not fetch object: %w", err))
+	}
 
 	var o object.Object
 	common.ExitOnErr(cmd, common.Errf("could not unmarshal object: %w", o.Unmarshal(data)))
@@ -33,3 +42,26 @@ func inspectFunc(cmd *cobra.Command, _ []string) {
 	common.PrintObjectHeader(cmd, o)
 	common.WriteObjectToFile(cmd, vOut, data)
 }
+
+var errFound = errors.New("found")
+
+func getFromLog(cmd *cobra.Command) []byte {
+	var addr oid.Address
+	common.ExitOnErr(cmd, common.Errf("invalid address argument: %w", addr.DecodeString(vAddress)))
+
+	var data []byte
+	err := writecache.IterateLog(vPath, func(a oid.Address, d []byte) error {
+		if a == addr {
+			data = d
+			return errFound
+		}
+		return nil
+	})
+	if !errors.Is(err, errFound) {
+		if err == nil {
+			err = errors.New("object not found")
+		}
+		common.ExitOnErr(cmd, common.Errf("could not fetch object: %w", err))
+	}
+	return data
+}
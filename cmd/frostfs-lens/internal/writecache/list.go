@@ -30,6 +30,14 @@ func listFunc(cmd *cobra.Command, _ []string) {
 		return err
 	}
 
+	if writecache.IsLog(vPath) {
+		err := writecache.IterateLog(vPath, func(addr oid.Address, _ []byte) error {
+			return wAddr(addr)
+		})
+		common.ExitOnErr(cmd, common.Errf("write-cache iterator failure: %w", err))
+		return
+	}
+
 	db := openWC(cmd)
 	defer db.Close()
 
@@ -31,6 +31,7 @@ import (
 	netmapCore "github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
@@ -59,8 +60,10 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/state"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/user"
 	"github.com/TrueCloudLab/frostfs-sdk-go/version"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
@@ -94,9 +97,11 @@ type applicationConfiguration struct {
 	}
 
 	EngineCfg struct {
-		errorThreshold uint32
-		shardPoolSize  uint32
-		shards         []shardCfg
+		errorThreshold  uint32
+		shardPoolSize   uint32
+		shards          []shardCfg
+		containerQuotas map[cid.ID]engine.QuotaLimits
+		ownerQuotas     map[string]engine.QuotaLimits
 	}
 }
 
@@ -104,6 +109,8 @@ type shardCfg struct {
 	compress                  bool
 	smallSizeObjectLimit      uint64
 	uncompressableContentType []string
+	compressionCodec          string
+	compressionRules          []compression.Rule
 	refillMetabase            bool
 	mode                      shardmode.Mode
 
@@ -121,8 +128,17 @@ type shardCfg struct {
 		removerSleepInterval time.Duration
 	}
 
+	tieringCfg struct {
+		coldAfter     time.Duration
+		interval      time.Duration
+		maxObjectSize uint64
+	}
+
+	ioLimits map[shard.IOClass]shard.IOLimits
+
 	writecacheCfg struct {
 		enabled          bool
+		typ              writecache.Type
 		path             string
 		maxBatchSize     int
 		maxBatchDelay    time.Duration
@@ -131,6 +147,9 @@ type shardCfg struct {
 		flushWorkerCount int
 		sizeLimit        uint64
 		noSync           bool
+
+		backpressureThreshold float64
+		backpressureMaxDelay  time.Duration
 	}
 
 	piloramaCfg struct {
@@ -182,13 +201,24 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 		if err != nil {
 			return fmt.Errorf("configuration's validation: %w", err)
 		}
+	}
+
+	// parse into a separate value, so a failed reread
+	// keeps the current configuration untouched
+	var next applicationConfiguration
 
-		// clear if it is rereading
-		*a = applicationConfiguration{}
+	err := next.parseConfig(c)
+	if err != nil {
+		return err
 	}
 
-	a._read = true
+	next._read = true
+	*a = next
 
+	return nil
+}
+
+func (a *applicationConfiguration) parseConfig(c *config.Config) error {
 	// Logger
 
 	a.LoggerCfg.level = loggerconfig.Level(c)
@@ -197,6 +227,8 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 
 	a.EngineCfg.errorThreshold = engineconfig.ShardErrorThreshold(c)
 	a.EngineCfg.shardPoolSize = engineconfig.ShardPoolSize(c)
+	a.EngineCfg.containerQuotas = engineconfig.ContainerQuotas(c)
+	a.EngineCfg.ownerQuotas = engineconfig.OwnerQuotas(c)
 
 	return engineconfig.IterateShards(c, false, func(sc *shardconfig.Config) error {
 		var sh shardCfg
@@ -205,6 +237,8 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 		sh.mode = sc.Mode()
 		sh.compress = sc.Compress()
 		sh.uncompressableContentType = sc.UncompressableContentTypes()
+		sh.compressionCodec = sc.CompressionCodec()
+		sh.compressionRules = sc.CompressionRules()
 		sh.smallSizeObjectLimit = sc.SmallSizeLimit()
 
 		// write-cache
@@ -214,6 +248,7 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 			wc := &sh.writecacheCfg
 
 			wc.enabled = true
+			wc.typ = writecache.Type(writeCacheCfg.Type())
 			wc.path = writeCacheCfg.Path()
 			wc.maxBatchSize = writeCacheCfg.BoltDB().MaxBatchSize()
 			wc.maxBatchDelay = writeCacheCfg.BoltDB().MaxBatchDelay()
@@ -222,6 +257,8 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 			wc.flushWorkerCount = writeCacheCfg.WorkersNumber()
 			wc.sizeLimit = writeCacheCfg.SizeLimit()
 			wc.noSync = writeCacheCfg.NoSync()
+			wc.backpressureThreshold = writeCacheCfg.BackpressureThreshold()
+			wc.backpressureMaxDelay = writeCacheCfg.BackpressureMaxDelay()
 		}
 
 		// blobstor with substorages
@@ -230,6 +267,7 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 		storagesCfg := blobStorCfg.Storages()
 		metabaseCfg := sc.Metabase()
 		gcCfg := sc.GC()
+		tieringCfg := sc.Tiering()
 
 		if config.BoolSafe(c.Sub("tree"), "enabled") {
 			piloramaCfg := sc.Pilorama()
@@ -286,6 +324,23 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 		sh.gcCfg.removerBatchSize = gcCfg.RemoverBatchSize()
 		sh.gcCfg.removerSleepInterval = gcCfg.RemoverSleepInterval()
 
+		// tiering
+
+		sh.tieringCfg.coldAfter = tieringCfg.ColdAfter()
+		sh.tieringCfg.interval = tieringCfg.Interval()
+		sh.tieringCfg.maxObjectSize = tieringCfg.MaxObjectSize()
+
+		// I/O limits
+
+		ioLimitsCfg := sc.IOLimits()
+		sh.ioLimits = make(map[shard.IOClass]shard.IOLimits)
+		for _, class := range []shard.IOClass{shard.IOClassClient, shard.IOClassReplication, shard.IOClassBackground} {
+			sh.ioLimits[class] = shard.IOLimits{
+				IOPS:      ioLimitsCfg.IOPS(class.String()),
+				Bandwidth: ioLimitsCfg.Bandwidth(class.String()),
+			}
+		}
+
 		a.EngineCfg.shards = append(a.EngineCfg.shards, sh)
 
 		return nil
@@ -314,6 +369,10 @@ type internals struct {
 	healthStatus *atomic.Int32
 	// is node under maintenance
 	isMaintenance atomic.Bool
+
+	// serializes configuration rereads and the
+	// runtime changes that depend on them
+	reloadMtx sync.Mutex
 }
 
 // starts node's maintenance.
@@ -489,6 +548,12 @@ type cfgNotifications struct {
 
 type cfgLocalStorage struct {
 	localStorage *engine.StorageEngine
+
+	quotas *quotaSource
+
+	objectCache *getsvc.ObjectCache
+
+	tombstoneSource *tombstone.ExpirationChecker
 }
 
 type cfgObjectRoutines struct {
@@ -613,6 +678,7 @@ func initCfg(appCfg *config.Config) *cfg {
 		pool:              initObjectPool(appCfg),
 		tombstoneLifetime: objectconfig.TombstoneLifetime(appCfg),
 	}
+	c.cfgObject.cfgLocalStorage.quotas = newQuotaSource(c)
 	c.cfgReputation = cfgReputation{
 		scriptHash: contractsconfig.Reputation(appCfg),
 		workerPool: reputationWorkerPool,
@@ -637,6 +703,7 @@ func (c *cfg) engineOpts() []engine.Option {
 	opts = append(opts,
 		engine.WithShardPoolSize(c.EngineCfg.shardPoolSize),
 		engine.WithErrorThreshold(c.EngineCfg.errorThreshold),
+		engine.WithQuotaSource(c.cfgObject.cfgLocalStorage.quotas),
 
 		engine.WithLogger(c.log),
 	)
@@ -645,6 +712,12 @@ func (c *cfg) engineOpts() []engine.Option {
 		opts = append(opts, engine.WithMetrics(c.metricsCollector))
 	}
 
+	if cache := c.cfgObject.cfgLocalStorage.objectCache; cache != nil {
+		opts = append(opts, engine.WithRemovedObjectsCallback(func(addrs []oid.Address) {
+			cache.Invalidate(addrs...)
+		}))
+	}
+
 	return opts
 }
 
@@ -660,6 +733,7 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 		var writeCacheOpts []writecache.Option
 		if wcRead := shCfg.writecacheCfg; wcRead.enabled {
 			writeCacheOpts = append(writeCacheOpts,
+				writecache.WithType(wcRead.typ),
 				writecache.WithPath(wcRead.path),
 				writecache.WithMaxBatchSize(wcRead.maxBatchSize),
 				writecache.WithMaxBatchDelay(wcRead.maxBatchDelay),
@@ -668,6 +742,7 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 				writecache.WithFlushWorkersCount(wcRead.flushWorkerCount),
 				writecache.WithMaxCacheSize(wcRead.sizeLimit),
 				writecache.WithNoSync(wcRead.noSync),
+				writecache.WithBackpressure(wcRead.backpressureThreshold, wcRead.backpressureMaxDelay),
 				writecache.WithLogger(c.log),
 			)
 		}
@@ -727,6 +802,8 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 			shard.WithBlobStorOptions(
 				blobstor.WithCompressObjects(shCfg.compress),
 				blobstor.WithUncompressableContentTypes(shCfg.uncompressableContentType),
+				blobstor.WithCompressionCodec(shCfg.compressionCodec),
+				blobstor.WithCompressionRules(shCfg.compressionRules),
 				blobstor.WithStorages(ss),
 
 				blobstor.WithLogger(c.log),
@@ -742,12 +819,14 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 
 				meta.WithLogger(c.log),
 				meta.WithEpochState(c.cfgNetmap.state),
+				meta.WithIndexSource(indexSource{cfg: c}),
 			),
 			shard.WithPiloramaOptions(piloramaOpts...),
 			shard.WithWriteCache(shCfg.writecacheCfg.enabled),
 			shard.WithWriteCacheOptions(writeCacheOpts...),
 			shard.WithRemoverBatchSize(shCfg.gcCfg.removerBatchSize),
 			shard.WithGCRemoverSleepInterval(shCfg.gcCfg.removerSleepInterval),
+			shard.WithTiering(shCfg.tieringCfg.coldAfter, shCfg.tieringCfg.interval, shCfg.tieringCfg.maxObjectSize),
 			shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
 				pool, err := ants.NewPool(sz)
 				fatalOnErr(err)
@@ -756,6 +835,10 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 			}),
 		}
 
+		for class, limits := range shCfg.ioLimits {
+			sh.shOpts = append(sh.shOpts, shard.WithIOLimits(class, limits))
+		}
+
 		shards = append(shards, sh)
 	}
 
@@ -783,6 +866,8 @@ func (c *cfg) LocalAddress() network.AddressGroup {
 }
 
 func initLocalStorage(c *cfg) {
+	c.cfgObject.cfgLocalStorage.objectCache = newObjectCache(c)
+
 	ls := engine.New(c.engineOpts()...)
 
 	addNewEpochAsyncNotificationHandler(c, func(ev event.Event) {
@@ -817,6 +902,7 @@ func initLocalStorage(c *cfg) {
 	}
 
 	c.cfgObject.cfgLocalStorage.localStorage = ls
+	c.cfgObject.cfgLocalStorage.tombstoneSource = tombstoneSource
 
 	c.onShutdown(func() {
 		c.log.Info("closing components of the storage engine...")
@@ -963,6 +1049,9 @@ func (c *cfg) signalWatcher() {
 func (c *cfg) reloadConfig() {
 	c.log.Info("SIGHUP has been received, rereading configuration...")
 
+	c.reloadMtx.Lock()
+	defer c.reloadMtx.Unlock()
+
 	err := c.readConfig(c.appCfg)
 	if err != nil {
 		c.log.Error("configuration reading", zap.Error(err))
@@ -993,6 +1082,9 @@ func (c *cfg) reloadConfig() {
 	if cmp, updated := pprofComponent(c); updated {
 		components = append(components, dCmp{cmp.name, cmp.reload})
 	}
+	components = append(components, dCmp{"tracing", func() error {
+		return reloadTracing(c)
+	}})
 
 	// Storage Engine
 
@@ -1007,6 +1099,8 @@ func (c *cfg) reloadConfig() {
 		return
 	}
 
+	c.cfgObject.cfgLocalStorage.quotas.update(c.EngineCfg.containerQuotas, c.EngineCfg.ownerQuotas)
+
 	for _, component := range components {
 		err = component.reloadFunc()
 		if err != nil {
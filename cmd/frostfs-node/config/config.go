@@ -84,3 +84,10 @@ func (x *Config) Reload() error {
 
 	return nil
 }
+
+// ConfigDir returns the path to the configuration directory provided to New.
+//
+// Returns empty string if the directory was not provided.
+func (x *Config) ConfigDir() string {
+	return x.opts.configDir
+}
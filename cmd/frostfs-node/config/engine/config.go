@@ -2,11 +2,15 @@ package engineconfig
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
 	shardconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
 )
 
 const (
@@ -24,18 +28,42 @@ var ErrNoShardConfigured = errors.New("no shard configured")
 // wrap them into shardconfig.Config and passes to f.
 //
 // Section names are expected to be consecutive integer numbers, starting from 0.
+// Sections of the disabled shards are skipped.
 //
 // Panics if N is not a positive number while shards are required.
 func IterateShards(c *config.Config, required bool, f func(*shardconfig.Config) error) error {
+	alive := 0
+	err := IterateShardSections(c, func(_ int, sc *shardconfig.Config) error {
+		if sc.Mode() == mode.Disabled {
+			return nil
+		}
+
+		if err := f(sc); err != nil {
+			return err
+		}
+		alive++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if alive == 0 && required {
+		return ErrNoShardConfigured
+	}
+	return nil
+}
+
+// IterateShardSections iterates over all subsections of "shard" subsection of "storage"
+// section of c including the disabled ones, wrap them into shardconfig.Config and
+// passes them to f along with the section indexes.
+func IterateShardSections(c *config.Config, f func(int, *shardconfig.Config) error) error {
 	c = c.Sub(subsection)
 
 	c = c.Sub("shard")
 	def := c.Sub("default")
 
-	alive := 0
-	i := uint64(0)
-	for ; ; i++ {
-		si := strconv.FormatUint(i, 10)
+	for i := 0; ; i++ {
+		si := strconv.Itoa(i)
 
 		sc := shardconfig.From(
 			c.Sub(si),
@@ -46,23 +74,14 @@ func IterateShards(c *config.Config, required bool, f func(*shardconfig.Config)
 		// At the same time checking for "blobstor" section doesn't work proper
 		// with configuration via the environment.
 		if (*config.Config)(sc).Value("metabase.path") == nil {
-			break
+			return nil
 		}
 		(*config.Config)(sc).SetDefault(def)
 
-		if sc.Mode() == mode.Disabled {
-			continue
-		}
-
-		if err := f(sc); err != nil {
+		if err := f(i, sc); err != nil {
 			return err
 		}
-		alive++
 	}
-	if alive == 0 && required {
-		return ErrNoShardConfigured
-	}
-	return nil
 }
 
 // ShardPoolSize returns the value of "shard_pool_size" config parameter from "storage" section.
@@ -83,3 +102,59 @@ func ShardPoolSize(c *config.Config) uint32 {
 func ShardErrorThreshold(c *config.Config) uint32 {
 	return config.Uint32Safe(c.Sub(subsection), "shard_ro_error_threshold")
 }
+
+// ContainerQuotas returns the value of "containers" list from "quota" subsection
+// of "storage" section. Each element is a subsection with "id", "soft_limit"
+// and "hard_limit" parameters. Elements are read until an element without ID is met.
+//
+// Panics if the container ID is invalid.
+func ContainerQuotas(c *config.Config) map[cid.ID]engine.QuotaLimits {
+	res := make(map[cid.ID]engine.QuotaLimits)
+
+	iterateQuotas(c, "containers", func(i int, id string, l engine.QuotaLimits) {
+		var cnr cid.ID
+		if err := cnr.DecodeString(id); err != nil {
+			panic(fmt.Errorf("invalid container ID in quota #%d: %w", i, err))
+		}
+		res[cnr] = l
+	})
+
+	return res
+}
+
+// OwnerQuotas returns the value of "owners" list from "quota" subsection
+// of "storage" section keyed by the owner address. Each element is
+// a subsection with "id", "soft_limit" and "hard_limit" parameters.
+// Elements are read until an element without ID is met.
+//
+// Panics if the owner ID is invalid.
+func OwnerQuotas(c *config.Config) map[string]engine.QuotaLimits {
+	res := make(map[string]engine.QuotaLimits)
+
+	iterateQuotas(c, "owners", func(i int, id string, l engine.QuotaLimits) {
+		var owner user.ID
+		if err := owner.DecodeString(id); err != nil {
+			panic(fmt.Errorf("invalid owner ID in quota #%d: %w", i, err))
+		}
+		res[owner.EncodeToString()] = l
+	})
+
+	return res
+}
+
+func iterateQuotas(c *config.Config, name string, f func(int, string, engine.QuotaLimits)) {
+	sub := c.Sub(subsection).Sub("quota").Sub(name)
+	for i := 0; ; i++ {
+		qc := sub.Sub(strconv.Itoa(i))
+
+		id := config.StringSafe(qc, "id")
+		if id == "" {
+			return
+		}
+
+		f(i, id, engine.QuotaLimits{
+			Soft: config.SizeInBytesSafe(qc, "soft_limit"),
+			Hard: config.SizeInBytesSafe(qc, "hard_limit"),
+		})
+	}
+}
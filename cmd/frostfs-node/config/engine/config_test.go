@@ -11,8 +11,12 @@ import (
 	blobovniczaconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor/blobovnicza"
 	fstreeconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor/fstree"
 	piloramaconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/pilorama"
+	tieringconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/tiering"
 	configtest "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/test"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,6 +40,8 @@ func TestEngineSection(t *testing.T) {
 
 		require.EqualValues(t, 0, engineconfig.ShardErrorThreshold(empty))
 		require.EqualValues(t, engineconfig.ShardPoolSizeDefault, engineconfig.ShardPoolSize(empty))
+		require.Empty(t, engineconfig.ContainerQuotas(empty))
+		require.Empty(t, engineconfig.OwnerQuotas(empty))
 		require.EqualValues(t, mode.ReadWrite, shardconfig.From(empty).Mode())
 	})
 
@@ -47,6 +53,15 @@ func TestEngineSection(t *testing.T) {
 		require.EqualValues(t, 100, engineconfig.ShardErrorThreshold(c))
 		require.EqualValues(t, 15, engineconfig.ShardPoolSize(c))
 
+		var quotaCnr cid.ID
+		require.NoError(t, quotaCnr.DecodeString("EutHBsdT1YCzHxjCfQHnLPL1vFrkSyLSio4vkphfnEk"))
+		require.Equal(t, map[cid.ID]engine.QuotaLimits{
+			quotaCnr: {Soft: 1 << 30, Hard: 2 << 30},
+		}, engineconfig.ContainerQuotas(c))
+		require.Equal(t, map[string]engine.QuotaLimits{
+			"NbUgTSFvPmsRxmGeWpuuGeJUoRoi6PErcM": {Hard: 10 << 30},
+		}, engineconfig.OwnerQuotas(c))
+
 		err := engineconfig.IterateShards(c, true, func(sc *shardconfig.Config) error {
 			defer func() {
 				num++
@@ -58,6 +73,8 @@ func TestEngineSection(t *testing.T) {
 			ss := blob.Storages()
 			pl := sc.Pilorama()
 			gc := sc.GC()
+			tiering := sc.Tiering()
+			io := sc.IOLimits()
 
 			switch num {
 			case 0:
@@ -71,10 +88,13 @@ func TestEngineSection(t *testing.T) {
 				require.Equal(t, true, wc.NoSync())
 
 				require.Equal(t, "tmp/0/cache", wc.Path())
+				require.Equal(t, "bbolt", wc.Type())
 				require.EqualValues(t, 16384, wc.SmallObjectSize())
 				require.EqualValues(t, 134217728, wc.MaxObjectSize())
 				require.EqualValues(t, 30, wc.WorkersNumber())
 				require.EqualValues(t, 3221225472, wc.SizeLimit())
+				require.Equal(t, 0.9, wc.BackpressureThreshold())
+				require.Equal(t, 100*time.Millisecond, wc.BackpressureMaxDelay())
 
 				require.Equal(t, "tmp/0/meta", meta.Path())
 				require.Equal(t, fs.FileMode(0644), meta.BoltDB().Perm())
@@ -83,6 +103,23 @@ func TestEngineSection(t *testing.T) {
 
 				require.Equal(t, true, sc.Compress())
 				require.Equal(t, []string{"audio/*", "video/*"}, sc.UncompressableContentTypes())
+				require.Equal(t, "zstd:fastest", sc.CompressionCodec())
+
+				var cnr cid.ID
+				require.NoError(t, cnr.DecodeString("EutHBsdT1YCzHxjCfQHnLPL1vFrkSyLSio4vkphfnEk"))
+				require.Equal(t, []compression.Rule{
+					{
+						Containers: []cid.ID{cnr},
+						Attribute:  "Content-Type",
+						Value:      "text/*",
+						Codec:      "lz4",
+					},
+					{
+						Attribute: "Content-Type",
+						Value:     "application/octet-stream",
+						Codec:     "none",
+					},
+				}, sc.CompressionRules())
 				require.EqualValues(t, 102400, sc.SmallSizeLimit())
 
 				require.Equal(t, 2, len(ss))
@@ -104,6 +141,13 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 150, gc.RemoverBatchSize())
 				require.Equal(t, 2*time.Minute, gc.RemoverSleepInterval())
 
+				require.Equal(t, 72*time.Hour, tiering.ColdAfter())
+				require.Equal(t, 30*time.Minute, tiering.Interval())
+				require.EqualValues(t, 524288, tiering.MaxObjectSize())
+
+				require.EqualValues(t, 0, io.IOPS("replication"))
+				require.EqualValues(t, 0, io.Bandwidth("replication"))
+
 				require.Equal(t, false, sc.RefillMetabase())
 				require.Equal(t, mode.ReadOnly, sc.Mode())
 			case 1:
@@ -117,10 +161,13 @@ func TestEngineSection(t *testing.T) {
 				require.Equal(t, false, wc.NoSync())
 
 				require.Equal(t, "tmp/1/cache", wc.Path())
+				require.Equal(t, "log", wc.Type())
 				require.EqualValues(t, 16384, wc.SmallObjectSize())
 				require.EqualValues(t, 134217728, wc.MaxObjectSize())
 				require.EqualValues(t, 30, wc.WorkersNumber())
 				require.EqualValues(t, 4294967296, wc.SizeLimit())
+				require.Equal(t, 0.0, wc.BackpressureThreshold())
+				require.Equal(t, time.Duration(0), wc.BackpressureMaxDelay())
 
 				require.Equal(t, "tmp/1/meta", meta.Path())
 				require.Equal(t, fs.FileMode(0644), meta.BoltDB().Perm())
@@ -129,6 +176,8 @@ func TestEngineSection(t *testing.T) {
 
 				require.Equal(t, false, sc.Compress())
 				require.Equal(t, []string(nil), sc.UncompressableContentTypes())
+				require.Equal(t, compression.DefaultCodec, sc.CompressionCodec())
+				require.Nil(t, sc.CompressionRules())
 				require.EqualValues(t, 102400, sc.SmallSizeLimit())
 
 				require.Equal(t, 2, len(ss))
@@ -150,6 +199,17 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 200, gc.RemoverBatchSize())
 				require.Equal(t, 5*time.Minute, gc.RemoverSleepInterval())
 
+				require.Equal(t, time.Duration(0), tiering.ColdAfter())
+				require.Equal(t, tieringconfig.IntervalDefault, tiering.Interval())
+				require.EqualValues(t, tieringconfig.MaxObjectSizeDefault, tiering.MaxObjectSize())
+
+				require.EqualValues(t, 0, io.IOPS("client"))
+				require.EqualValues(t, 0, io.Bandwidth("client"))
+				require.EqualValues(t, 500, io.IOPS("replication"))
+				require.EqualValues(t, 100*1024*1024, io.Bandwidth("replication"))
+				require.EqualValues(t, 100, io.IOPS("background"))
+				require.EqualValues(t, 20*1024*1024, io.Bandwidth("background"))
+
 				require.Equal(t, true, sc.RefillMetabase())
 				require.Equal(t, mode.ReadWrite, sc.Mode())
 			}
@@ -2,14 +2,19 @@ package shardconfig
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
 	blobstorconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor"
 	gcconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/gc"
+	iolimitsconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/iolimits"
 	metabaseconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/metabase"
 	piloramaconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/pilorama"
+	tieringconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/tiering"
 	writecacheconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/writecache"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 )
 
 // Config is a wrapper over the config section
@@ -43,6 +48,57 @@ func (x *Config) UncompressableContentTypes() []string {
 		"compression_exclude_content_types")
 }
 
+// CompressionCodec returns the value of "compression_codec" config parameter.
+//
+// Returns compression.DefaultCodec if the value is missing or is invalid.
+func (x *Config) CompressionCodec() string {
+	c := config.StringSafe(
+		(*config.Config)(x),
+		"compression_codec",
+	)
+
+	if c != "" {
+		return c
+	}
+
+	return compression.DefaultCodec
+}
+
+// CompressionRules returns the value of "compression_rules" config section.
+// Each rule is a subsection with "containers", "attribute", "value" and "codec"
+// parameters. Rules are read until a rule without codec is met.
+//
+// Panics if the container list contains an invalid container ID.
+func (x *Config) CompressionRules() []compression.Rule {
+	var rs []compression.Rule
+
+	sub := (*config.Config)(x).Sub("compression_rules")
+	for i := 0; ; i++ {
+		rc := sub.Sub(strconv.Itoa(i))
+
+		codec := config.StringSafe(rc, "codec")
+		if codec == "" {
+			return rs
+		}
+
+		r := compression.Rule{
+			Attribute: config.StringSafe(rc, "attribute"),
+			Value:     config.StringSafe(rc, "value"),
+			Codec:     codec,
+		}
+
+		for _, s := range config.StringSliceSafe(rc, "containers") {
+			var id cid.ID
+			if err := id.DecodeString(s); err != nil {
+				panic(fmt.Errorf("invalid container ID in compression rule #%d: %w", i, err))
+			}
+			r.Containers = append(r.Containers, id)
+		}
+
+		rs = append(rs, r)
+	}
+}
+
 // SmallSizeLimit returns the value of "small_object_size" config parameter.
 //
 // Returns SmallSizeLimitDefault if the value is not a positive number.
@@ -99,6 +155,22 @@ func (x *Config) GC() *gcconfig.Config {
 	)
 }
 
+// Tiering returns "tiering" subsection as a tieringconfig.Config.
+func (x *Config) Tiering() *tieringconfig.Config {
+	return tieringconfig.From(
+		(*config.Config)(x).
+			Sub("tiering"),
+	)
+}
+
+// IOLimits returns "io_limits" subsection as a iolimitsconfig.Config.
+func (x *Config) IOLimits() *iolimitsconfig.Config {
+	return iolimitsconfig.From(
+		(*config.Config)(x).
+			Sub("io_limits"),
+	)
+}
+
 // RefillMetabase returns the value of "resync_metabase" config parameter.
 //
 // Returns false if the value is not a valid bool.
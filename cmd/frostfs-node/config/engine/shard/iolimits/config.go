@@ -0,0 +1,36 @@
+package iolimitsconfig
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+)
+
+// Config is a wrapper over the config section
+// which provides access to Shard's I/O limits configurations.
+type Config config.Config
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// IOPS returns the value of "iops" config parameter
+// from the subsection of the I/O class.
+//
+// Returns 0 (no limit) if the value is not a positive number.
+func (x *Config) IOPS(class string) uint32 {
+	return config.Uint32Safe(
+		(*config.Config)(x).Sub(class),
+		"iops",
+	)
+}
+
+// Bandwidth returns the value of "bandwidth" config parameter
+// from the subsection of the I/O class.
+//
+// Returns 0 (no limit) if the value is not a positive number.
+func (x *Config) Bandwidth(class string) uint64 {
+	return config.SizeInBytesSafe(
+		(*config.Config)(x).Sub(class),
+		"bandwidth",
+	)
+}
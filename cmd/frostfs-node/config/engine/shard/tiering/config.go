@@ -0,0 +1,72 @@
+package tieringconfig
+
+import (
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+)
+
+// Config is a wrapper over the config section
+// which provides access to Shard's tiering configurations.
+type Config config.Config
+
+const (
+	// IntervalDefault is a default interval between cold objects migrations.
+	IntervalDefault = time.Hour
+
+	// MaxObjectSizeDefault is a default maximum size of the migrated object.
+	MaxObjectSizeDefault = 1 << 20
+)
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// ColdAfter returns the value of "cold_after" config parameter.
+//
+// Returns 0 (tiering is disabled) if the value is not a positive duration.
+func (x *Config) ColdAfter() time.Duration {
+	d := config.DurationSafe(
+		(*config.Config)(x),
+		"cold_after",
+	)
+
+	if d > 0 {
+		return d
+	}
+
+	return 0
+}
+
+// Interval returns the value of "interval" config parameter.
+//
+// Returns IntervalDefault if the value is not a positive duration.
+func (x *Config) Interval() time.Duration {
+	d := config.DurationSafe(
+		(*config.Config)(x),
+		"interval",
+	)
+
+	if d > 0 {
+		return d
+	}
+
+	return IntervalDefault
+}
+
+// MaxObjectSize returns the value of "max_object_size" config parameter.
+//
+// Returns MaxObjectSizeDefault if the value is not a positive number.
+func (x *Config) MaxObjectSize() uint64 {
+	s := config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"max_object_size",
+	)
+
+	if s > 0 {
+		return s
+	}
+
+	return MaxObjectSizeDefault
+}
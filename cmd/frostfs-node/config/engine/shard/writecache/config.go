@@ -1,6 +1,8 @@
 package writecacheconfig
 
 import (
+	"time"
+
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
 	boltdbconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/boltdb"
 )
@@ -21,6 +23,9 @@ const (
 
 	// SizeLimitDefault is a default write-cache size limit.
 	SizeLimitDefault = 1 << 30
+
+	// TypeDefault is a default write-cache storage type.
+	TypeDefault = "bbolt"
 )
 
 // From wraps config section into Config.
@@ -35,6 +40,17 @@ func (x *Config) Enabled() bool {
 	return config.Bool((*config.Config)(x), "enabled")
 }
 
+// Type returns the value of "type" config parameter.
+//
+// Returns TypeDefault if the value is not set.
+func (x *Config) Type() string {
+	t := config.StringSafe((*config.Config)(x), "type")
+	if t == "" {
+		return TypeDefault
+	}
+	return t
+}
+
 // Path returns the value of "path" config parameter.
 //
 // Panics if the value is not a non-empty string.
@@ -122,6 +138,29 @@ func (x *Config) NoSync() bool {
 	return config.BoolSafe((*config.Config)(x), "no_sync")
 }
 
+// BackpressureThreshold returns the value of "backpressure_threshold" config parameter
+// as a fraction of the write-cache capacity.
+//
+// Returns 0 if the value is not a number between 0 and 100.
+func (x *Config) BackpressureThreshold() float64 {
+	p := config.UintSafe((*config.Config)(x), "backpressure_threshold")
+	if p > 100 {
+		return 0
+	}
+	return float64(p) / 100
+}
+
+// BackpressureMaxDelay returns the value of "backpressure_max_delay" config parameter.
+//
+// Returns 0 (backpressure is disabled) if the value is not a positive duration.
+func (x *Config) BackpressureMaxDelay() time.Duration {
+	d := config.DurationSafe((*config.Config)(x), "backpressure_max_delay")
+	if d > 0 {
+		return d
+	}
+	return 0
+}
+
 // BoltDB returns config instance for querying bolt db specific parameters.
 func (x *Config) BoltDB() *boltdbconfig.Config {
 	return (*boltdbconfig.Config)(x)
@@ -2,6 +2,7 @@ package objectconfig_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
 	objectconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/object"
@@ -16,6 +17,13 @@ func TestObjectSection(t *testing.T) {
 		require.Equal(t, objectconfig.PutPoolSizeDefault, objectconfig.Put(empty).PoolSizeRemote())
 		require.Equal(t, objectconfig.PutPoolSizeDefault, objectconfig.Put(empty).PoolSizeLocal())
 		require.EqualValues(t, objectconfig.DefaultTombstoneLifetime, objectconfig.TombstoneLifetime(empty))
+		require.Empty(t, objectconfig.PutUpload(empty).Path())
+		require.EqualValues(t, objectconfig.UploadLifetimeDefault, objectconfig.PutUpload(empty).Lifetime())
+		require.Equal(t, objectconfig.AssemblyConcurrencyDefault, objectconfig.Get(empty).AssemblyConcurrency())
+		require.EqualValues(t, objectconfig.AssemblyMemoryDefault, objectconfig.Get(empty).AssemblyMemory())
+		require.Zero(t, objectconfig.GetCache(empty).Size())
+		require.Equal(t, objectconfig.CacheTTLDefault, objectconfig.GetCache(empty).TTL())
+		require.EqualValues(t, objectconfig.CacheMaxObjectSizeDefault, objectconfig.GetCache(empty).MaxObjectSize())
 	})
 
 	const path = "../../../../config/example/node"
@@ -24,6 +32,13 @@ func TestObjectSection(t *testing.T) {
 		require.Equal(t, 100, objectconfig.Put(c).PoolSizeRemote())
 		require.Equal(t, 200, objectconfig.Put(c).PoolSizeLocal())
 		require.EqualValues(t, 10, objectconfig.TombstoneLifetime(c))
+		require.Equal(t, "/path/to/uploads.db", objectconfig.PutUpload(c).Path())
+		require.EqualValues(t, 20, objectconfig.PutUpload(c).Lifetime())
+		require.Equal(t, 8, objectconfig.Get(c).AssemblyConcurrency())
+		require.EqualValues(t, 512<<20, objectconfig.Get(c).AssemblyMemory())
+		require.EqualValues(t, 1<<30, objectconfig.GetCache(c).Size())
+		require.Equal(t, 30*time.Second, objectconfig.GetCache(c).TTL())
+		require.EqualValues(t, 4<<20, objectconfig.GetCache(c).MaxObjectSize())
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
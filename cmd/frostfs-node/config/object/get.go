@@ -0,0 +1,109 @@
+package objectconfig
+
+import (
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+)
+
+// GetConfig is a wrapper over "get" config section which provides access
+// to object get pipeline configuration of object service.
+type GetConfig struct {
+	cfg *config.Config
+}
+
+// CacheConfig is a wrapper over "get.cache" config section which provides
+// access to object cache configuration of object service.
+type CacheConfig struct {
+	cfg *config.Config
+}
+
+const (
+	getSubsection   = "get"
+	cacheSubsection = "cache"
+
+	// AssemblyConcurrencyDefault is a default number of the child objects
+	// fetched concurrently during the split object assembly.
+	AssemblyConcurrencyDefault = 4
+
+	// AssemblyMemoryDefault is a default total payload size of the child
+	// objects buffered by the split object assemblies.
+	AssemblyMemoryDefault = 256 << 20
+
+	// CacheTTLDefault is a default time during which the cached object is served.
+	CacheTTLDefault = time.Minute
+
+	// CacheMaxObjectSizeDefault is a default maximum payload size of the cached object.
+	CacheMaxObjectSizeDefault = 1 << 20
+)
+
+// Get returns structure that provides access to "get" subsection of
+// "object" section.
+func Get(c *config.Config) GetConfig {
+	return GetConfig{
+		c.Sub(subsection).Sub(getSubsection),
+	}
+}
+
+// AssemblyConcurrency returns the value of "assembly_concurrency" config parameter.
+//
+// Returns AssemblyConcurrencyDefault if the value is not a positive number.
+func (g GetConfig) AssemblyConcurrency() int {
+	v := config.Int(g.cfg, "assembly_concurrency")
+	if v > 0 {
+		return int(v)
+	}
+
+	return AssemblyConcurrencyDefault
+}
+
+// AssemblyMemory returns the value of "assembly_memory" config parameter.
+//
+// Returns AssemblyMemoryDefault if the value is not a positive number.
+func (g GetConfig) AssemblyMemory() uint64 {
+	v := config.SizeInBytesSafe(g.cfg, "assembly_memory")
+	if v > 0 {
+		return v
+	}
+
+	return AssemblyMemoryDefault
+}
+
+// GetCache returns structure that provides access to "get.cache" subsection
+// of "object" section.
+func GetCache(c *config.Config) CacheConfig {
+	return CacheConfig{
+		c.Sub(subsection).Sub(getSubsection).Sub(cacheSubsection),
+	}
+}
+
+// Size returns the value of "size" config parameter.
+//
+// Returns 0 if the value is not set, the cache is disabled in this case.
+func (x CacheConfig) Size() uint64 {
+	return config.SizeInBytesSafe(x.cfg, "size")
+}
+
+// TTL returns the value of "ttl" config parameter.
+//
+// Returns CacheTTLDefault if the value is not a positive duration.
+func (x CacheConfig) TTL() time.Duration {
+	v := config.DurationSafe(x.cfg, "ttl")
+	if v > 0 {
+		return v
+	}
+
+	return CacheTTLDefault
+}
+
+// MaxObjectSize returns the value of "max_object_size" config parameter.
+//
+// Returns CacheMaxObjectSizeDefault if the value is not a positive number.
+func (x CacheConfig) MaxObjectSize() uint64 {
+	v := config.SizeInBytesSafe(x.cfg, "max_object_size")
+	if v > 0 {
+		return v
+	}
+
+	return CacheMaxObjectSizeDefault
+}
@@ -0,0 +1,47 @@
+package objectconfig
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+)
+
+// UploadConfig is a wrapper over "put.upload" config section which provides
+// access to resumable upload configuration of object service.
+type UploadConfig struct {
+	cfg *config.Config
+}
+
+const (
+	uploadSubsection = "upload"
+
+	// UploadLifetimeDefault is a default number of epochs the state of
+	// the resumable upload is kept after the last write.
+	UploadLifetimeDefault = 10
+)
+
+// PutUpload returns structure that provides access to "put.upload" subsection
+// of "object" section.
+func PutUpload(c *config.Config) UploadConfig {
+	return UploadConfig{
+		c.Sub(subsection).Sub(putSubsection).Sub(uploadSubsection),
+	}
+}
+
+// Path returns the value of "path" config parameter.
+//
+// Returns empty string if the value is not set, resumable uploads
+// are disabled in this case.
+func (x UploadConfig) Path() string {
+	return config.StringSafe(x.cfg, "path")
+}
+
+// Lifetime returns the value of "lifetime" config parameter.
+//
+// Returns UploadLifetimeDefault if the value is not a positive number.
+func (x UploadConfig) Lifetime() uint64 {
+	v := config.UintSafe(x.cfg, "lifetime")
+	if v > 0 {
+		return v
+	}
+
+	return UploadLifetimeDefault
+}
@@ -0,0 +1,52 @@
+package tracingconfig
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
+)
+
+const (
+	subsection = "tracing"
+
+	// ExporterDefault is a default value for the span exporter.
+	ExporterDefault = tracing.ExporterOTLPGRPC
+)
+
+// Enabled returns the value of "enabled" config parameter
+// from "tracing" section.
+//
+// Returns false if the value is missing or invalid.
+func Enabled(c *config.Config) bool {
+	return config.BoolSafe(c.Sub(subsection), "enabled")
+}
+
+// Exporter returns the value of "exporter" config parameter
+// from "tracing" section.
+//
+// Returns ExporterDefault if the value is not set.
+func Exporter(c *config.Config) tracing.Exporter {
+	v := config.StringSafe(c.Sub(subsection), "exporter")
+	if v != "" {
+		return tracing.Exporter(v)
+	}
+
+	return ExporterDefault
+}
+
+// Endpoint returns the value of "endpoint" config parameter
+// from "tracing" section. It is the address of the OTLP collector
+// for "otlp_grpc" exporter and the path to the output file
+// for "file" exporter.
+//
+// Returns empty string if the value is not set.
+func Endpoint(c *config.Config) string {
+	return config.StringSafe(c.Sub(subsection), "endpoint")
+}
+
+// Insecure returns the value of "insecure" config parameter
+// from "tracing" section.
+//
+// Returns false if the value is missing or invalid.
+func Insecure(c *config.Config) bool {
+	return config.BoolSafe(c.Sub(subsection), "insecure")
+}
@@ -0,0 +1,37 @@
+package tracingconfig_test
+
+import (
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+	configtest "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/test"
+	tracingconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/tracing"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracingSection(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		empty := configtest.EmptyConfig()
+
+		require.False(t, tracingconfig.Enabled(empty))
+		require.Equal(t, tracingconfig.ExporterDefault, tracingconfig.Exporter(empty))
+		require.Empty(t, tracingconfig.Endpoint(empty))
+		require.False(t, tracingconfig.Insecure(empty))
+	})
+
+	const path = "../../../../config/example/node"
+
+	var fileConfigTest = func(c *config.Config) {
+		require.True(t, tracingconfig.Enabled(c))
+		require.Equal(t, tracing.ExporterOTLPGRPC, tracingconfig.Exporter(c))
+		require.Equal(t, "localhost:4317", tracingconfig.Endpoint(c))
+		require.True(t, tracingconfig.Insecure(c))
+	}
+
+	configtest.ForEachFileType(path, fileConfigTest)
+
+	t.Run("ENV", func(t *testing.T) {
+		configtest.ForEnvFileType(path, fileConfigTest)
+	})
+}
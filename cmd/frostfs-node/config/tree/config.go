@@ -71,3 +71,19 @@ func (c TreeConfig) ReplicationWorkerCount() int {
 func (c TreeConfig) SyncInterval() time.Duration {
 	return config.DurationSafe(c.cfg, "sync_interval")
 }
+
+// LogCompactionInterval returns the value of "log_compaction_interval"
+// config parameter from the "tree" section.
+//
+// Returns 0 if config value is not specified.
+func (c TreeConfig) LogCompactionInterval() time.Duration {
+	return config.DurationSafe(c.cfg, "log_compaction_interval")
+}
+
+// LogRetention returns the value of "log_retention"
+// config parameter from the "tree" section.
+//
+// Returns 0 if config value is not specified.
+func (c TreeConfig) LogRetention() time.Duration {
+	return config.DurationSafe(c.cfg, "log_retention")
+}
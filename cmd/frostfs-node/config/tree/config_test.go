@@ -21,6 +21,8 @@ func TestTreeSection(t *testing.T) {
 		require.Equal(t, 0, treeSec.ReplicationChannelCapacity())
 		require.Equal(t, 0, treeSec.ReplicationWorkerCount())
 		require.Equal(t, time.Duration(0), treeSec.ReplicationTimeout())
+		require.Equal(t, time.Duration(0), treeSec.LogCompactionInterval())
+		require.Equal(t, time.Duration(0), treeSec.LogRetention())
 	})
 
 	const path = "../../../../config/example/node"
@@ -34,6 +36,8 @@ func TestTreeSection(t *testing.T) {
 		require.Equal(t, 32, treeSec.ReplicationWorkerCount())
 		require.Equal(t, 5*time.Second, treeSec.ReplicationTimeout())
 		require.Equal(t, time.Hour, treeSec.SyncInterval())
+		require.Equal(t, time.Hour, treeSec.LogCompactionInterval())
+		require.Equal(t, 72*time.Hour, treeSec.LogRetention())
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRereadConfigFailure(t *testing.T) {
+	p := filepath.Join("../../config/example", "node.yaml")
+
+	c := &cfg{}
+	c.appCfg = config.New(config.Prm{}, config.WithConfigFile(p))
+	require.NoError(t, c.readConfig(c.appCfg))
+
+	shards := c.EngineCfg.shards
+	require.NotEmpty(t, shards)
+
+	s := &shardConfigurator{cfg: c}
+
+	// storage getter panics on an unknown type
+	t.Setenv("FROSTFS_STORAGE_SHARD_0_BLOBSTOR_0_TYPE", "unknown")
+	require.Error(t, s.readConfig())
+	require.True(t, c._read)
+	require.Equal(t, shards, c.EngineCfg.shards)
+}
@@ -130,6 +130,8 @@ func initContainerService(c *cfg) {
 		cnrWrt.eacls = cachedEACLStorage
 	}
 
+	c.cfgObject.cfgLocalStorage.quotas.setContainerSource(c.cfgObject.cnrSource)
+
 	localMetrics := &localStorageLoad{
 		log:    c.log,
 		engine: c.cfgObject.cfgLocalStorage.localStorage,
@@ -48,6 +48,8 @@ func initControlService(c *cfg) {
 		controlSvc.WithTreeService(treeSynchronizer{
 			c.treeService,
 		}),
+		controlSvc.WithShardConfigurator(&shardConfigurator{cfg: c}),
+		controlSvc.WithMaxObjectSizeSource(newCachedMaxObjectSizeSource(c)),
 	)
 
 	lis, err := net.Listen("tcp", endpoint)
@@ -9,6 +9,7 @@ import (
 
 	grpcconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/grpc"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -19,6 +20,8 @@ func initGRPC(c *cfg) {
 	grpcconfig.IterateEndpoints(c.appCfg, func(sc *grpcconfig.Config) {
 		serverOpts := []grpc.ServerOption{
 			grpc.MaxSendMsgSize(maxMsgSize),
+			grpc.ChainUnaryInterceptor(tracing.NewUnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(tracing.NewStreamServerInterceptor()),
 		}
 
 		tlsCfg := sc.TLS()
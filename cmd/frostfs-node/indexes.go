@@ -0,0 +1,59 @@
+package main
+
+import (
+	"strings"
+
+	containerV2 "github.com/TrueCloudLab/frostfs-api-go/v2/container"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+)
+
+// indexedAttributesAttribute is a container attribute containing the comma-separated
+// list of the object attributes to maintain sorted indexes for. Each attribute
+// may be followed by the index type after a colon: `string` (default) or `numeric`.
+const indexedAttributesAttribute = containerV2.SysAttributePrefix + "INDEXED_ATTRIBUTES"
+
+// indexSource provides the sorted attribute indexes from the container attributes.
+type indexSource struct {
+	cfg *cfg
+}
+
+func (s indexSource) AttributeIndexes(cnr cid.ID) ([]meta.AttributeIndex, error) {
+	// container source is initialized after the storage engine
+	src := s.cfg.cfgObject.cnrSource
+	if src == nil {
+		return nil, errNoContainerSource
+	}
+
+	c, err := src.Get(cnr)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseIndexedAttributes(c.Value.Attribute(indexedAttributesAttribute)), nil
+}
+
+// parseIndexedAttributes returns the indexes listed in the attribute value,
+// invalid entries are ignored.
+func parseIndexedAttributes(v string) []meta.AttributeIndex {
+	var res []meta.AttributeIndex
+	for _, s := range strings.Split(v, ",") {
+		attr, typ, _ := strings.Cut(strings.TrimSpace(s), ":")
+		if attr == "" {
+			continue
+		}
+
+		idx := meta.AttributeIndex{Attribute: attr}
+		switch typ {
+		case "", "string":
+			idx.Type = meta.IndexString
+		case "numeric":
+			idx.Type = meta.IndexNumeric
+		default:
+			continue
+		}
+
+		res = append(res, idx)
+	}
+	return res
+}
@@ -86,6 +86,7 @@ func initApp(c *cfg) {
 	metrics, _ := metricsComponent(c)
 	initAndLog(c, pprof.name, pprof.init)
 	initAndLog(c, metrics.name, metrics.init)
+	initAndLog(c, "tracing", initTracing)
 
 	initLocalStorage(c)
 
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 
@@ -40,7 +41,7 @@ func (n *notificationSource) Iterate(epoch uint64, handler func(topic string, ad
 	for _, c := range listRes.Containers() {
 		selectPrm.WithContainerID(c)
 
-		selectRes, err := n.e.Select(selectPrm)
+		selectRes, err := n.e.Select(context.Background(), selectPrm)
 		if err != nil {
 			log.Error("notificator: could not select objects from container",
 				zap.Stringer("cid", c),
@@ -71,7 +72,7 @@ func (n *notificationSource) processAddress(
 	var prm engine.HeadPrm
 	prm.WithAddress(a)
 
-	res, err := n.e.Head(prm)
+	res, err := n.e.Head(context.Background(), prm)
 	if err != nil {
 		return err
 	}
@@ -5,35 +5,44 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	objectGRPC "github.com/TrueCloudLab/frostfs-api-go/v2/object/grpc"
 	metricsconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/metrics"
+	objectconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/object"
 	policerconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/policer"
 	replicatorconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/replicator"
 	coreclient "github.com/TrueCloudLab/frostfs-node/pkg/core/client"
 	containercore "github.com/TrueCloudLab/frostfs-node/pkg/core/container"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
 	morphClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client"
 	cntClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/container"
 	nmClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/netmap"
+	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
+	netmapEvent "github.com/TrueCloudLab/frostfs-node/pkg/morph/event/netmap"
 	objectTransportGRPC "github.com/TrueCloudLab/frostfs-node/pkg/network/transport/object/grpc"
 	objectService "github.com/TrueCloudLab/frostfs-node/pkg/services/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/acl"
 	v2 "github.com/TrueCloudLab/frostfs-node/pkg/services/object/acl/v2"
+	copysvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/copy"
 	deletesvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/delete"
 	deletesvcV2 "github.com/TrueCloudLab/frostfs-node/pkg/services/object/delete/v2"
+	ecsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/ec"
 	getsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get"
 	getsvcV2 "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get/v2"
 	headsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/head"
 	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/put/upload"
 	putsvcV2 "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put/v2"
 	searchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/search"
 	searchsvcV2 "github.com/TrueCloudLab/frostfs-node/pkg/services/object/search/v2"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/transformer"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/policer"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/replicator"
 	truststorage "github.com/TrueCloudLab/frostfs-node/pkg/services/reputation/local/storage"
@@ -214,6 +223,8 @@ func initObjectService(c *cfg) {
 		),
 	)
 
+	ecCollector := ecsvc.NewCollector(c.log, ls, keyStorage, clientConstructor, c)
+
 	pol := policer.New(
 		policer.WithLogger(c.log),
 		policer.WithLocalStorage(ls),
@@ -229,11 +240,12 @@ func initObjectService(c *cfg) {
 			policerconfig.HeadTimeout(c.appCfg),
 		),
 		policer.WithReplicator(c.replicator),
+		policer.WithErasureCoding(ecCollector, &c.key.PrivateKey),
 		policer.WithRedundantCopyCallback(func(addr oid.Address) {
 			var inhumePrm engine.InhumePrm
 			inhumePrm.MarkAsGarbage(addr)
 
-			_, err := ls.Inhume(inhumePrm)
+			_, err := ls.Inhume(context.Background(), inhumePrm)
 			if err != nil {
 				c.log.Warn("could not inhume mark redundant copy as garbage",
 					zap.String("error", err.Error()),
@@ -266,7 +278,28 @@ func initObjectService(c *cfg) {
 		}
 	}
 
-	sPut := putsvc.NewService(
+	getOpts := []getsvc.Option{
+		getsvc.WithLogger(c.log),
+		getsvc.WithLocalStorageEngine(ls),
+		getsvc.WithClientConstructor(coreConstructor),
+		getsvc.WithTraverserGenerator(
+			traverseGen.WithTraverseOptions(
+				placement.SuccessAfter(1),
+			),
+		),
+		getsvc.WithNetMapSource(c.netMapSource),
+		getsvc.WithKeyStorage(keyStorage),
+		getsvc.WithErasureCoding(c.cfgObject.cnrSource, ecCollector),
+		getsvc.WithAssemblyConcurrency(objectconfig.Get(c.appCfg).AssemblyConcurrency()),
+		getsvc.WithAssemblyMemoryLimit(objectconfig.Get(c.appCfg).AssemblyMemory()),
+		getsvc.WithMaxSizeSource(newCachedMaxObjectSizeSource(c)),
+	}
+
+	if cache := c.cfgObject.cfgLocalStorage.objectCache; cache != nil {
+		getOpts = append(getOpts, getsvc.WithObjectCache(cache))
+	}
+
+	putOpts := []putsvc.Option{
 		putsvc.WithKeyStorage(keyStorage),
 		putsvc.WithClientConstructor(putConstructor),
 		putsvc.WithMaxSizeSource(newCachedMaxObjectSizeSource(c)),
@@ -277,12 +310,14 @@ func initObjectService(c *cfg) {
 		putsvc.WithNetworkState(c.cfgNetmap.state),
 		putsvc.WithWorkerPools(c.cfgObject.pool.putRemote, c.cfgObject.pool.putLocal),
 		putsvc.WithLogger(c.log),
-	)
+	}
 
-	sPutV2 := putsvcV2.NewService(
-		putsvcV2.WithInternalService(sPut),
-		putsvcV2.WithKeyStorage(keyStorage),
-	)
+	uploads := newUploadStorage(c)
+	if uploads != nil {
+		putOpts = append(putOpts, putsvc.WithUploadStorage(uploads, objectconfig.PutUpload(c.appCfg).Lifetime()))
+	}
+
+	sPut := putsvc.NewService(putOpts...)
 
 	sSearch := searchsvc.New(
 		searchsvc.WithLogger(c.log),
@@ -302,18 +337,7 @@ func initObjectService(c *cfg) {
 		searchsvcV2.WithKeyStorage(keyStorage),
 	)
 
-	sGet := getsvc.New(
-		getsvc.WithLogger(c.log),
-		getsvc.WithLocalStorageEngine(ls),
-		getsvc.WithClientConstructor(coreConstructor),
-		getsvc.WithTraverserGenerator(
-			traverseGen.WithTraverseOptions(
-				placement.SuccessAfter(1),
-			),
-		),
-		getsvc.WithNetMapSource(c.netMapSource),
-		getsvc.WithKeyStorage(keyStorage),
-	)
+	sGet := getsvc.New(getOpts...)
 
 	*c.cfgObject.getSvc = *sGet // need smth better
 
@@ -322,6 +346,24 @@ func initObjectService(c *cfg) {
 		getsvcV2.WithKeyStorage(keyStorage),
 	)
 
+	sCopy := copysvc.New(
+		copysvc.WithLogger(c.log),
+		copysvc.WithGetService(sGet),
+		copysvc.WithPutService(sPut),
+		copysvc.WithKeyStorage(keyStorage),
+	)
+
+	// ACL service is built on top of the Put service,
+	// it is set after the pipeline is built
+	copySrcChecker := new(aclCopySourceChecker)
+
+	sPutV2 := putsvcV2.NewService(
+		putsvcV2.WithInternalService(sPut),
+		putsvcV2.WithCopyService(sCopy),
+		putsvcV2.WithCopySourceChecker(copySrcChecker),
+		putsvcV2.WithKeyStorage(keyStorage),
+	)
+
 	sDelete := deletesvc.New(
 		deletesvc.WithLogger(c.log),
 		deletesvc.WithHeadService(sGet),
@@ -334,12 +376,22 @@ func initObjectService(c *cfg) {
 			cfg: c,
 		}),
 		deletesvc.WithKeyStorage(keyStorage),
+		deletesvc.WithErasureCoding(c.cfgObject.cnrSource),
+		deletesvc.WithLockSource(ls),
 	)
 
 	sDeleteV2 := deletesvcV2.NewService(
 		deletesvcV2.WithInternalService(sDelete),
 	)
 
+	if uploads != nil {
+		addNewEpochAsyncNotificationHandler(c, func(ev event.Event) {
+			uploads.RemoveOld(ev.(netmapEvent.NewEpoch).EpochNumber(), func(id string, state []byte) {
+				removeUploadChildren(c, sDelete, id, state)
+			})
+		})
+	}
+
 	// build service pipeline
 	// grpc | <metrics> | signature | response | acl | split
 
@@ -372,6 +424,8 @@ func initObjectService(c *cfg) {
 		),
 	)
 
+	copySrcChecker.acl = aclSvc
+
 	var commonSvc objectService.Common
 	commonSvc.Init(&c.internals, aclSvc)
 
@@ -394,6 +448,16 @@ func initObjectService(c *cfg) {
 	}
 }
 
+// aclCopySourceChecker checks the source objects of the copies
+// with the ACL service.
+type aclCopySourceChecker struct {
+	acl v2.Service
+}
+
+func (x *aclCopySourceChecker) CheckCopySource(req *object.PutRequest, src *objectSDK.Object) error {
+	return x.acl.CheckCopySource(req, src)
+}
+
 type morphEACLFetcher struct {
 	w *cntClient.Client
 }
@@ -554,16 +618,16 @@ type engineWithNotifications struct {
 	defaultTopic string
 }
 
-func (e engineWithNotifications) Delete(tombstone oid.Address, toDelete []oid.ID) error {
-	return e.base.Delete(tombstone, toDelete)
+func (e engineWithNotifications) Delete(ctx context.Context, tombstone oid.Address, toDelete []oid.ID) error {
+	return e.base.Delete(ctx, tombstone, toDelete)
 }
 
-func (e engineWithNotifications) Lock(locker oid.Address, toLock []oid.ID) error {
-	return e.base.Lock(locker, toLock)
+func (e engineWithNotifications) Lock(ctx context.Context, locker oid.Address, toLock []oid.ID) error {
+	return e.base.Lock(ctx, locker, toLock)
 }
 
-func (e engineWithNotifications) Put(o *objectSDK.Object) error {
-	if err := e.base.Put(o); err != nil {
+func (e engineWithNotifications) Put(ctx context.Context, o *objectSDK.Object) error {
+	if err := e.base.Put(ctx, o); err != nil {
 		return err
 	}
 
@@ -583,11 +647,102 @@ func (e engineWithNotifications) Put(o *objectSDK.Object) error {
 	return nil
 }
 
+// newObjectCache returns the object cache of the Get service,
+// nil if the cache is disabled.
+func newObjectCache(c *cfg) *getsvc.ObjectCache {
+	cacheCfg := objectconfig.GetCache(c.appCfg)
+
+	size := cacheCfg.Size()
+	if size == 0 {
+		return nil
+	}
+
+	opts := []getsvc.CacheOption{
+		getsvc.WithCacheTTL(cacheCfg.TTL()),
+		getsvc.WithCacheMaxObjectSize(cacheCfg.MaxObjectSize()),
+	}
+	if c.metricsCollector != nil {
+		opts = append(opts, getsvc.WithCacheMetrics(c.metricsCollector))
+	}
+
+	return getsvc.NewObjectCache(size, opts...)
+}
+
+// newUploadStorage returns the storage of the resumable uploads,
+// nil if the resumable uploads are disabled.
+func newUploadStorage(c *cfg) *upload.Storage {
+	path := objectconfig.PutUpload(c.appCfg).Path()
+	if path == "" {
+		return nil
+	}
+
+	s, err := upload.NewStorage(path, upload.WithLogger(c.log))
+	fatalOnErr(err)
+
+	c.onShutdown(func() {
+		_ = s.Close()
+	})
+
+	return s
+}
+
+// uploadChildrenRemovalTimeout is the time given to remove the children
+// of the single expired upload.
+const uploadChildrenRemovalTimeout = time.Minute
+
+// removeUploadChildren removes the children stored by the expired resumable
+// upload, so that the abandoned uploads don't leave orphaned objects.
+func removeUploadChildren(c *cfg, sDelete *deletesvc.Service, id string, state []byte) {
+	var cp transformer.Checkpoint
+
+	err := cp.Unmarshal(state)
+	if err == nil && len(cp.Children) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), uploadChildrenRemovalTimeout)
+		defer cancel()
+
+		cnr, _ := cp.Parent.ContainerID()
+
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(cp.Children[0])
+
+		var skipped skippedObjectsCounter
+
+		var prm deletesvc.Prm
+		prm.WithAddress(addr)
+		prm.WithMembers(cp.Children[1:])
+		prm.WithSkippedObjectsTarget(&skipped)
+
+		err = sDelete.Delete(ctx, prm)
+		if err == nil {
+			c.log.Debug("children of the expired upload removed",
+				zap.String("upload", id),
+				zap.Int("removed", len(cp.Children)-int(skipped)),
+				zap.Int("skipped", int(skipped)),
+			)
+		}
+	}
+
+	if err != nil {
+		c.log.Warn("could not remove children of the expired upload",
+			zap.String("upload", id),
+			zap.Error(err),
+		)
+	}
+}
+
+// skippedObjectsCounter counts the objects skipped by the bulk removal.
+type skippedObjectsCounter int
+
+func (x *skippedObjectsCounter) SetSkipped(objs []deletesvc.SkippedObject) {
+	*x = skippedObjectsCounter(len(objs))
+}
+
 type engineWithoutNotifications struct {
 	engine *engine.StorageEngine
 }
 
-func (e engineWithoutNotifications) Delete(tombstone oid.Address, toDelete []oid.ID) error {
+func (e engineWithoutNotifications) Delete(ctx context.Context, tombstone oid.Address, toDelete []oid.ID) error {
 	var prm engine.InhumePrm
 
 	addrs := make([]oid.Address, len(toDelete))
@@ -596,16 +751,37 @@ func (e engineWithoutNotifications) Delete(tombstone oid.Address, toDelete []oid
 		addrs[i].SetObject(toDelete[i])
 	}
 
+	// erasure coded chunks are removed along with the original object
+	for i := range toDelete {
+		var fs objectSDK.SearchFilters
+		fs.AddFilter(erasurecode.AttributeParent, toDelete[i].EncodeToString(), objectSDK.MatchStringEqual)
+
+		var selPrm engine.SelectPrm
+		selPrm.WithContainerID(tombstone.Container())
+		selPrm.WithFilters(fs)
+
+		res, err := e.engine.Select(ctx, selPrm)
+		if err != nil {
+			return fmt.Errorf("could not select erasure coded chunks of %s: %w", toDelete[i], err)
+		}
+
+		addrs = append(addrs, res.AddressList()...)
+	}
+
 	prm.WithTarget(tombstone, addrs...)
 
-	_, err := e.engine.Inhume(prm)
+	_, err := e.engine.Inhume(ctx, prm)
 	return err
 }
 
-func (e engineWithoutNotifications) Lock(locker oid.Address, toLock []oid.ID) error {
+func (e engineWithoutNotifications) Lock(_ context.Context, locker oid.Address, toLock []oid.ID) error {
 	return e.engine.Lock(locker.Container(), locker.Object(), toLock)
 }
 
-func (e engineWithoutNotifications) Put(o *objectSDK.Object) error {
-	return engine.Put(e.engine, o)
+func (e engineWithoutNotifications) Put(ctx context.Context, o *objectSDK.Object) error {
+	var prm engine.PutPrm
+	prm.WithObject(o)
+
+	_, err := e.engine.Put(ctx, prm)
+	return err
 }
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	containerV2 "github.com/TrueCloudLab/frostfs-api-go/v2/container"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/container"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+)
+
+const (
+	// quotaSoftLimitAttribute is a container attribute containing
+	// the soft limit of the container payload size in bytes.
+	quotaSoftLimitAttribute = containerV2.SysAttributePrefix + "QUOTA_SOFT_LIMIT"
+
+	// quotaHardLimitAttribute is a container attribute containing
+	// the hard limit of the container payload size in bytes.
+	quotaHardLimitAttribute = containerV2.SysAttributePrefix + "QUOTA_HARD_LIMIT"
+)
+
+var errNoContainerSource = errors.New("container source is not initialized")
+
+// quotaSource provides container quotas from the local configuration
+// and container attributes. Locally configured container quota
+// takes precedence over the attributes.
+type quotaSource struct {
+	mtx        sync.RWMutex
+	cnrSrc     container.Source
+	containers map[cid.ID]engine.QuotaLimits
+	owners     map[string]engine.QuotaLimits
+}
+
+func newQuotaSource(c *cfg) *quotaSource {
+	return &quotaSource{
+		containers: c.EngineCfg.containerQuotas,
+		owners:     c.EngineCfg.ownerQuotas,
+	}
+}
+
+// setContainerSource sets the source of the container attributes and owners.
+// Container source is initialized after the storage engine, so only locally
+// configured container quotas are applied before the call.
+func (s *quotaSource) setContainerSource(src container.Source) {
+	s.mtx.Lock()
+	s.cnrSrc = src
+	s.mtx.Unlock()
+}
+
+// update replaces locally configured quotas.
+func (s *quotaSource) update(containers map[cid.ID]engine.QuotaLimits, owners map[string]engine.QuotaLimits) {
+	s.mtx.Lock()
+	s.containers = containers
+	s.owners = owners
+	s.mtx.Unlock()
+}
+
+func (s *quotaSource) ContainerQuota(cnr cid.ID) (engine.ContainerQuota, error) {
+	var q engine.ContainerQuota
+
+	s.mtx.RLock()
+	local, hasLocal := s.containers[cnr]
+	owners := s.owners
+	src := s.cnrSrc
+	s.mtx.RUnlock()
+
+	if hasLocal {
+		q.Container = local
+	}
+
+	if src == nil {
+		if hasLocal {
+			return q, nil
+		}
+		return q, errNoContainerSource
+	}
+
+	c, err := src.Get(cnr)
+	if err != nil {
+		if hasLocal {
+			return q, nil
+		}
+		return q, err
+	}
+
+	q.Owner = c.Value.Owner()
+	q.OwnerLimits = owners[q.Owner.EncodeToString()]
+
+	if !hasLocal {
+		q.Container.Soft = parseQuotaAttribute(c.Value.Attribute(quotaSoftLimitAttribute))
+		q.Container.Hard = parseQuotaAttribute(c.Value.Attribute(quotaHardLimitAttribute))
+	}
+
+	return q, nil
+}
+
+// parseQuotaAttribute returns the limit stored in the attribute value, 0 if it is invalid.
+func parseQuotaAttribute(v string) uint64 {
+	l, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return l
+}
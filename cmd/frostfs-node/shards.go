@@ -0,0 +1,298 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	engineconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine"
+	shardconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+var errNoConfigDir = errors.New("configuration directory is not set, shards configuration can't be persisted")
+
+// shardConfigurator adds and removes the shards of the running node.
+// Every change is persisted as a separate shard_<N>.yaml file in the
+// configuration directory, so it survives a restart or a SIGHUP.
+type shardConfigurator struct {
+	cfg *cfg
+}
+
+// AddShard stores the shard configuration section as the next element of
+// the `storage.shard` section, rereads the configuration and attaches the
+// new shard to the local storage. The configuration file is removed if the
+// shard can't be attached.
+func (s *shardConfigurator) AddShard(data []byte) (*shard.ID, error) {
+	s.cfg.reloadMtx.Lock()
+	defer s.cfg.reloadMtx.Unlock()
+
+	dir := s.cfg.appCfg.ConfigDir()
+	if dir == "" {
+		return nil, errNoConfigDir
+	}
+
+	var section map[string]any
+	if err := yaml.Unmarshal(data, &section); err != nil {
+		return nil, fmt.Errorf("invalid shard configuration: %w", err)
+	}
+	if len(section) == 0 {
+		return nil, errors.New("empty shard configuration")
+	}
+
+	var idx int
+	err := engineconfig.IterateShardSections(s.cfg.appCfg, func(int, *shardconfig.Config) error {
+		idx++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]struct{}, len(s.cfg.EngineCfg.shards))
+	for i := range s.cfg.EngineCfg.shards {
+		known[s.cfg.EngineCfg.shards[i].id()] = struct{}{}
+	}
+
+	p := shardConfigPath(dir, idx)
+	if _, err := os.Stat(p); err == nil {
+		return nil, fmt.Errorf("shard configuration file already exists: %s", p)
+	}
+
+	err = writeShardConfig(p, idx, section)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.attachShard(known)
+	if err != nil {
+		if rmErr := os.Remove(p); rmErr != nil {
+			s.cfg.log.Error("could not remove shard configuration file",
+				zap.String("path", p), zap.Error(rmErr))
+		}
+		s.rereadConfig()
+		return nil, err
+	}
+
+	s.cfg.log.Info("shard has been added",
+		zap.Stringer("id", id), zap.String("config", p))
+
+	return id, nil
+}
+
+func (s *shardConfigurator) attachShard(known map[string]struct{}) (*shard.ID, error) {
+	err := s.readConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []shard.Option
+	for _, sh := range s.cfg.shardOpts() {
+		if _, ok := known[sh.configID]; ok {
+			continue
+		}
+		if opts != nil {
+			return nil, errors.New("more than one new shard is found in the configuration")
+		}
+		opts = sh.shOpts
+	}
+	if opts == nil {
+		return nil, errors.New("shard is disabled or its blobstor paths are already used by another shard")
+	}
+
+	ls := s.cfg.cfgObject.cfgLocalStorage
+	return ls.localStorage.AttachShard(append(opts, shard.WithTombstoneSource(ls.tombstoneSource))...)
+}
+
+// RemoveShards marks the configuration sections of the shards as disabled
+// and detaches the shards from the local storage. The configuration files
+// are restored if the shards can't be detached.
+func (s *shardConfigurator) RemoveShards(ids []*shard.ID) error {
+	s.cfg.reloadMtx.Lock()
+	defer s.cfg.reloadMtx.Unlock()
+
+	dir := s.cfg.appCfg.ConfigDir()
+	if dir == "" {
+		return errNoConfigDir
+	}
+
+	configIDs := make(map[string]string, len(ids))
+	for _, info := range s.cfg.cfgObject.cfgLocalStorage.localStorage.DumpInfo().Shards {
+		configIDs[info.ID.String()] = shardInfoConfigID(info)
+	}
+
+	indexes := make(map[string]int)
+	err := engineconfig.IterateShardSections(s.cfg.appCfg, func(i int, sc *shardconfig.Config) error {
+		indexes[shardSectionConfigID(sc)] = i
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	backups := make(map[string][]byte, len(ids))
+	for _, id := range ids {
+		configID, ok := configIDs[id.String()]
+		if !ok {
+			return fmt.Errorf("shard %s is not found", id)
+		}
+
+		idx, ok := indexes[configID]
+		if !ok {
+			return fmt.Errorf("shard %s is not found in the configuration", id)
+		}
+
+		p := shardConfigPath(dir, idx)
+		if _, ok := backups[p]; ok {
+			continue
+		}
+
+		data, err := os.ReadFile(p)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("read shard configuration file: %w", err)
+		}
+		backups[p] = data
+
+		section, err := readShardConfig(data, idx)
+		if err != nil {
+			s.restoreShardConfigs(backups)
+			return err
+		}
+		section["mode"] = "disabled"
+
+		err = writeShardConfig(p, idx, section)
+		if err != nil {
+			s.restoreShardConfigs(backups)
+			return err
+		}
+	}
+
+	err = s.cfg.cfgObject.cfgLocalStorage.localStorage.DetachShards(ids)
+	if err != nil {
+		s.restoreShardConfigs(backups)
+		return err
+	}
+
+	s.rereadConfig()
+
+	for _, id := range ids {
+		s.cfg.log.Info("shard has been removed", zap.Stringer("id", id))
+	}
+
+	return nil
+}
+
+func (s *shardConfigurator) restoreShardConfigs(backups map[string][]byte) {
+	for p, data := range backups {
+		var err error
+		if data == nil {
+			err = os.Remove(p)
+			if os.IsNotExist(err) {
+				err = nil
+			}
+		} else {
+			err = os.WriteFile(p, data, 0640)
+		}
+		if err != nil {
+			s.cfg.log.Error("could not restore shard configuration file",
+				zap.String("path", p), zap.Error(err))
+		}
+	}
+}
+
+// readConfig rereads the node configuration. Configuration getters
+// panic on invalid values, such panics are returned as errors and the
+// current configuration is kept. Must be called under the reload mutex.
+func (s *shardConfigurator) readConfig() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid configuration: %v", r)
+		}
+	}()
+
+	return s.cfg.readConfig(s.cfg.appCfg)
+}
+
+func (s *shardConfigurator) rereadConfig() {
+	if err := s.readConfig(); err != nil {
+		s.cfg.log.Error("configuration reading", zap.Error(err))
+	}
+}
+
+func shardConfigPath(dir string, idx int) string {
+	return filepath.Join(dir, "shard_"+strconv.Itoa(idx)+".yaml")
+}
+
+// readShardConfig returns the shard section with the specified index
+// from the shard configuration file.
+func readShardConfig(data []byte, idx int) (map[string]any, error) {
+	var file struct {
+		Storage struct {
+			Shard map[string]map[string]any `yaml:"shard"`
+		} `yaml:"storage"`
+	}
+
+	err := yaml.Unmarshal(data, &file)
+	if err != nil {
+		return nil, fmt.Errorf("decode shard configuration file: %w", err)
+	}
+
+	section := file.Storage.Shard[strconv.Itoa(idx)]
+	if section == nil {
+		section = make(map[string]any)
+	}
+	return section, nil
+}
+
+// writeShardConfig atomically writes the shard section as the element
+// of the `storage.shard` section with the specified index.
+func writeShardConfig(p string, idx int, section map[string]any) error {
+	data, err := yaml.Marshal(map[string]any{
+		"storage": map[string]any{
+			"shard": map[string]any{
+				strconv.Itoa(idx): section,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("encode shard configuration: %w", err)
+	}
+
+	tmp := p + ".tmp"
+	err = os.WriteFile(tmp, data, 0640)
+	if err != nil {
+		return fmt.Errorf("write shard configuration file: %w", err)
+	}
+
+	err = os.Rename(tmp, p)
+	if err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("write shard configuration file: %w", err)
+	}
+	return nil
+}
+
+// shardSectionConfigID returns the persistent ID of the shard configured
+// in sc. It must be kept in sync with shardCfg.id().
+func shardSectionConfigID(sc *shardconfig.Config) string {
+	var sb strings.Builder
+	for _, st := range sc.BlobStor().Storages() {
+		sb.WriteString(filepath.Clean(st.Path()))
+	}
+	return sb.String()
+}
+
+// shardInfoConfigID returns the persistent ID of the running shard.
+// It must be kept in sync with shardCfg.id().
+func shardInfoConfigID(info shard.Info) string {
+	var sb strings.Builder
+	for _, sub := range info.BlobStorInfo.SubStorages {
+		sb.WriteString(filepath.Clean(sub.Path))
+	}
+	return sb.String()
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	tracingconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/tracing"
+	"github.com/TrueCloudLab/frostfs-node/misc"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
+	"go.uber.org/zap"
+)
+
+// tracingShutdownTimeout is the time given to export the collected spans on shutdown.
+const tracingShutdownTimeout = 5 * time.Second
+
+func initTracing(c *cfg) {
+	err := tracing.Setup(c.ctx, tracingConfig(c))
+	if err != nil {
+		c.log.Error("failed to init tracing", zap.Error(err))
+	}
+
+	c.onShutdown(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+
+		err := tracing.Shutdown(ctx)
+		if err != nil {
+			c.log.Error("failed to shutdown tracing", zap.Error(err))
+		}
+	})
+}
+
+func reloadTracing(c *cfg) error {
+	return tracing.Setup(c.ctx, tracingConfig(c))
+}
+
+func tracingConfig(c *cfg) tracing.Config {
+	return tracing.Config{
+		Enabled:    tracingconfig.Enabled(c.appCfg),
+		Exporter:   tracingconfig.Exporter(c.appCfg),
+		Endpoint:   tracingconfig.Endpoint(c.appCfg),
+		Insecure:   tracingconfig.Insecure(c.appCfg),
+		Service:    "frostfs-node",
+		InstanceID: hex.EncodeToString(c.key.PublicKey().Bytes()),
+		Version:    misc.Version,
+	}
+}
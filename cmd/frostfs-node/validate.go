@@ -11,6 +11,7 @@ import (
 	treeconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/tree"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/blobovniczatree"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 )
 
@@ -31,6 +32,12 @@ func validateConfig(c *config.Config) error {
 	paths := make(map[string]pathDescription)
 	return engineconfig.IterateShards(c, false, func(sc *shardconfig.Config) error {
 		if sc.WriteCache().Enabled() {
+			switch typ := writecache.Type(sc.WriteCache().Type()); typ {
+			case writecache.TypeBBolt, writecache.TypeLog:
+			default:
+				return fmt.Errorf("unexpected write-cache type: %s (shard %d)", typ, shardNum)
+			}
+
 			err := addPath(paths, "writecache", shardNum, sc.WriteCache().Path())
 			if err != nil {
 				return err
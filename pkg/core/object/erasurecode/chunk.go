@@ -0,0 +1,385 @@
+package erasurecode
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-sdk-go/checksum"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/version"
+	"github.com/TrueCloudLab/tzhash/tz"
+	"github.com/klauspost/reedsolomon"
+)
+
+const (
+	// AttributeParent is a chunk attribute containing the identifier
+	// of the object the chunk belongs to.
+	AttributeParent = objectV2.SysAttributePrefix + "EC_PARENT"
+
+	// AttributeIndex is a chunk attribute containing the index of the chunk.
+	// Indices below the number of data chunks correspond to data chunks.
+	AttributeIndex = objectV2.SysAttributePrefix + "EC_INDEX"
+
+	// AttributePolicy is a chunk attribute containing the policy the
+	// object was encoded with in "K+M" format.
+	AttributePolicy = objectV2.SysAttributePrefix + "EC_POLICY"
+)
+
+// headerLenSize is the size of the parent header length prefix of the chunk payload.
+const headerLenSize = 4
+
+var (
+	// ErrNotEnoughChunks is returned if there are not enough chunks to restore the object.
+	ErrNotEnoughChunks = errors.New("not enough chunks to restore the object")
+
+	errInvalidChunk = errors.New("invalid erasure coded chunk")
+)
+
+// ChunkInfo describes the chunk of an erasure coded object.
+type ChunkInfo struct {
+	// Parent is the identifier of the original object.
+	Parent oid.ID
+
+	// Index is the index of the chunk.
+	Index int
+
+	// Policy is the policy the original object was encoded with.
+	Policy Policy
+}
+
+// IsChunk checks whether the object is a chunk of an erasure coded object.
+func IsChunk(obj *object.Object) bool {
+	for _, a := range obj.Attributes() {
+		if a.Key() == AttributeParent {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadChunkInfo reads chunk information from the object header.
+// Returns false if the object is not an erasure coded chunk.
+func ReadChunkInfo(obj *object.Object) (ChunkInfo, bool, error) {
+	var (
+		info                        ChunkInfo
+		parentSet, indexSet, polSet bool
+		err                         error
+	)
+
+	for _, a := range obj.Attributes() {
+		switch a.Key() {
+		case AttributeParent:
+			if err = info.Parent.DecodeString(a.Value()); err != nil {
+				return ChunkInfo{}, true, fmt.Errorf("%w: invalid parent ID: %v", errInvalidChunk, err)
+			}
+			parentSet = true
+		case AttributeIndex:
+			if info.Index, err = strconv.Atoi(a.Value()); err != nil {
+				return ChunkInfo{}, true, fmt.Errorf("%w: invalid index: %v", errInvalidChunk, err)
+			}
+			indexSet = true
+		case AttributePolicy:
+			if info.Policy, err = ParsePolicy(a.Value()); err != nil {
+				return ChunkInfo{}, true, fmt.Errorf("%w: %v", errInvalidChunk, err)
+			}
+			polSet = true
+		}
+	}
+
+	if !parentSet {
+		return ChunkInfo{}, false, nil
+	}
+
+	if !indexSet || !polSet {
+		return ChunkInfo{}, true, fmt.Errorf("%w: missing index or policy attribute", errInvalidChunk)
+	}
+
+	if info.Index < 0 || info.Index >= info.Policy.Total() {
+		return ChunkInfo{}, true, fmt.Errorf("%w: index %d is out of range for policy %s",
+			errInvalidChunk, info.Index, info.Policy)
+	}
+
+	return info, true, nil
+}
+
+// EncodePrm groups the parameters of Encode operation.
+type EncodePrm struct {
+	// Object is the object to encode. It must have the identifier set.
+	Object *object.Object
+
+	// Policy is the erasure coding policy.
+	Policy Policy
+
+	// Key is the key the chunks are signed with. The chunks are
+	// owned by the owner of the original object.
+	Key *ecdsa.PrivateKey
+
+	// Epoch is the creation epoch of the chunks.
+	Epoch uint64
+
+	// Indices is the list of chunk indices to return.
+	// All chunks are returned if the list is empty.
+	Indices []int
+}
+
+// Encode splits the object into data and parity chunks according to the policy.
+// Each chunk is a regular object of the same container carrying the header of
+// the original object and the corresponding part of the encoded payload.
+// The header of the original object is also set as the parent header of the
+// chunk, so the original object is indexed as a root one like a split object.
+// Owner and expiration epoch of the original object are inherited by the chunks.
+func Encode(prm EncodePrm) ([]*object.Object, error) {
+	if err := prm.Policy.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidPolicy, err)
+	}
+
+	parent := prm.Object
+
+	id, ok := parent.ID()
+	if !ok {
+		return nil, errors.New("missing object ID")
+	}
+
+	cnr, ok := parent.ContainerID()
+	if !ok {
+		return nil, errors.New("missing container ID")
+	}
+
+	owner := parent.OwnerID()
+	if owner == nil {
+		return nil, errors.New("missing object owner")
+	}
+
+	parentHdr := parent.CutPayload()
+
+	hdr, err := parent.CutPayload().Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal object header: %w", err)
+	}
+
+	shards, err := encodePayload(prm.Policy, parent.Payload())
+	if err != nil {
+		return nil, err
+	}
+
+	indices := prm.Indices
+	if len(indices) == 0 {
+		indices = make([]int, prm.Policy.Total())
+		for i := range indices {
+			indices[i] = i
+		}
+	}
+
+	_, withTZ := parent.PayloadHomomorphicHash()
+
+	var expAttr *object.Attribute
+	for _, a := range parent.Attributes() {
+		if a.Key() == objectV2.SysAttributeExpEpoch {
+			a := a
+			expAttr = &a
+			break
+		}
+	}
+
+	chunks := make([]*object.Object, 0, len(indices))
+	for _, i := range indices {
+		if i < 0 || i >= len(shards) {
+			return nil, fmt.Errorf("chunk index %d is out of range for policy %s", i, prm.Policy)
+		}
+
+		payload := make([]byte, headerLenSize+len(hdr)+len(shards[i]))
+		binary.BigEndian.PutUint32(payload, uint32(len(hdr)))
+		copy(payload[headerLenSize:], hdr)
+		copy(payload[headerLenSize+len(hdr):], shards[i])
+
+		attrs := make([]object.Attribute, 3, 4)
+		attrs[0].SetKey(AttributeParent)
+		attrs[0].SetValue(id.EncodeToString())
+		attrs[1].SetKey(AttributeIndex)
+		attrs[1].SetValue(strconv.Itoa(i))
+		attrs[2].SetKey(AttributePolicy)
+		attrs[2].SetValue(prm.Policy.String())
+		if expAttr != nil {
+			attrs = append(attrs, *expAttr)
+		}
+
+		ver := version.Current()
+
+		chunk := object.New()
+		chunk.SetVersion(&ver)
+		chunk.SetContainerID(cnr)
+		chunk.SetOwnerID(owner)
+		chunk.SetCreationEpoch(prm.Epoch)
+		chunk.SetType(object.TypeRegular)
+		chunk.SetAttributes(attrs...)
+		chunk.SetParent(parentHdr)
+		chunk.SetPayload(payload)
+		chunk.SetPayloadSize(uint64(len(payload)))
+		object.CalculateAndSetPayloadChecksum(chunk)
+
+		if withTZ {
+			var cs checksum.Checksum
+			cs.SetTillichZemor(tz.Sum(payload))
+			chunk.SetPayloadHomomorphicHash(cs)
+		}
+
+		if err := object.SetIDWithSignature(*prm.Key, chunk); err != nil {
+			return nil, fmt.Errorf("could not sign chunk #%d: %w", i, err)
+		}
+
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks, nil
+}
+
+func encodePayload(p Policy, payload []byte) ([][]byte, error) {
+	enc, err := reedsolomon.New(p.DataChunks, p.ParityChunks)
+	if err != nil {
+		return nil, fmt.Errorf("could not create encoder: %w", err)
+	}
+
+	if len(payload) == 0 {
+		return make([][]byte, p.Total()), nil
+	}
+
+	// Split can use the spare capacity of the slice, so copy the payload
+	// to avoid corrupting the original object.
+	data := make([]byte, len(payload))
+	copy(data, payload)
+
+	shards, err := enc.Split(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not split payload: %w", err)
+	}
+
+	if err := enc.Encode(shards); err != nil {
+		return nil, fmt.Errorf("could not encode payload: %w", err)
+	}
+
+	return shards, nil
+}
+
+// ParentHeader returns the header of the original object stored in the chunk.
+func ParentHeader(chunk *object.Object) (*object.Object, error) {
+	hdr, _, err := splitPayload(chunk.Payload())
+	if err != nil {
+		return nil, err
+	}
+
+	parent := object.New()
+	if err := parent.Unmarshal(hdr); err != nil {
+		return nil, fmt.Errorf("%w: could not unmarshal parent header: %v", errInvalidChunk, err)
+	}
+
+	return parent, nil
+}
+
+func splitPayload(payload []byte) ([]byte, []byte, error) {
+	if len(payload) < headerLenSize {
+		return nil, nil, fmt.Errorf("%w: payload is too short", errInvalidChunk)
+	}
+
+	n := binary.BigEndian.Uint32(payload)
+	if uint64(n) > uint64(len(payload)-headerLenSize) {
+		return nil, nil, fmt.Errorf("%w: parent header length %d exceeds payload", errInvalidChunk, n)
+	}
+
+	return payload[headerLenSize : headerLenSize+n], payload[headerLenSize+n:], nil
+}
+
+// Decode restores the original object from the chunks. At least the number of
+// data chunks with distinct indices must be provided. Chunks of other objects
+// and duplicates are ignored. The identifier and payload checksum of the
+// restored object are verified.
+func Decode(parent oid.ID, chunks []*object.Object) (*object.Object, error) {
+	var (
+		pol    Policy
+		shards [][]byte
+		hdr    *object.Object
+		have   int
+	)
+
+	for _, chunk := range chunks {
+		info, ok, err := ReadChunkInfo(chunk)
+		if err != nil || !ok || !info.Parent.Equals(parent) {
+			continue
+		}
+
+		if shards == nil {
+			pol = info.Policy
+			shards = make([][]byte, pol.Total())
+		} else if info.Policy != pol {
+			continue
+		}
+
+		if shards[info.Index] != nil {
+			continue
+		}
+
+		h, data, err := splitPayload(chunk.Payload())
+		if err != nil {
+			continue
+		}
+
+		if hdr == nil {
+			hdr = object.New()
+			if err := hdr.Unmarshal(h); err != nil {
+				hdr = nil
+				continue
+			}
+		}
+
+		shards[info.Index] = data
+		have++
+	}
+
+	if hdr == nil || have < pol.DataChunks {
+		return nil, fmt.Errorf("%w: have %d, need %d", ErrNotEnoughChunks, have, pol.DataChunks)
+	}
+
+	size := hdr.PayloadSize()
+	payload := make([]byte, 0, size)
+
+	if size > 0 {
+		enc, err := reedsolomon.New(pol.DataChunks, pol.ParityChunks)
+		if err != nil {
+			return nil, fmt.Errorf("could not create decoder: %w", err)
+		}
+
+		if err := enc.ReconstructData(shards); err != nil {
+			return nil, fmt.Errorf("could not reconstruct payload: %w", err)
+		}
+
+		for i := 0; i < pol.DataChunks; i++ {
+			payload = append(payload, shards[i]...)
+		}
+
+		if uint64(len(payload)) < size {
+			return nil, fmt.Errorf("%w: restored payload is shorter than declared", errInvalidChunk)
+		}
+
+		payload = payload[:size]
+	}
+
+	hdr.SetPayload(payload)
+
+	if err := object.VerifyID(hdr); err != nil {
+		return nil, fmt.Errorf("restored object has invalid ID: %w", err)
+	}
+
+	if id, _ := hdr.ID(); !id.Equals(parent) {
+		return nil, fmt.Errorf("restored object ID mismatch: expected %s, got %s", parent, id)
+	}
+
+	if err := object.VerifyPayloadChecksum(hdr); err != nil {
+		return nil, fmt.Errorf("restored object has invalid payload: %w", err)
+	}
+
+	return hdr, nil
+}
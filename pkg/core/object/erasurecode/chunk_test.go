@@ -0,0 +1,164 @@
+package erasurecode
+
+import (
+	"crypto/rand"
+	"testing"
+
+	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePolicy(t *testing.T) {
+	p, err := ParsePolicy("4+2")
+	require.NoError(t, err)
+	require.Equal(t, Policy{DataChunks: 4, ParityChunks: 2}, p)
+	require.Equal(t, 6, p.Total())
+	require.Equal(t, "4+2", p.String())
+
+	for _, s := range []string{"", "4", "4+", "+2", "a+2", "0+2", "4+0", "-1+2", "200+100"} {
+		_, err := ParsePolicy(s)
+		require.Error(t, err, s)
+	}
+}
+
+func testObject(t *testing.T, key *keys.PrivateKey, size int) *object.Object {
+	var owner user.ID
+	user.IDFromKey(&owner, key.PrivateKey.PublicKey)
+
+	payload := make([]byte, size)
+	_, _ = rand.Read(payload)
+
+	var exp object.Attribute
+	exp.SetKey(objectV2.SysAttributeExpEpoch)
+	exp.SetValue("100")
+
+	obj := object.New()
+	obj.SetContainerID(cidtest.ID())
+	obj.SetOwnerID(&owner)
+	obj.SetAttributes(exp)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(size))
+	object.CalculateAndSetPayloadChecksum(obj)
+	require.NoError(t, object.SetIDWithSignature(key.PrivateKey, obj))
+
+	return obj
+}
+
+func TestEncodeDecode(t *testing.T) {
+	ownerKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	nodeKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	pol := Policy{DataChunks: 3, ParityChunks: 2}
+
+	for _, size := range []int{0, 1, 1000, 1024} {
+		obj := testObject(t, ownerKey, size)
+		id, _ := obj.ID()
+
+		chunks, err := Encode(EncodePrm{
+			Object: obj,
+			Policy: pol,
+			Key:    &nodeKey.PrivateKey,
+			Epoch:  10,
+		})
+		require.NoError(t, err)
+		require.Len(t, chunks, pol.Total())
+
+		for i, chunk := range chunks {
+			require.NoError(t, object.CheckVerificationFields(chunk))
+			require.Equal(t, uint64(10), chunk.CreationEpoch())
+
+			info, ok, err := ReadChunkInfo(chunk)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Equal(t, i, info.Index)
+			require.Equal(t, pol, info.Policy)
+			require.Equal(t, id, info.Parent)
+			require.True(t, IsChunk(chunk))
+
+			hdr, err := ParentHeader(chunk)
+			require.NoError(t, err)
+			require.Equal(t, obj.CutPayload(), hdr)
+
+			// chunk is owned by the object owner and carries its header
+			require.Equal(t, obj.OwnerID(), chunk.OwnerID())
+			require.Equal(t, obj.CutPayload(), chunk.Parent())
+
+			var hasExp bool
+			for _, a := range chunk.Attributes() {
+				hasExp = hasExp || a.Key() == objectV2.SysAttributeExpEpoch && a.Value() == "100"
+			}
+			require.True(t, hasExp)
+		}
+
+		// any DataChunks chunks are enough
+		for _, subset := range [][]int{{0, 1, 2}, {2, 3, 4}, {0, 4, 2}, {4, 3, 1, 1}} {
+			var in []*object.Object
+			for _, i := range subset {
+				in = append(in, chunks[i])
+			}
+
+			res, err := Decode(id, in)
+			require.NoError(t, err, subset)
+			require.Equal(t, obj, res)
+		}
+
+		_, err = Decode(id, []*object.Object{chunks[0], chunks[3], chunks[3]})
+		require.ErrorIs(t, err, ErrNotEnoughChunks)
+	}
+}
+
+func TestEncodeIndices(t *testing.T) {
+	ownerKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	obj := testObject(t, ownerKey, 100)
+	prm := EncodePrm{
+		Object: obj,
+		Policy: Policy{DataChunks: 2, ParityChunks: 2},
+		Key:    &ownerKey.PrivateKey,
+	}
+
+	all, err := Encode(prm)
+	require.NoError(t, err)
+
+	prm.Indices = []int{3, 1}
+	some, err := Encode(prm)
+	require.NoError(t, err)
+	require.Len(t, some, 2)
+
+	// signatures are randomized, so compare identifiers only
+	for i, j := range prm.Indices {
+		exp, _ := all[j].ID()
+		act, _ := some[i].ID()
+		require.Equal(t, exp, act)
+	}
+
+	prm.Indices = []int{4}
+	_, err = Encode(prm)
+	require.Error(t, err)
+}
+
+func TestReadChunkInfo(t *testing.T) {
+	obj := object.New()
+
+	_, ok, err := ReadChunkInfo(obj)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.False(t, IsChunk(obj))
+
+	attrs := make([]object.Attribute, 3)
+	attrs[0].SetKey(AttributeParent)
+	attrs[0].SetValue("not an ID")
+	obj.SetAttributes(attrs[0])
+
+	_, ok, err = ReadChunkInfo(obj)
+	require.Error(t, err)
+	require.True(t, ok)
+}
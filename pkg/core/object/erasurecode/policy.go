@@ -0,0 +1,95 @@
+package erasurecode
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
+)
+
+// AttributeContainerPolicy is a container attribute which enables erasure
+// coding of the container objects. The value has "K+M" format, where K is
+// the number of data chunks and M is the number of parity chunks, e.g. "4+2".
+const AttributeContainerPolicy = objectV2.SysAttributePrefix + "ERASURE_CODE"
+
+// MaxChunks is the maximum total number of chunks of a single object.
+const MaxChunks = 256
+
+// Policy describes Reed-Solomon erasure coding parameters.
+type Policy struct {
+	// DataChunks is the number of chunks the payload is split to.
+	// Any DataChunks chunks are enough to restore the object.
+	DataChunks int
+
+	// ParityChunks is the number of chunks holding the parity data.
+	// Up to ParityChunks chunks can be lost without the data loss.
+	ParityChunks int
+}
+
+var errInvalidPolicy = errors.New("invalid erasure coding policy")
+
+// ParsePolicy parses the policy from "K+M" string.
+func ParsePolicy(s string) (Policy, error) {
+	data, parity, ok := strings.Cut(s, "+")
+	if !ok {
+		return Policy{}, fmt.Errorf("%w: %q: missing '+' separator", errInvalidPolicy, s)
+	}
+
+	k, err := strconv.Atoi(strings.TrimSpace(data))
+	if err != nil {
+		return Policy{}, fmt.Errorf("%w: %q: invalid data chunk number: %v", errInvalidPolicy, s, err)
+	}
+
+	m, err := strconv.Atoi(strings.TrimSpace(parity))
+	if err != nil {
+		return Policy{}, fmt.Errorf("%w: %q: invalid parity chunk number: %v", errInvalidPolicy, s, err)
+	}
+
+	p := Policy{DataChunks: k, ParityChunks: m}
+	if err := p.validate(); err != nil {
+		return Policy{}, fmt.Errorf("%w: %q: %v", errInvalidPolicy, s, err)
+	}
+
+	return p, nil
+}
+
+func (p Policy) validate() error {
+	switch {
+	case p.DataChunks <= 0:
+		return errors.New("data chunk number must be positive")
+	case p.ParityChunks <= 0:
+		return errors.New("parity chunk number must be positive")
+	case p.Total() > MaxChunks:
+		return fmt.Errorf("total chunk number must not exceed %d", MaxChunks)
+	}
+	return nil
+}
+
+// Total returns the total number of chunks of an object.
+func (p Policy) Total() int {
+	return p.DataChunks + p.ParityChunks
+}
+
+// String implements fmt.Stringer.
+func (p Policy) String() string {
+	return strconv.Itoa(p.DataChunks) + "+" + strconv.Itoa(p.ParityChunks)
+}
+
+// ContainerPolicy returns erasure coding policy of the container.
+// Returns false if erasure coding is not enabled for the container.
+func ContainerPolicy(cnr containerSDK.Container) (Policy, bool, error) {
+	v := cnr.Attribute(AttributeContainerPolicy)
+	if v == "" {
+		return Policy{}, false, nil
+	}
+
+	p, err := ParsePolicy(v)
+	if err != nil {
+		return Policy{}, false, err
+	}
+
+	return p, true, nil
+}
@@ -9,6 +9,7 @@ import (
 	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	"github.com/TrueCloudLab/frostfs-api-go/v2/refs"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	frostfsecdsa "github.com/TrueCloudLab/frostfs-sdk-go/crypto/ecdsa"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
@@ -150,6 +151,10 @@ func (v *FormatValidator) validateSignatureKey(obj *object.Object) error {
 	token := obj.SessionToken()
 
 	if token == nil || !token.AssertAuthKey(&key) {
+		if isErasureCodedChunk(obj) {
+			return nil
+		}
+
 		return v.checkOwnerKey(*obj.OwnerID(), key)
 	}
 
@@ -158,6 +163,31 @@ func (v *FormatValidator) validateSignatureKey(obj *object.Object) error {
 	return nil
 }
 
+// isErasureCodedChunk checks whether the object is a chunk of the erasure coded
+// object. Chunks are signed by the storage node encoding the object, but are
+// owned by the owner of the original object carried in the parent header. The
+// parent header is validated separately.
+func isErasureCodedChunk(obj *object.Object) bool {
+	par := obj.Parent()
+	if par == nil {
+		return false
+	}
+
+	info, ok, err := erasurecode.ReadChunkInfo(obj)
+	if err != nil || !ok {
+		return false
+	}
+
+	parID, ok := par.ID()
+	if !ok || !parID.Equals(info.Parent) {
+		return false
+	}
+
+	parOwner := par.OwnerID()
+
+	return parOwner != nil && parOwner.Equals(*obj.OwnerID())
+}
+
 func (v *FormatValidator) checkOwnerKey(id user.ID, key frostfsecdsa.PublicKey) error {
 	var id2 user.ID
 	user.IDFromKey(&id2, (ecdsa.PublicKey)(key))
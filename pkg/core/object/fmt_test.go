@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
 	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
@@ -13,6 +14,7 @@ import (
 	sessiontest "github.com/TrueCloudLab/frostfs-sdk-go/session/test"
 	"github.com/TrueCloudLab/frostfs-sdk-go/storagegroup"
 	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	usertest "github.com/TrueCloudLab/frostfs-sdk-go/user/test"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/stretchr/testify/require"
 )
@@ -99,6 +101,32 @@ func TestFormatValidator_Validate(t *testing.T) {
 		require.NoError(t, v.Validate(obj, false))
 	})
 
+	t.Run("erasure coded chunk", func(t *testing.T) {
+		nodeKey, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+
+		parent := blankValidObject(&ownerKey.PrivateKey)
+		parent.SetPayload([]byte("payload"))
+		parent.SetPayloadSize(7)
+		require.NoError(t, object.SetIDWithSignature(ownerKey.PrivateKey, parent))
+
+		chunks, err := erasurecode.Encode(erasurecode.EncodePrm{
+			Object: parent,
+			Policy: erasurecode.Policy{DataChunks: 2, ParityChunks: 1},
+			Key:    &nodeKey.PrivateKey,
+		})
+		require.NoError(t, err)
+
+		// chunk is signed by the node but owned by the object owner
+		require.NoError(t, v.Validate(chunks[0], false))
+
+		// owner of the chunk must be the owner of the parent
+		chunk := chunks[1]
+		chunk.SetOwnerID(usertest.ID())
+		require.NoError(t, object.SetIDWithSignature(nodeKey.PrivateKey, chunk))
+		require.Error(t, v.Validate(chunk, false))
+	})
+
 	t.Run("tombstone content", func(t *testing.T) {
 		obj := object.New()
 		obj.SetType(object.TypeTombstone)
@@ -76,6 +76,10 @@ type Blobovniczas struct {
 	// list of active (opened, non-filled) Blobovniczas
 	activeMtx sync.RWMutex
 	active    map[string]blobovniczaWithIndex
+
+	// Blobovniczas being rebuilt, they are never activated.
+	// Protected by lruMtx.
+	rebuilding map[string]*blobovnicza.Blobovnicza
 }
 
 type blobovniczaWithIndex struct {
@@ -126,6 +130,7 @@ func NewBlobovniczaTree(opts ...Option) (blz *Blobovniczas) {
 
 	blz.opened = cache
 	blz.active = make(map[string]blobovniczaWithIndex, cp)
+	blz.rebuilding = make(map[string]*blobovnicza.Blobovnicza)
 
 	return blz
 }
@@ -174,6 +179,13 @@ func (b *Blobovniczas) updateAndGet(lvlPath string, old *uint64) (blobovniczaWit
 		active.ind++
 	}
 
+	for b.isRebuilding(filepath.Join(lvlPath, u64ToHexString(active.ind))) {
+		if active.ind == b.blzShallowWidth-1 {
+			return active, logicerr.New("no more Blobovniczas")
+		}
+		active.ind++
+	}
+
 	var err error
 	if active.blz, err = b.openBlobovnicza(filepath.Join(lvlPath, u64ToHexString(active.ind))); err != nil {
 		return active, err
@@ -188,12 +200,18 @@ func (b *Blobovniczas) updateAndGet(lvlPath string, old *uint64) (blobovniczaWit
 		return tryActive, nil
 	}
 
+	activePath := filepath.Join(lvlPath, u64ToHexString(active.ind))
+
+	// rebuild could have been started while the blobovnicza was being opened
+	if b.isRebuilding(activePath) {
+		return active, logicerr.New("blobovnicza is being rebuilt")
+	}
+
 	// Remove from opened cache (active blobovnicza should always be opened).
 	// Because `onEvict` callback is called in `Remove`, we need to update
 	// active map beforehand.
 	b.active[lvlPath] = active
 
-	activePath := filepath.Join(lvlPath, u64ToHexString(active.ind))
 	b.lruMtx.Lock()
 	b.opened.Remove(activePath)
 	if ok {
@@ -67,7 +67,17 @@ func (b *Blobovniczas) Close() error {
 		b.opened.Remove(k)
 	}
 
+	for p, blz := range b.rebuilding {
+		if err := blz.Close(); err != nil {
+			b.log.Debug("could not close rebuilt blobovnicza",
+				zap.String("path", p),
+				zap.String("error", err.Error()),
+			)
+		}
+	}
+
 	b.active = make(map[string]blobovniczaWithIndex)
+	b.rebuilding = make(map[string]*blobovnicza.Blobovnicza)
 
 	b.lruMtx.Unlock()
 
@@ -107,6 +117,11 @@ func (b *Blobovniczas) openBlobovnicza(p string) (*blobovnicza.Blobovnicza, erro
 		return v, nil
 	}
 
+	v, ok = b.rebuilding[p]
+	if ok {
+		return v, nil
+	}
+
 	blz, err := b.openBlobovniczaNoCache(p)
 	if err != nil {
 		return nil, err
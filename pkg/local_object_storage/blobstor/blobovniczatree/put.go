@@ -19,7 +19,7 @@ func (b *Blobovniczas) Put(prm common.PutPrm) (common.PutRes, error) {
 	}
 
 	if !prm.DontCompress {
-		prm.RawData = b.compression.Compress(prm.RawData)
+		prm.RawData = b.compression.Compress(prm.Object, prm.RawData)
 	}
 
 	var putPrm blobovnicza.PutPrm
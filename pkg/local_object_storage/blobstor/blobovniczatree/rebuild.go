@@ -0,0 +1,348 @@
+package blobovniczatree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// Rebuild moves objects out of the Blobovniczas which either don't match
+// the current tree configuration (e.g. after width or depth has been changed)
+// or contain less live data than prm.FillPercent of their file size.
+// Objects are saved as new ones, their storage IDs are updated in
+// prm.MetaStorage and the source Blobovniczas are removed afterwards,
+// so disk space occupied by deleted objects is returned.
+//
+// Active Blobovniczas are never rebuilt. Objects remain available for
+// reading during the rebuild.
+func (b *Blobovniczas) Rebuild(ctx context.Context, prm common.RebuildPrm) (common.RebuildRes, error) {
+	var res common.RebuildRes
+
+	if b.readOnly {
+		return res, common.ErrReadOnly
+	}
+
+	paths, err := b.listBlobovniczas()
+	if err != nil {
+		return res, fmt.Errorf("could not list blobovniczas: %w", err)
+	}
+
+	for _, p := range paths {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+
+		moved, removed, err := b.rebuildBlobovnicza(ctx, p, prm)
+		res.ObjectsMoved += moved
+		if err != nil {
+			return res, fmt.Errorf("could not rebuild blobovnicza %s: %w", p, err)
+		}
+		if removed {
+			res.FilesRemoved++
+		}
+	}
+
+	return res, nil
+}
+
+// listBlobovniczas returns the paths of all Blobovnicza files
+// relative to the tree root, including the ones which don't match
+// the current configuration.
+func (b *Blobovniczas) listBlobovniczas() ([]string, error) {
+	var paths []string
+
+	err := filepath.WalkDir(b.rootPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && p == b.rootPath {
+				return filepath.SkipDir
+			}
+			return err
+		}
+
+		if p == b.rootPath {
+			return nil
+		}
+
+		if _, err := strconv.ParseUint(d.Name(), 16, 64); err != nil {
+			// not a part of the tree
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if d.Type().IsRegular() {
+			rel, err := filepath.Rel(b.rootPath, p)
+			if err != nil {
+				return err
+			}
+			paths = append(paths, rel)
+		}
+		return nil
+	})
+
+	return paths, err
+}
+
+// isValidPath checks whether Blobovnicza at path p can be
+// selected for new objects with the current configuration.
+func (b *Blobovniczas) isValidPath(p string) bool {
+	parts := strings.Split(filepath.ToSlash(p), "/")
+	if uint64(len(parts)) != b.blzShallowDepth+1 {
+		return false
+	}
+
+	for i := range parts {
+		ind, err := strconv.ParseUint(parts[i], 16, 64)
+		if err != nil || ind >= b.blzShallowWidth {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *Blobovniczas) rebuildBlobovnicza(ctx context.Context, p string, prm common.RebuildPrm) (uint64, bool, error) {
+	valid := b.isValidPath(p)
+	if valid && prm.FillPercent <= 0 {
+		return 0, false, nil
+	}
+
+	blz, err := b.startRebuild(p, valid)
+	if err != nil || blz == nil {
+		return 0, false, err
+	}
+
+	if valid {
+		fill, err := b.fillPercent(p, blz)
+		if err != nil {
+			b.finishRebuild(p, false, false)
+			return 0, false, err
+		}
+
+		if fill >= prm.FillPercent {
+			b.finishRebuild(p, false, false)
+			return 0, false, nil
+		}
+
+		b.log.Debug("rebuilding sparse blobovnicza",
+			zap.String("path", p),
+			zap.Int("fill percent", fill))
+	} else {
+		b.log.Debug("rebuilding blobovnicza not matching the configuration",
+			zap.String("path", p))
+	}
+
+	var addrs []oid.Address
+	err = blobovnicza.IterateAddresses(blz, func(addr oid.Address) error {
+		addrs = append(addrs, addr)
+		return nil
+	})
+	if err != nil {
+		b.finishRebuild(p, false, false)
+		return 0, false, fmt.Errorf("could not list objects: %w", err)
+	}
+
+	var moved uint64
+	for i := range addrs {
+		if err := ctx.Err(); err != nil {
+			b.finishRebuild(p, false, false)
+			return moved, false, err
+		}
+
+		ok, err := b.moveObject(blz, addrs[i], prm.MetaStorage)
+		if err != nil {
+			b.finishRebuild(p, false, false)
+			return moved, false, fmt.Errorf("could not move object %s: %w", addrs[i], err)
+		}
+		if ok {
+			moved++
+		}
+	}
+
+	b.finishRebuild(p, true, !valid)
+
+	b.log.Debug("blobovnicza has been rebuilt",
+		zap.String("path", p),
+		zap.Uint64("moved objects", moved))
+
+	return moved, true, nil
+}
+
+// startRebuild marks Blobovnicza at path p as being rebuilt, so it is never
+// activated, and returns its instance. Returns nil if the Blobovnicza is active.
+func (b *Blobovniczas) startRebuild(p string, valid bool) (*blobovnicza.Blobovnicza, error) {
+	lvlPath := filepath.Dir(p)
+
+	b.activeMtx.Lock()
+	defer b.activeMtx.Unlock()
+
+	if valid {
+		active, ok := b.active[lvlPath]
+		if ok && active.ind == u64FromHexString(filepath.Base(p)) {
+			return nil, nil
+		}
+	}
+
+	b.lruMtx.Lock()
+	defer b.lruMtx.Unlock()
+
+	// closed on eviction
+	b.opened.Remove(p)
+
+	blz, err := b.openBlobovniczaNoCache(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// Blobovniczas not matching the configuration were not initialized
+	// on startup, also object size limit could have been changed.
+	if err := blz.Init(); err != nil {
+		_ = blz.Close()
+		return nil, fmt.Errorf("could not initialize blobovnicza: %w", err)
+	}
+
+	b.rebuilding[p] = blz
+	return blz, nil
+}
+
+// isRebuilding checks whether Blobovnicza at path p is being rebuilt.
+func (b *Blobovniczas) isRebuilding(p string) bool {
+	b.lruMtx.Lock()
+	defer b.lruMtx.Unlock()
+
+	_, ok := b.rebuilding[p]
+	return ok
+}
+
+// finishRebuild closes Blobovnicza at path p and removes its file if remove is true.
+// If removeDirs is true, empty parent directories are removed as well.
+func (b *Blobovniczas) finishRebuild(p string, remove bool, removeDirs bool) {
+	b.lruMtx.Lock()
+	defer b.lruMtx.Unlock()
+
+	blz := b.rebuilding[p]
+	delete(b.rebuilding, p)
+
+	if err := blz.Close(); err != nil {
+		b.log.Debug("could not close rebuilt blobovnicza",
+			zap.String("path", p),
+			zap.String("error", err.Error()))
+	}
+
+	if !remove {
+		return
+	}
+
+	if err := os.Remove(filepath.Join(b.rootPath, p)); err != nil {
+		b.log.Warn("could not remove rebuilt blobovnicza",
+			zap.String("path", p),
+			zap.String("error", err.Error()))
+		return
+	}
+
+	for dir := filepath.Dir(p); removeDirs && dir != "."; dir = filepath.Dir(dir) {
+		if os.Remove(filepath.Join(b.rootPath, dir)) != nil {
+			// not empty
+			break
+		}
+	}
+}
+
+// fillPercent returns the percentage of live data in the Blobovnicza file.
+func (b *Blobovniczas) fillPercent(p string, blz *blobovnicza.Blobovnicza) (int, error) {
+	info, err := os.Stat(filepath.Join(b.rootPath, p))
+	if err != nil {
+		return 0, err
+	}
+	if info.Size() == 0 {
+		return 0, nil
+	}
+
+	var size uint64
+
+	var prm blobovnicza.IteratePrm
+	prm.SetHandler(func(elem blobovnicza.IterationElement) error {
+		size += uint64(len(elem.ObjectData()))
+		return nil
+	})
+
+	if _, err := blz.Iterate(prm); err != nil {
+		return 0, fmt.Errorf("could not calculate data size: %w", err)
+	}
+
+	return int(size * 100 / uint64(info.Size())), nil
+}
+
+// moveObject saves the object from the source Blobovnicza in the tree,
+// updates its storage ID and removes it from the source.
+// Returns false if the object was not moved.
+func (b *Blobovniczas) moveObject(src *blobovnicza.Blobovnicza, addr oid.Address, ms common.MetaStorage) (bool, error) {
+	var getPrm blobovnicza.GetPrm
+	getPrm.SetAddress(addr)
+
+	res, err := src.Get(getPrm)
+	if err != nil {
+		if blobovnicza.IsErrNotFound(err) {
+			// removed concurrently
+			return false, nil
+		}
+		return false, err
+	}
+
+	// data is stored compressed already
+	putRes, err := b.Put(common.PutPrm{
+		Address:      addr,
+		RawData:      res.Object(),
+		DontCompress: true,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	moved := true
+
+	err = ms.UpdateStorageID(addr, putRes.StorageID)
+	if err != nil {
+		if !errors.As(err, new(apistatus.ObjectNotFound)) && !errors.As(err, new(apistatus.ObjectAlreadyRemoved)) {
+			b.deleteMoved(addr, putRes.StorageID)
+			return false, fmt.Errorf("could not update storage ID: %w", err)
+		}
+
+		// object is going to be removed, there is no need to keep it
+		b.deleteMoved(addr, putRes.StorageID)
+		moved = false
+	}
+
+	var delPrm blobovnicza.DeletePrm
+	delPrm.SetAddress(addr)
+
+	if _, err := src.Delete(delPrm); err != nil && !blobovnicza.IsErrNotFound(err) {
+		return moved, fmt.Errorf("could not delete object from the source: %w", err)
+	}
+
+	return moved, nil
+}
+
+func (b *Blobovniczas) deleteMoved(addr oid.Address, storageID []byte) {
+	_, err := b.Delete(common.DeletePrm{
+		Address:   addr,
+		StorageID: storageID,
+	})
+	if err != nil {
+		b.log.Warn("could not delete moved object",
+			zap.Stringer("address", addr),
+			zap.String("storage ID", string(storageID)),
+			zap.String("error", err.Error()))
+	}
+}
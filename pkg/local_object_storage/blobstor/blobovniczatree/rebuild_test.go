@@ -0,0 +1,163 @@
+package blobovniczatree
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/internal/blobstortest"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type storageIDMap struct {
+	ids     map[oid.Address][]byte
+	removed map[oid.Address]struct{}
+}
+
+func (m *storageIDMap) UpdateStorageID(addr oid.Address, storageID []byte) error {
+	if _, ok := m.removed[addr]; ok {
+		return logicerr.Wrap(apistatus.ObjectAlreadyRemoved{})
+	}
+	m.ids[addr] = storageID
+	return nil
+}
+
+func newRebuildTree(t *testing.T, dir string, width uint64) *Blobovniczas {
+	b := NewBlobovniczaTree(
+		WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+		WithObjectSizeLimit(2048),
+		WithBlobovniczaShallowWidth(width),
+		WithBlobovniczaShallowDepth(1),
+		WithRootPath(dir),
+		WithBlobovniczaSize(16*1024))
+	require.NoError(t, b.Open(false))
+	require.NoError(t, b.Init())
+	return b
+}
+
+func putRebuildObjects(t *testing.T, b *Blobovniczas, count int, ms *storageIDMap) map[oid.Address][]byte {
+	data := make(map[oid.Address][]byte, count)
+	for i := 0; i < count; i++ {
+		obj := blobstortest.NewObject(1024)
+		addr := object.AddressOf(obj)
+		d, err := obj.Marshal()
+		require.NoError(t, err)
+
+		res, err := b.Put(common.PutPrm{Address: addr, RawData: d, DontCompress: true})
+		require.NoError(t, err)
+
+		ms.ids[addr] = res.StorageID
+		data[addr] = d
+	}
+	return data
+}
+
+func checkRebuiltObjects(t *testing.T, b *Blobovniczas, data map[oid.Address][]byte, ms *storageIDMap) {
+	for addr, d := range data {
+		res, err := b.Get(common.GetPrm{Address: addr, StorageID: ms.ids[addr]})
+		require.NoError(t, err)
+
+		raw, err := res.Object.Marshal()
+		require.NoError(t, err)
+		require.Equal(t, d, raw)
+	}
+}
+
+func TestRebuild(t *testing.T) {
+	t.Run("sparse", func(t *testing.T) {
+		dir := t.TempDir()
+		b := newRebuildTree(t, dir, 4)
+		defer func() { require.NoError(t, b.Close()) }()
+
+		ms := &storageIDMap{ids: make(map[oid.Address][]byte), removed: make(map[oid.Address]struct{})}
+		data := putRebuildObjects(t, b, 100, ms)
+
+		// remove most of the objects and mark some of them as removed in metabase
+		// without removing from the storage
+		var i int
+		for addr := range data {
+			switch {
+			case i%5 == 0:
+			case i%5 == 1:
+				ms.removed[addr] = struct{}{}
+				delete(data, addr)
+			default:
+				_, err := b.Delete(common.DeletePrm{Address: addr, StorageID: ms.ids[addr]})
+				require.NoError(t, err)
+				delete(data, addr)
+			}
+			i++
+		}
+
+		before, err := b.listBlobovniczas()
+		require.NoError(t, err)
+
+		res, err := b.Rebuild(context.Background(), common.RebuildPrm{MetaStorage: ms})
+		require.NoError(t, err)
+		require.Equal(t, common.RebuildRes{}, res, "only misconfigured blobovniczas must be rebuilt by default")
+
+		res, err = b.Rebuild(context.Background(), common.RebuildPrm{MetaStorage: ms, FillPercent: 50})
+		require.NoError(t, err)
+		require.NotZero(t, res.FilesRemoved)
+		require.LessOrEqual(t, res.ObjectsMoved, uint64(len(data)))
+
+		after, err := b.listBlobovniczas()
+		require.NoError(t, err)
+		require.Less(t, len(after), len(before))
+
+		checkRebuiltObjects(t, b, data, ms)
+
+		// objects marked as removed must not be moved from the rebuilt blobovniczas
+		for addr := range ms.removed {
+			if _, err := os.Stat(filepath.Join(dir, string(ms.ids[addr]))); err == nil {
+				continue
+			}
+
+			res, err := b.Exists(common.ExistsPrm{Address: addr})
+			require.NoError(t, err)
+			require.False(t, res.Exists)
+		}
+	})
+	t.Run("misconfigured", func(t *testing.T) {
+		dir := t.TempDir()
+		b := newRebuildTree(t, dir, 4)
+
+		ms := &storageIDMap{ids: make(map[oid.Address][]byte), removed: make(map[oid.Address]struct{})}
+		data := putRebuildObjects(t, b, 50, ms)
+		require.NoError(t, b.Close())
+
+		b = newRebuildTree(t, dir, 2)
+		defer func() { require.NoError(t, b.Close()) }()
+
+		res, err := b.Rebuild(context.Background(), common.RebuildPrm{MetaStorage: ms})
+		require.NoError(t, err)
+		require.NotZero(t, res.ObjectsMoved)
+
+		paths, err := b.listBlobovniczas()
+		require.NoError(t, err)
+		for _, p := range paths {
+			require.True(t, b.isValidPath(p), p)
+		}
+
+		_, err = os.Stat(filepath.Join(dir, "3"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+
+		checkRebuiltObjects(t, b, data, ms)
+	})
+	t.Run("read-only", func(t *testing.T) {
+		b := NewBlobovniczaTree(WithRootPath(t.TempDir()))
+		require.NoError(t, b.Open(true))
+		defer func() { require.NoError(t, b.Close()) }()
+
+		_, err := b.Rebuild(context.Background(), common.RebuildPrm{})
+		require.ErrorIs(t, err, common.ErrReadOnly)
+	})
+}
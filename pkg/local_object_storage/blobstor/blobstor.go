@@ -43,6 +43,7 @@ type cfg struct {
 	compression compression.Config
 	log         *logger.Logger
 	storage     []SubStorage
+	metrics     Metrics
 }
 
 func initConfig(c *cfg) {
@@ -58,8 +59,20 @@ func New(opts ...Option) *BlobStor {
 		opts[i](&bs.cfg)
 	}
 
+	if bs.metrics != nil {
+		// storages are going to be wrapped, don't modify the slice passed in options
+		bs.storage = append([]SubStorage(nil), bs.storage...)
+	}
+
 	for i := range bs.storage {
 		bs.storage[i].Storage.SetCompressor(&bs.compression)
+
+		if bs.metrics != nil {
+			bs.storage[i].Storage = &measuredStorage{
+				Storage: bs.storage[i].Storage,
+				metrics: bs.metrics,
+			}
+		}
 	}
 
 	return bs
@@ -87,7 +100,8 @@ func WithLogger(l *logger.Logger) Option {
 // WithCompressObjects returns option to toggle
 // compression of the stored objects.
 //
-// If true, Zstandard algorithm is used for data compression.
+// If true, the codec set by WithCompressionCodec is used for data compression,
+// Zstandard by default.
 //
 // If compressor (decompressor) creation failed,
 // the uncompressed option will be used, and the error
@@ -106,6 +120,22 @@ func WithUncompressableContentTypes(values []string) Option {
 	}
 }
 
+// WithCompressionCodec returns option to set the codec used for
+// compression of the stored objects, e.g. `zstd:best` or `lz4`.
+func WithCompressionCodec(codec string) Option {
+	return func(c *cfg) {
+		c.compression.Codec = codec
+	}
+}
+
+// WithCompressionRules returns option to select compression codec
+// by container or object attribute. The first matching rule is applied.
+func WithCompressionRules(rules []compression.Rule) Option {
+	return func(c *cfg) {
+		c.compression.Rules = rules
+	}
+}
+
 // SetReportErrorFunc allows to provide a function to be called on disk errors.
 // This function MUST be called before Open.
 func (b *BlobStor) SetReportErrorFunc(f func(string, error)) {
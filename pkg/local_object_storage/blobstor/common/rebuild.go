@@ -0,0 +1,33 @@
+package common
+
+import (
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// RebuildPrm groups the parameters of Rebuild operation.
+type RebuildPrm struct {
+	// MetaStorage is used to update storage IDs of the moved objects.
+	MetaStorage MetaStorage
+	// FillPercent is the minimal percentage of live data in a storage unit.
+	// Units with less live data are rebuilt. If zero, only units which don't
+	// match current storage configuration are rebuilt.
+	FillPercent int
+}
+
+// RebuildRes groups the resulting values of Rebuild operation.
+type RebuildRes struct {
+	// ObjectsMoved is the amount of objects moved to the new location.
+	ObjectsMoved uint64
+	// FilesRemoved is the amount of storage units removed after rebuild.
+	FilesRemoved uint64
+}
+
+// MetaStorage is an interface of the storage which keeps storage IDs of the objects.
+type MetaStorage interface {
+	// UpdateStorageID must update storage ID of the object. It must not
+	// return an error if the object is missing. It must return an error
+	// of type apistatus.ObjectNotFound or apistatus.ObjectAlreadyRemoved
+	// if the object is marked for removal: such objects are removed from
+	// the storage instead of being moved.
+	UpdateStorageID(addr oid.Address, storageID []byte) error
+}
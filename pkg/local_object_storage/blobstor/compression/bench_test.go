@@ -35,7 +35,7 @@ func benchWith(b *testing.B, c Config, data []byte) {
 	b.ResetTimer()
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
-		_ = c.Compress(data)
+		_ = c.Compress(nil, data)
 	}
 }
 
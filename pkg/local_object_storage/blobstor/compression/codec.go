@@ -0,0 +1,287 @@
+package compression
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// CodecType is an identifier of the compression algorithm.
+// It is written in the header of the stored frame, so values must never change.
+type CodecType uint8
+
+const (
+	// CodecNone stores data as is.
+	CodecNone CodecType = iota
+	// CodecZstd is a Zstandard compression. Data is stored as a native zstd frame.
+	CodecZstd
+	// CodecLZ4 is an LZ4 block compression.
+	CodecLZ4
+	// CodecS2 is an S2 block compression, a Snappy extension.
+	CodecS2
+)
+
+// String implements fmt.Stringer.
+func (t CodecType) String() string {
+	switch t {
+	case CodecNone:
+		return "none"
+	case CodecZstd:
+		return "zstd"
+	case CodecLZ4:
+		return "lz4"
+	case CodecS2:
+		return "s2"
+	default:
+		return "unknown(" + strconv.Itoa(int(t)) + ")"
+	}
+}
+
+// Codec represents a compression algorithm with particular settings.
+type Codec interface {
+	// Type returns the identifier of the codec.
+	Type() CodecType
+	// Compress returns compressed src without a frame header.
+	Compress(src []byte) ([]byte, error)
+	// Decompress decompresses data produced by Compress.
+	Decompress(src []byte) ([]byte, error)
+	// Close releases all resources of the codec.
+	Close() error
+}
+
+// CodecConstructor creates a codec from the level part of the codec name.
+// Level is empty if it was not specified.
+type CodecConstructor func(level string) (Codec, error)
+
+var (
+	registryMtx sync.RWMutex
+	registry    = map[string]CodecConstructor{
+		"none":   newNoneCodec,
+		"zstd":   newZstdCodec,
+		"lz4":    newLZ4Codec,
+		"s2":     newS2Codec,
+		"snappy": newSnappyCodec,
+	}
+)
+
+// RegisterCodec registers codec constructor with the provided name.
+// Codecs are referenced in the configuration as `name` or `name:level`.
+// Codec type of the registered codec must be unique, because it is
+// used to select decoder when data is read.
+func RegisterCodec(name string, f CodecConstructor) {
+	registryMtx.Lock()
+	defer registryMtx.Unlock()
+
+	registry[name] = f
+}
+
+// NewCodec creates a codec from its name. Name is `codec` or `codec:level`,
+// e.g. `zstd`, `zstd:best`, `zstd:19`, `lz4`, `s2:better`.
+func NewCodec(name string) (Codec, error) {
+	codecName, level, _ := strings.Cut(name, ":")
+
+	registryMtx.RLock()
+	f, ok := registry[codecName]
+	registryMtx.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown compression codec: %s", codecName)
+	}
+
+	c, err := f(level)
+	if err != nil {
+		return nil, fmt.Errorf("invalid compression codec %s: %w", name, err)
+	}
+	return c, nil
+}
+
+var errNoLevel = errors.New("codec doesn't support compression levels")
+
+// MaxDecompressedSize is the maximum size of the decompressed data. It
+// exceeds the maximum object size with the header, so the size read from
+// a corrupted frame can't force an arbitrary large allocation.
+const MaxDecompressedSize = 1 << 30
+
+// lz4MaxRatio is the maximum compression ratio of the LZ4 block.
+const lz4MaxRatio = 255
+
+var errDecompressedSize = errors.New("invalid decompressed data size")
+
+type noneCodec struct{}
+
+func newNoneCodec(level string) (Codec, error) {
+	if level != "" {
+		return nil, errNoLevel
+	}
+	return noneCodec{}, nil
+}
+
+func (noneCodec) Type() CodecType                       { return CodecNone }
+func (noneCodec) Compress(src []byte) ([]byte, error)   { return src, nil }
+func (noneCodec) Decompress(src []byte) ([]byte, error) { return src, nil }
+func (noneCodec) Close() error                          { return nil }
+
+type zstdCodec struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+func newZstdCodec(level string) (Codec, error) {
+	lvl := zstd.SpeedDefault
+	if level != "" {
+		if n, err := strconv.Atoi(level); err == nil {
+			lvl = zstd.EncoderLevelFromZstd(n)
+		} else {
+			var ok bool
+			ok, lvl = zstd.EncoderLevelFromString(level)
+			if !ok {
+				return nil, fmt.Errorf("unknown zstd level: %s", level)
+			}
+		}
+	}
+
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(lvl))
+	if err != nil {
+		return nil, err
+	}
+
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		_ = encoder.Close()
+		return nil, err
+	}
+
+	return &zstdCodec{encoder: encoder, decoder: decoder}, nil
+}
+
+func (c *zstdCodec) Type() CodecType { return CodecZstd }
+
+func (c *zstdCodec) Compress(src []byte) ([]byte, error) {
+	maxSize := c.encoder.MaxEncodedSize(len(src))
+	return c.encoder.EncodeAll(src, make([]byte, 0, maxSize)), nil
+}
+
+func (c *zstdCodec) Decompress(src []byte) ([]byte, error) {
+	return c.decoder.DecodeAll(src, nil)
+}
+
+func (c *zstdCodec) Close() error {
+	c.decoder.Close()
+	return c.encoder.Close()
+}
+
+// lz4Codec stores original data size as uvarint before the LZ4 block,
+// because it is needed to allocate the buffer for decompression.
+type lz4Codec struct {
+	level lz4.CompressionLevel
+}
+
+func newLZ4Codec(level string) (Codec, error) {
+	switch level {
+	case "", "fast":
+		return &lz4Codec{level: lz4.Fast}, nil
+	default:
+		n, err := strconv.Atoi(level)
+		if err != nil || n < 1 || n > 9 {
+			return nil, fmt.Errorf("unknown lz4 level: %s", level)
+		}
+		return &lz4Codec{level: lz4.CompressionLevel(1 << (8 + n))}, nil
+	}
+}
+
+func (c *lz4Codec) Type() CodecType { return CodecLZ4 }
+
+func (c *lz4Codec) Compress(src []byte) ([]byte, error) {
+	dst := make([]byte, binary.MaxVarintLen64+lz4.CompressBlockBound(len(src)))
+	n := binary.PutUvarint(dst, uint64(len(src)))
+
+	var (
+		sz  int
+		err error
+	)
+	if c.level == lz4.Fast {
+		var cmp lz4.Compressor
+		sz, err = cmp.CompressBlock(src, dst[n:])
+	} else {
+		cmp := lz4.CompressorHC{Level: c.level}
+		sz, err = cmp.CompressBlock(src, dst[n:])
+	}
+	if err != nil {
+		return nil, err
+	}
+	if sz == 0 && len(src) != 0 {
+		return nil, errIncompressible
+	}
+	return dst[:n+sz], nil
+}
+
+func (c *lz4Codec) Decompress(src []byte) ([]byte, error) {
+	size, n := binary.Uvarint(src)
+	if n <= 0 {
+		return nil, errors.New("invalid lz4 frame size")
+	}
+	if size > MaxDecompressedSize || size > uint64(len(src)-n)*lz4MaxRatio {
+		return nil, fmt.Errorf("%w: %d", errDecompressedSize, size)
+	}
+
+	dst := make([]byte, size)
+	sz, err := lz4.UncompressBlock(src[n:], dst)
+	if err != nil {
+		return nil, err
+	}
+	return dst[:sz], nil
+}
+
+func (c *lz4Codec) Close() error { return nil }
+
+type s2Codec struct {
+	encode func(dst, src []byte) []byte
+}
+
+func newS2Codec(level string) (Codec, error) {
+	switch level {
+	case "":
+		return &s2Codec{encode: s2.Encode}, nil
+	case "better":
+		return &s2Codec{encode: s2.EncodeBetter}, nil
+	case "best":
+		return &s2Codec{encode: s2.EncodeBest}, nil
+	default:
+		return nil, fmt.Errorf("unknown s2 level: %s", level)
+	}
+}
+
+// newSnappyCodec creates a codec producing Snappy-compatible blocks.
+// S2 decoder is able to read them, so the codec shares the type with S2.
+func newSnappyCodec(level string) (Codec, error) {
+	if level != "" {
+		return nil, errNoLevel
+	}
+	return &s2Codec{encode: s2.EncodeSnappy}, nil
+}
+
+func (c *s2Codec) Type() CodecType { return CodecS2 }
+
+func (c *s2Codec) Compress(src []byte) ([]byte, error) {
+	return c.encode(nil, src), nil
+}
+
+func (c *s2Codec) Decompress(src []byte) ([]byte, error) {
+	size, err := s2.DecodedLen(src)
+	if err != nil {
+		return nil, err
+	}
+	if size > MaxDecompressedSize {
+		return nil, fmt.Errorf("%w: %d", errDecompressedSize, size)
+	}
+	return s2.Decode(nil, src)
+}
+
+func (c *s2Codec) Close() error { return nil }
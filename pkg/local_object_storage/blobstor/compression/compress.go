@@ -2,102 +2,292 @@ package compression
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"strings"
 
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
-	"github.com/klauspost/compress/zstd"
 )
 
+// DefaultCodec is a codec used when compression is enabled
+// and no codec is specified.
+const DefaultCodec = "zstd"
+
 // Config represents common compression-related configuration.
 type Config struct {
 	Enabled                    bool
 	UncompressableContentTypes []string
 
-	encoder *zstd.Encoder
-	decoder *zstd.Decoder
+	// Codec is a name of the codec used for objects not matched by any rule.
+	// DefaultCodec is used if empty.
+	Codec string
+
+	// Rules select the codec for particular objects. The first matching rule
+	// is applied. Rules are applied even if compression is not Enabled.
+	Rules []Rule
+
+	// codecs maps codec name to the codec used for compression.
+	codecs map[string]Codec
+	// decoders maps codec type to the codec used for decompression.
+	decoders map[CodecType]Codec
+}
+
+// Rule selects a codec for the objects matching it.
+type Rule struct {
+	// Containers is a list of containers the rule is applied to.
+	// Objects from any container match if empty.
+	Containers []cid.ID
+
+	// Attribute is a key of the object attribute to match.
+	// Any object matches if empty.
+	Attribute string
+
+	// Value is an attribute value to match. It can contain a star `*`
+	// as a first (last) character, which matches any prefix (suffix).
+	Value string
+
+	// Codec is a codec name in `codec` or `codec:level` form.
+	Codec string
 }
 
 // zstdFrameMagic contains first 4 bytes of any compressed object
 // https://github.com/klauspost/compress/blob/master/zstd/framedec.go#L58 .
 var zstdFrameMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
 
+// frameMagic is a prefix of data compressed by codecs other than zstd.
+// It is followed by a single byte with the codec type. The first byte
+// has an invalid protobuf wire type, so a marshaled object can't start with it.
+var frameMagic = []byte{0xff, 'F', 'C', 'F'}
+
+const frameHeaderSize = 5
+
+// errIncompressible is returned by codecs which can't compress the data,
+// such data is stored as is.
+var errIncompressible = errors.New("data is incompressible")
+
 // Init initializes compression routines.
 func (c *Config) Init() error {
-	var err error
+	c.codecs = make(map[string]Codec)
+	c.decoders = make(map[CodecType]Codec)
 
+	names := make([]string, 0, len(c.Rules)+1)
 	if c.Enabled {
-		c.encoder, err = zstd.NewWriter(nil)
+		names = append(names, c.defaultCodecName())
+	}
+	for i := range c.Rules {
+		names = append(names, c.Rules[i].Codec)
+	}
+
+	for _, name := range names {
+		if _, ok := c.codecs[name]; ok {
+			continue
+		}
+
+		codec, err := NewCodec(name)
 		if err != nil {
+			_ = c.Close()
 			return err
 		}
+
+		c.codecs[name] = codec
+		if _, ok := c.decoders[codec.Type()]; !ok {
+			c.decoders[codec.Type()] = codec
+		}
 	}
 
-	c.decoder, err = zstd.NewReader(nil)
-	if err != nil {
-		return err
+	// Data written with any codec must stay readable.
+	for _, name := range []string{"zstd", "lz4", "s2"} {
+		codec, err := NewCodec(name)
+		if err != nil {
+			_ = c.Close()
+			return err
+		}
+
+		if _, ok := c.decoders[codec.Type()]; ok {
+			_ = codec.Close()
+			continue
+		}
+		c.decoders[codec.Type()] = codec
 	}
 
 	return nil
 }
 
+func (c *Config) defaultCodecName() string {
+	if c.Codec == "" {
+		return DefaultCodec
+	}
+	return c.Codec
+}
+
 // NeedsCompression returns true if the object should be compressed.
-// For an object to be compressed 2 conditions must hold:
-// 1. Compression is enabled in settings.
-// 2. Object MIME Content-Type is allowed for compression.
+// For an object to be compressed one of the conditions must hold:
+//  1. Object matches a rule with a codec other than `none`.
+//  2. Object matches no rule, compression is enabled in settings,
+//     object MIME Content-Type is allowed for compression and
+//     the default codec is not `none`.
 func (c *Config) NeedsCompression(obj *objectSDK.Object) bool {
-	if !c.Enabled || len(c.UncompressableContentTypes) == 0 {
-		return c.Enabled
-	}
+	return c.codecName(obj) != ""
+}
 
-	for _, attr := range obj.Attributes() {
-		if attr.Key() == objectSDK.AttributeContentType {
-			for _, value := range c.UncompressableContentTypes {
-				match := false
-				switch {
-				case len(value) > 0 && value[len(value)-1] == '*':
-					match = strings.HasPrefix(attr.Value(), value[:len(value)-1])
-				case len(value) > 0 && value[0] == '*':
-					match = strings.HasSuffix(attr.Value(), value[1:])
-				default:
-					match = attr.Value() == value
+// codecName returns the name of the codec selected for the object
+// or an empty string if the object must not be compressed.
+func (c *Config) codecName(obj *objectSDK.Object) string {
+	if obj != nil {
+		for i := range c.Rules {
+			if c.Rules[i].match(obj) {
+				if c.Rules[i].Codec == "none" {
+					return ""
 				}
-				if match {
-					return false
+				return c.Rules[i].Codec
+			}
+		}
+	}
+
+	if !c.Enabled {
+		return ""
+	}
+
+	if obj != nil && len(c.UncompressableContentTypes) != 0 {
+		for _, attr := range obj.Attributes() {
+			if attr.Key() == objectSDK.AttributeContentType {
+				for _, value := range c.UncompressableContentTypes {
+					if matchValue(value, attr.Value()) {
+						return ""
+					}
 				}
 			}
 		}
 	}
 
-	return c.Enabled
+	if name := c.defaultCodecName(); name != "none" {
+		return name
+	}
+	return ""
+}
+
+func (r *Rule) match(obj *objectSDK.Object) bool {
+	if len(r.Containers) != 0 {
+		cnr, ok := obj.ContainerID()
+		if !ok {
+			return false
+		}
+
+		found := false
+		for i := range r.Containers {
+			if r.Containers[i].Equals(cnr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if r.Attribute == "" {
+		return true
+	}
+
+	for _, attr := range obj.Attributes() {
+		if attr.Key() == r.Attribute && matchValue(r.Value, attr.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchValue(pattern, value string) bool {
+	switch {
+	case len(pattern) > 0 && pattern[len(pattern)-1] == '*':
+		return strings.HasPrefix(value, pattern[:len(pattern)-1])
+	case len(pattern) > 0 && pattern[0] == '*':
+		return strings.HasSuffix(value, pattern[1:])
+	default:
+		return value == pattern
+	}
 }
 
 // Decompress decompresses data if it starts with the magic
 // and returns data untouched otherwise.
 func (c *Config) Decompress(data []byte) ([]byte, error) {
-	if len(data) < 4 || !bytes.Equal(data[:4], zstdFrameMagic) {
+	var typ CodecType
+
+	switch {
+	case len(data) >= 4 && bytes.Equal(data[:4], zstdFrameMagic):
+		typ = CodecZstd
+	case len(data) >= frameHeaderSize && bytes.Equal(data[:4], frameMagic):
+		typ = CodecType(data[4])
+		data = data[frameHeaderSize:]
+	default:
 		return data, nil
 	}
-	return c.decoder.DecodeAll(data, nil)
+
+	codec := c.decoder(typ)
+	if codec == nil {
+		return nil, fmt.Errorf("unknown compression codec: %s", typ)
+	}
+	return codec.Decompress(data)
 }
 
-// Compress compresses data if compression is enabled
-// and returns data untouched otherwise.
-func (c *Config) Compress(data []byte) []byte {
-	if c == nil || !c.Enabled {
+func (c *Config) decoder(typ CodecType) Codec {
+	if c == nil {
+		return nil
+	}
+	return c.decoders[typ]
+}
+
+// Compress compresses data with the codec selected for the object
+// and returns data untouched if the object must not be compressed.
+// If obj is nil, the default codec is used.
+func (c *Config) Compress(obj *objectSDK.Object, data []byte) []byte {
+	if c == nil {
+		return data
+	}
+
+	name := c.codecName(obj)
+	if name == "" {
 		return data
 	}
-	maxSize := c.encoder.MaxEncodedSize(len(data))
-	return c.encoder.EncodeAll(data, make([]byte, 0, maxSize))
+
+	codec := c.codecs[name]
+	compressed, err := codec.Compress(data)
+	if err != nil {
+		return data
+	}
+
+	if codec.Type() == CodecZstd {
+		return compressed
+	}
+
+	res := make([]byte, frameHeaderSize, frameHeaderSize+len(compressed))
+	copy(res, frameMagic)
+	res[4] = byte(codec.Type())
+	return append(res, compressed...)
 }
 
 // Close closes encoder and decoder, returns any error occurred.
 func (c *Config) Close() error {
 	var err error
-	if c.encoder != nil {
-		err = c.encoder.Close()
+
+	closed := make(map[Codec]struct{})
+	for _, codec := range c.codecs {
+		if cErr := codec.Close(); cErr != nil && err == nil {
+			err = cErr
+		}
+		closed[codec] = struct{}{}
 	}
-	if c.decoder != nil {
-		c.decoder.Close()
+	for _, codec := range c.decoders {
+		if _, ok := closed[codec]; ok {
+			continue
+		}
+		if cErr := codec.Close(); cErr != nil && err == nil {
+			err = cErr
+		}
 	}
+
+	c.codecs = nil
+	c.decoders = nil
 	return err
 }
@@ -0,0 +1,173 @@
+package compression
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"testing"
+
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodecs(t *testing.T) {
+	data := notSoRandomSlice(64*1024, 100)
+
+	for _, name := range []string{"none", "zstd", "zstd:fastest", "zstd:best", "zstd:19",
+		"lz4", "lz4:9", "s2", "s2:better", "s2:best", "snappy"} {
+		t.Run(name, func(t *testing.T) {
+			c := Config{Enabled: true, Codec: name}
+			require.NoError(t, c.Init())
+			t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+			compressed := c.Compress(nil, data)
+			if name == "none" {
+				require.Equal(t, data, compressed)
+			} else {
+				require.Less(t, len(compressed), len(data))
+			}
+
+			// Data must be readable regardless of the codec configured.
+			reader := Config{}
+			require.NoError(t, reader.Init())
+			t.Cleanup(func() { require.NoError(t, reader.Close()) })
+
+			decompressed, err := reader.Decompress(compressed)
+			require.NoError(t, err)
+			require.Equal(t, data, decompressed)
+		})
+	}
+
+	for _, name := range []string{"unknown", "zstd:unknown", "lz4:10", "s2:fast", "none:1"} {
+		c := Config{Enabled: true, Codec: name}
+		require.Error(t, c.Init(), name)
+	}
+}
+
+func TestDecompressedSizeLimit(t *testing.T) {
+	for _, name := range []string{"lz4", "lz4:9"} {
+		c, err := NewCodec(name)
+		require.NoError(t, err)
+
+		// highly compressible data must still be readable
+		data := make([]byte, 4<<20)
+		compressed, err := c.Compress(data)
+		require.NoError(t, err)
+
+		res, err := c.Decompress(compressed)
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+	}
+
+	t.Run("lz4", func(t *testing.T) {
+		c, err := NewCodec("lz4")
+		require.NoError(t, err)
+
+		for _, size := range []uint64{MaxDecompressedSize + 1, 1 << 20} {
+			frame := make([]byte, binary.MaxVarintLen64+16)
+			n := binary.PutUvarint(frame, size)
+
+			_, err = c.Decompress(frame[:n+16])
+			require.ErrorIs(t, err, errDecompressedSize, size)
+		}
+	})
+}
+
+func TestLegacyZstdFrame(t *testing.T) {
+	data := notSoRandomSlice(4096, 16)
+
+	enc, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	compressed := enc.EncodeAll(data, nil)
+	require.NoError(t, enc.Close())
+
+	c := Config{Enabled: true, Codec: "lz4"}
+	require.NoError(t, c.Init())
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+	decompressed, err := c.Decompress(compressed)
+	require.NoError(t, err)
+	require.Equal(t, data, decompressed)
+
+	// Uncompressed data is returned as is.
+	raw := make([]byte, 128)
+	_, _ = rand.Read(raw)
+	raw[0] = 0x0a
+	decompressed, err = c.Decompress(raw)
+	require.NoError(t, err)
+	require.Equal(t, raw, decompressed)
+}
+
+func TestRules(t *testing.T) {
+	logs, media, other := cidtest.ID(), cidtest.ID(), cidtest.ID()
+
+	c := Config{
+		Enabled:                    true,
+		UncompressableContentTypes: []string{"image/*"},
+		Rules: []Rule{
+			{Containers: []cid.ID{logs}, Codec: "zstd:best"},
+			{Containers: []cid.ID{media}, Codec: "none"},
+			{Attribute: "Codec", Value: "fast*", Codec: "lz4"},
+		},
+	}
+	require.NoError(t, c.Init())
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+	newObject := func(cnr cid.ID, attrs ...string) *objectSDK.Object {
+		obj := objectSDK.New()
+		obj.SetContainerID(cnr)
+
+		var aa []objectSDK.Attribute
+		for i := 0; i < len(attrs); i += 2 {
+			var a objectSDK.Attribute
+			a.SetKey(attrs[i])
+			a.SetValue(attrs[i+1])
+			aa = append(aa, a)
+		}
+		obj.SetAttributes(aa...)
+		return obj
+	}
+
+	data := notSoRandomSlice(4096, 16)
+	testCodec := func(obj *objectSDK.Object, expected CodecType) {
+		compressed := c.Compress(obj, data)
+		switch expected {
+		case CodecNone:
+			require.Equal(t, data, compressed)
+			require.False(t, c.NeedsCompression(obj))
+		case CodecZstd:
+			require.True(t, bytes.HasPrefix(compressed, zstdFrameMagic))
+			require.True(t, c.NeedsCompression(obj))
+		default:
+			require.True(t, bytes.HasPrefix(compressed, frameMagic))
+			require.Equal(t, byte(expected), compressed[len(frameMagic)])
+			require.True(t, c.NeedsCompression(obj))
+		}
+
+		decompressed, err := c.Decompress(compressed)
+		require.NoError(t, err)
+		require.Equal(t, data, decompressed)
+	}
+
+	testCodec(newObject(logs), CodecZstd)
+	testCodec(newObject(logs, objectSDK.AttributeContentType, "image/png"), CodecZstd)
+	testCodec(newObject(media), CodecNone)
+	testCodec(newObject(media, "Codec", "fast"), CodecNone)
+	testCodec(newObject(other, "Codec", "fastest"), CodecLZ4)
+	testCodec(newObject(other, "Codec", "slow"), CodecZstd)
+	testCodec(newObject(other, objectSDK.AttributeContentType, "image/png"), CodecNone)
+	testCodec(newObject(other), CodecZstd)
+
+	t.Run("rules without compression enabled", func(t *testing.T) {
+		c := Config{Rules: []Rule{{Containers: []cid.ID{logs}, Codec: "s2"}}}
+		require.NoError(t, c.Init())
+		t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+		require.True(t, c.NeedsCompression(newObject(logs)))
+		require.False(t, c.NeedsCompression(newObject(other)))
+		require.Equal(t, data, c.Compress(newObject(other), data))
+	})
+}
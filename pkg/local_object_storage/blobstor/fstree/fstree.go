@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
@@ -216,6 +217,20 @@ func (t *FSTree) Exists(prm common.ExistsPrm) (common.ExistsRes, error) {
 	return common.ExistsRes{Exists: found}, err
 }
 
+// ModTime returns the time the object was written to the storage at.
+//
+// Returns an error of type apistatus.ObjectNotFound if the object is missing.
+func (t *FSTree) ModTime(addr oid.Address) (time.Time, error) {
+	fi, err := os.Stat(t.treePath(addr))
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = logicerr.Wrap(apistatus.ObjectNotFound{})
+		}
+		return time.Time{}, err
+	}
+	return fi.ModTime(), nil
+}
+
 // Put puts an object in the storage.
 func (t *FSTree) Put(prm common.PutPrm) (common.PutRes, error) {
 	if t.readOnly {
@@ -228,7 +243,7 @@ func (t *FSTree) Put(prm common.PutPrm) (common.PutRes, error) {
 		return common.PutRes{}, err
 	}
 	if !prm.DontCompress {
-		prm.RawData = t.Compress(prm.RawData)
+		prm.RawData = t.Compress(prm.Object, prm.RawData)
 	}
 
 	// Here is a situation:
@@ -0,0 +1,80 @@
+package blobstor
+
+import (
+	"context"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Metrics is an interface that must store sub-storage metrics.
+type Metrics interface {
+	// AddMethodDuration must add the duration of the sub-storage operation.
+	// Storage is the type of the sub-storage.
+	AddMethodDuration(storage, method string, d time.Duration)
+}
+
+// WithMetrics returns option to specify the sub-storage metrics collector.
+func WithMetrics(m Metrics) Option {
+	return func(c *cfg) {
+		c.metrics = m
+	}
+}
+
+// measuredStorage reports the duration of the sub-storage operations.
+type measuredStorage struct {
+	common.Storage
+	metrics Metrics
+}
+
+func (s *measuredStorage) observe(method string) func() {
+	t := time.Now()
+	return func() {
+		s.metrics.AddMethodDuration(s.Type(), method, time.Since(t))
+	}
+}
+
+func (s *measuredStorage) Get(prm common.GetPrm) (common.GetRes, error) {
+	defer s.observe("Get")()
+	return s.Storage.Get(prm)
+}
+
+func (s *measuredStorage) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+	defer s.observe("GetRange")()
+	return s.Storage.GetRange(prm)
+}
+
+func (s *measuredStorage) Exists(prm common.ExistsPrm) (common.ExistsRes, error) {
+	defer s.observe("Exists")()
+	return s.Storage.Exists(prm)
+}
+
+func (s *measuredStorage) Put(prm common.PutPrm) (common.PutRes, error) {
+	defer s.observe("Put")()
+	return s.Storage.Put(prm)
+}
+
+func (s *measuredStorage) Delete(prm common.DeletePrm) (common.DeleteRes, error) {
+	defer s.observe("Delete")()
+	return s.Storage.Delete(prm)
+}
+
+// Rebuild rebuilds the underlying storage if it supports rebuilding.
+func (s *measuredStorage) Rebuild(ctx context.Context, prm common.RebuildPrm) (common.RebuildRes, error) {
+	r, ok := s.Storage.(rebuilder)
+	if !ok {
+		return common.RebuildRes{}, nil
+	}
+	return r.Rebuild(ctx, prm)
+}
+
+// ModTime returns the time the object was written to the underlying storage
+// if it supports such information.
+func (s *measuredStorage) ModTime(addr oid.Address) (time.Time, error) {
+	t, ok := s.Storage.(modTimer)
+	if !ok {
+		return time.Time{}, errModTimeNotSupported
+	}
+	return t.ModTime(addr)
+}
@@ -0,0 +1,52 @@
+package blobstor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+type testMetrics map[string]int
+
+func (m testMetrics) AddMethodDuration(storage, method string, _ time.Duration) {
+	m[storage+"/"+method]++
+}
+
+func TestMetrics(t *testing.T) {
+	const smallSizeLimit = 512
+
+	storages := defaultStorages(t.TempDir(), smallSizeLimit)
+	m := make(testMetrics)
+
+	bs := New(WithStorages(storages), WithMetrics(m))
+	require.NoError(t, bs.Open(false))
+	require.NoError(t, bs.Init())
+	defer func() { require.NoError(t, bs.Close()) }()
+
+	// slice passed in options must stay untouched
+	_, wrapped := storages[0].Storage.(*measuredStorage)
+	require.False(t, wrapped)
+
+	small := testObject(smallSizeLimit / 2)
+	big := testObject(smallSizeLimit * 2)
+
+	for _, obj := range []*objectSDK.Object{small, big} {
+		_, err := bs.Put(common.PutPrm{Object: obj})
+		require.NoError(t, err)
+
+		_, err = bs.Get(common.GetPrm{Address: object.AddressOf(obj)})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, testMetrics{
+		"blobovnicza/Put": 1,
+		"fstree/Put":      1,
+		// big object is looked for in blobovnicza first
+		"blobovnicza/Get": 2,
+		"fstree/Get":      1,
+	}, m)
+}
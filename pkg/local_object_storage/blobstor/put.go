@@ -51,9 +51,11 @@ func (b *BlobStor) Put(prm common.PutPrm) (common.PutRes, error) {
 }
 
 // NeedsCompression returns true if the object should be compressed.
-// For an object to be compressed 2 conditions must hold:
-// 1. Compression is enabled in settings.
-// 2. Object MIME Content-Type is allowed for compression.
+// For an object to be compressed one of the conditions must hold:
+//  1. Object matches a compression rule with a codec other than `none`.
+//  2. Object matches no rule, compression is enabled in settings,
+//     object MIME Content-Type is allowed for compression and
+//     the default codec is not `none`.
 func (b *BlobStor) NeedsCompression(obj *objectSDK.Object) bool {
 	return b.cfg.compression.NeedsCompression(obj)
 }
@@ -0,0 +1,36 @@
+package blobstor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+)
+
+// rebuilder is a sub-storage which is able to rebuild its structure.
+type rebuilder interface {
+	Rebuild(context.Context, common.RebuildPrm) (common.RebuildRes, error)
+}
+
+// Rebuild rebuilds all sub-storages supporting it.
+// See common.RebuildPrm for details.
+func (b *BlobStor) Rebuild(ctx context.Context, prm common.RebuildPrm) (common.RebuildRes, error) {
+	b.modeMtx.RLock()
+	defer b.modeMtx.RUnlock()
+
+	var res common.RebuildRes
+	for i := range b.storage {
+		r, ok := b.storage[i].Storage.(rebuilder)
+		if !ok {
+			continue
+		}
+
+		subRes, err := r.Rebuild(ctx, prm)
+		res.ObjectsMoved += subRes.ObjectsMoved
+		res.FilesRemoved += subRes.FilesRemoved
+		if err != nil {
+			return res, fmt.Errorf("could not rebuild %s sub-storage: %w", b.storage[i].Storage.Type(), err)
+		}
+	}
+	return res, nil
+}
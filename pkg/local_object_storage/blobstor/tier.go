@@ -0,0 +1,206 @@
+package blobstor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ErrNoColdStorage is returned when the migration is requested for the blobstor
+// with a single sub-storage.
+var ErrNoColdStorage = logicerr.New("blobstor has no cold sub-storage")
+
+var (
+	errModTimeNotSupported = errors.New("sub-storage does not support object modification time")
+	errMigrationStopped    = errors.New("migration was stopped")
+)
+
+// modTimer is a sub-storage which is able to tell when the object was written.
+type modTimer interface {
+	ModTime(oid.Address) (time.Time, error)
+}
+
+// migrateBatchSize is the number of objects moved under a single mode lock.
+const migrateBatchSize = 100
+
+// MigratePrm groups the parameters of Migrate operation.
+type MigratePrm struct {
+	// MetaStorage is used to update storage IDs of the moved objects.
+	MetaStorage common.MetaStorage
+	// IsCold must return true if the object written to the hot sub-storage
+	// at the specified time must be moved to the cold one.
+	IsCold func(addr oid.Address, modTime time.Time) bool
+	// MaxObjectSize is the maximum size of the object data the cold sub-storage
+	// can hold. Bigger objects are kept in the hot sub-storage. Zero means no limit.
+	MaxObjectSize uint64
+	// MoveLock, if set, is held while an object is being moved, so that
+	// the caller can exclude the concurrent removal of the object.
+	MoveLock sync.Locker
+}
+
+// MigrateRes groups the resulting values of Migrate operation.
+type MigrateRes struct {
+	// ObjectsMoved is the amount of objects moved to the cold sub-storage.
+	ObjectsMoved uint64
+}
+
+// Migrate moves cold objects from the hot sub-storage to the cold one.
+//
+// The last sub-storage (usually FSTree) is considered hot and the first one
+// (usually blobovnicza tree) is considered cold, the same way the storage IDs
+// are resolved. Sub-storage policies are not checked, objects are placed
+// according to them on write only.
+//
+// The mode lock is taken for each batch of objects only, so the caller must
+// interrupt the migration via ctx before changing the blobstor mode.
+//
+// Returns ErrNoColdStorage if b has a single sub-storage.
+func (b *BlobStor) Migrate(ctx context.Context, prm MigratePrm) (MigrateRes, error) {
+	var res MigrateRes
+
+	if len(b.storage) < 2 {
+		return res, ErrNoColdStorage
+	}
+
+	hot := b.storage[len(b.storage)-1].Storage
+	cold := b.storage[0].Storage
+
+	mt, ok := hot.(modTimer)
+	if !ok {
+		return res, fmt.Errorf("%s: %w", hot.Type(), errModTimeNotSupported)
+	}
+
+	var (
+		locked bool
+		batch  int
+	)
+
+	unlock := func() {
+		if locked {
+			b.modeMtx.RUnlock()
+			locked = false
+		}
+	}
+	defer unlock()
+
+	var iterPrm common.IteratePrm
+	iterPrm.IgnoreErrors = true
+	iterPrm.LazyHandler = func(addr oid.Address, load func() ([]byte, error)) error {
+		if ctx.Err() != nil {
+			return errMigrationStopped
+		}
+
+		if !locked {
+			b.modeMtx.RLock()
+			locked = true
+			batch = 0
+
+			if b.mode.ReadOnly() {
+				return common.ErrReadOnly
+			}
+		}
+
+		defer func() {
+			if batch++; batch >= migrateBatchSize {
+				unlock()
+			}
+		}()
+
+		modTime, err := mt.ModTime(addr)
+		if err != nil || !prm.IsCold(addr, modTime) {
+			// removed concurrently or still hot
+			return nil
+		}
+
+		data, err := load()
+		if err != nil {
+			b.log.Warn("could not read object for migration",
+				zap.Stringer("address", addr),
+				zap.Error(err))
+			return nil
+		}
+
+		if prm.MaxObjectSize != 0 && uint64(len(data)) > prm.MaxObjectSize {
+			return nil
+		}
+
+		if prm.MoveLock != nil {
+			prm.MoveLock.Lock()
+			defer prm.MoveLock.Unlock()
+		}
+
+		moved, err := b.migrateObject(hot, cold, addr, data, prm.MetaStorage)
+		if err != nil {
+			return err
+		}
+		if moved {
+			res.ObjectsMoved++
+		}
+		return nil
+	}
+
+	_, err := hot.Iterate(iterPrm)
+	return res, err
+}
+
+// migrateObject saves the object to the cold sub-storage, updates its storage ID
+// and removes it from the hot sub-storage. Returns false if the object was not moved.
+func (b *BlobStor) migrateObject(hot, cold common.Storage, addr oid.Address, data []byte, ms common.MetaStorage) (bool, error) {
+	// data is stored compressed already
+	putRes, err := cold.Put(common.PutPrm{
+		Address:      addr,
+		RawData:      data,
+		DontCompress: true,
+	})
+	if err != nil {
+		if errors.Is(err, common.ErrNoSpace) {
+			return false, fmt.Errorf("could not save object to %s sub-storage: %w", cold.Type(), err)
+		}
+
+		b.log.Warn("could not save object to cold sub-storage",
+			zap.Stringer("address", addr),
+			zap.String("type", cold.Type()),
+			zap.Error(err))
+		return false, nil
+	}
+	logOp(b.log, putOp, addr, cold.Type(), putRes.StorageID)
+
+	moved := true
+
+	err = ms.UpdateStorageID(addr, putRes.StorageID)
+	if err != nil {
+		b.deleteMigrated(cold, addr, putRes.StorageID)
+		if !errors.As(err, new(apistatus.ObjectNotFound)) && !errors.As(err, new(apistatus.ObjectAlreadyRemoved)) {
+			return false, fmt.Errorf("could not update storage ID: %w", err)
+		}
+
+		// object is going to be removed, there is no need to keep it
+		moved = false
+	}
+
+	b.deleteMigrated(hot, addr, []byte{})
+	return moved, nil
+}
+
+func (b *BlobStor) deleteMigrated(st common.Storage, addr oid.Address, storageID []byte) {
+	_, err := st.Delete(common.DeletePrm{
+		Address:   addr,
+		StorageID: storageID,
+	})
+	if err == nil {
+		logOp(b.log, deleteOp, addr, st.Type(), storageID)
+	} else if !errors.As(err, new(apistatus.ObjectNotFound)) {
+		b.log.Warn("could not delete migrated object",
+			zap.Stringer("address", addr),
+			zap.String("type", st.Type()),
+			zap.Error(err))
+	}
+}
@@ -130,8 +130,11 @@ var errClosed = errors.New("storage engine is closed")
 //
 // The method MUST only be called when the application exits.
 func (e *StorageEngine) Close() error {
+	_ = e.evacuateLimiter.CancelIfRunning()
 	close(e.closeCh)
-	defer e.wg.Wait()
+	// background routines (including async evacuation) may still
+	// access the shards, so they must finish before the shards are closed
+	e.wg.Wait()
 	return e.setBlockExecErr(errClosed)
 }
 
@@ -315,29 +318,12 @@ loop:
 	}
 
 	for _, newID := range shardsToAdd {
-		sh, err := e.createShard(rcfg.shards[newID])
+		sh, err := e.openShard(rcfg.shards[newID])
 		if err != nil {
 			return fmt.Errorf("could not add new shard with '%s' metabase path: %w", newID, err)
 		}
 
-		idStr := sh.ID().String()
-
-		err = sh.Open()
-		if err == nil {
-			err = sh.Init()
-		}
-		if err != nil {
-			_ = sh.Close()
-			return fmt.Errorf("could not init %s shard: %w", idStr, err)
-		}
-
-		err = e.addShard(sh)
-		if err != nil {
-			_ = sh.Close()
-			return fmt.Errorf("could not add %s shard: %w", idStr, err)
-		}
-
-		e.log.Info("added new shard", zap.String("id", idStr))
+		e.log.Info("added new shard", zap.Stringer("id", sh.ID()))
 	}
 
 	return nil
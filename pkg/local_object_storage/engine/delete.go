@@ -1,12 +1,16 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -44,16 +48,23 @@ func (p *DeletePrm) WithForceRemoval() {
 // NOTE: Marks any object to be deleted (despite any prohibitions
 // on operations with that object) if WithForceRemoval option has
 // been provided.
-func (e *StorageEngine) Delete(prm DeletePrm) (res DeleteRes, err error) {
+func (e *StorageEngine) Delete(ctx context.Context, prm DeletePrm) (res DeleteRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "StorageEngine.Delete",
+		trace.WithAttributes(
+			attribute.String("address", prm.addr.EncodeToString()),
+			attribute.Bool("force_removal", prm.forceRemoval),
+		))
+	defer span.End()
+
 	err = e.execIfNotBlocked(func() error {
-		res, err = e.delete(prm)
+		res, err = e.delete(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) delete(prm DeletePrm) (DeleteRes, error) {
+func (e *StorageEngine) delete(ctx context.Context, prm DeletePrm) (DeleteRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddDeleteDuration)()
 	}
@@ -64,6 +75,8 @@ func (e *StorageEngine) delete(prm DeletePrm) (DeleteRes, error) {
 	}
 	var splitInfo *objectSDK.SplitInfo
 
+	defer e.notifyRemoved(prm.addr)
+
 	// Removal of a big object is done in multiple stages:
 	// 1. Remove the parent object. If it is locked or already removed, return immediately.
 	// 2. Otherwise, search for all objects with a particular SplitID and delete them too.
@@ -71,7 +84,7 @@ func (e *StorageEngine) delete(prm DeletePrm) (DeleteRes, error) {
 		var existsPrm shard.ExistsPrm
 		existsPrm.SetAddress(prm.addr)
 
-		resExists, err := sh.Exists(existsPrm)
+		resExists, err := sh.Exists(ctx, existsPrm)
 		if err != nil {
 			if shard.IsErrRemoved(err) || shard.IsErrObjectExpired(err) {
 				return true
@@ -95,7 +108,7 @@ func (e *StorageEngine) delete(prm DeletePrm) (DeleteRes, error) {
 			shPrm.ForceRemoval()
 		}
 
-		_, err = sh.Inhume(shPrm)
+		_, err = sh.Inhume(ctx, shPrm)
 		if err != nil {
 			e.reportShardError(sh, "could not inhume object in shard", err)
 
@@ -113,13 +126,13 @@ func (e *StorageEngine) delete(prm DeletePrm) (DeleteRes, error) {
 	}
 
 	if splitInfo != nil {
-		e.deleteChildren(prm.addr, prm.forceRemoval, splitInfo.SplitID())
+		e.deleteChildren(ctx, prm.addr, prm.forceRemoval, splitInfo.SplitID())
 	}
 
 	return DeleteRes{}, nil
 }
 
-func (e *StorageEngine) deleteChildren(addr oid.Address, force bool, splitID *objectSDK.SplitID) {
+func (e *StorageEngine) deleteChildren(ctx context.Context, addr oid.Address, force bool, splitID *objectSDK.SplitID) {
 	var fs objectSDK.SearchFilters
 	fs.AddSplitIDFilter(objectSDK.MatchStringEqual, splitID)
 
@@ -133,7 +146,7 @@ func (e *StorageEngine) deleteChildren(addr oid.Address, force bool, splitID *ob
 	}
 
 	e.iterateOverSortedShards(addr, func(_ int, sh hashedShard) (stop bool) {
-		res, err := sh.Select(selectPrm)
+		res, err := sh.Select(ctx, selectPrm)
 		if err != nil {
 			e.log.Warn("error during searching for object children",
 				zap.Stringer("addr", addr),
@@ -144,13 +157,15 @@ func (e *StorageEngine) deleteChildren(addr oid.Address, force bool, splitID *ob
 		for _, addr := range res.AddressList() {
 			inhumePrm.MarkAsGarbage(addr)
 
-			_, err = sh.Inhume(inhumePrm)
+			_, err = sh.Inhume(ctx, inhumePrm)
 			if err != nil {
 				e.log.Debug("could not inhume object in shard",
 					zap.Stringer("addr", addr),
 					zap.String("err", err.Error()))
 				continue
 			}
+
+			e.notifyRemoved(addr)
 		}
 		return false
 	})
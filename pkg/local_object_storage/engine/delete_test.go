@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -77,7 +78,7 @@ func TestDeleteBigObject(t *testing.T) {
 	deletePrm.WithForceRemoval()
 	deletePrm.WithAddress(addrParent)
 
-	_, err := e.Delete(deletePrm)
+	_, err := e.Delete(context.Background(), deletePrm)
 	require.NoError(t, err)
 
 	checkGetError(t, e, addrParent, &apistatus.ObjectNotFound{})
@@ -91,7 +92,7 @@ func checkGetError(t *testing.T, e *StorageEngine, addr oid.Address, expected an
 	var getPrm GetPrm
 	getPrm.WithAddress(addr)
 
-	_, err := e.Get(getPrm)
+	_, err := e.Get(context.Background(), getPrm)
 	if expected != nil {
 		require.ErrorAs(t, err, expected)
 	} else {
@@ -4,16 +4,15 @@ import "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 
 // DumpShard dumps objects from the shard with provided identifier.
 //
-// Returns an error if shard is not read-only.
-func (e *StorageEngine) DumpShard(id *shard.ID, prm shard.DumpPrm) error {
+// Returns an error if shard is degraded and not read-only.
+func (e *StorageEngine) DumpShard(id *shard.ID, prm shard.DumpPrm) (shard.DumpRes, error) {
 	e.mtx.RLock()
 	defer e.mtx.RUnlock()
 
 	sh, ok := e.shards[id.String()]
 	if !ok {
-		return errShardNotFound
+		return shard.DumpRes{}, errShardNotFound
 	}
 
-	_, err := sh.Dump(prm)
-	return err
+	return sh.Dump(prm)
 }
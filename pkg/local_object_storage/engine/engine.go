@@ -32,6 +32,10 @@ type StorageEngine struct {
 
 		err error
 	}
+
+	evacuateLimiter *evacuationLimiter
+
+	quotas *quotaState
 }
 
 type shardWrapper struct {
@@ -202,6 +206,10 @@ type cfg struct {
 	metrics MetricRegister
 
 	shardPoolSize uint32
+
+	quotaSource QuotaSource
+
+	removedCallback RemovedObjectsCallback
 }
 
 func defaultCfg() *cfg {
@@ -227,6 +235,9 @@ func New(opts ...Option) *StorageEngine {
 		shardPools: make(map[string]util.WorkerPool),
 		closeCh:    make(chan struct{}),
 		setModeCh:  make(chan setModeRequest),
+
+		evacuateLimiter: &evacuationLimiter{},
+		quotas:          newQuotaState(),
 	}
 }
 
@@ -250,6 +261,15 @@ func WithShardPoolSize(sz uint32) Option {
 	}
 }
 
+// WithRemovedObjectsCallback returns an option to specify the callback
+// of the objects marked as removed by the engine, including the objects
+// removed by the GC.
+func WithRemovedObjectsCallback(cb RemovedObjectsCallback) Option {
+	return func(c *cfg) {
+		c.removedCallback = cb
+	}
+}
+
 // WithErrorThreshold returns an option to specify size amount of errors after which
 // shard is moved to read-only mode.
 func WithErrorThreshold(sz uint32) Option {
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -71,7 +72,7 @@ func benchmarkExists(b *testing.B, shardNum int) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		ok, err := e.exists(addr)
+		ok, err := e.exists(context.Background(), addr)
 		if err != nil || ok {
 			b.Fatalf("%t %v", ok, err)
 		}
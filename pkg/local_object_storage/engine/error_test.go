@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -71,11 +72,11 @@ func TestErrorReporting(t *testing.T) {
 		var prm shard.PutPrm
 		prm.SetObject(obj)
 		e.mtx.RLock()
-		_, err := e.shards[id[0].String()].Shard.Put(prm)
+		_, err := e.shards[id[0].String()].Shard.Put(context.Background(), prm)
 		e.mtx.RUnlock()
 		require.NoError(t, err)
 
-		_, err = e.Get(GetPrm{addr: object.AddressOf(obj)})
+		_, err = e.Get(context.Background(), GetPrm{addr: object.AddressOf(obj)})
 		require.NoError(t, err)
 
 		checkShardState(t, e, id[0], 0, mode.ReadWrite)
@@ -84,7 +85,7 @@ func TestErrorReporting(t *testing.T) {
 		corruptSubDir(t, filepath.Join(dir, "0"))
 
 		for i := uint32(1); i < 3; i++ {
-			_, err = e.Get(GetPrm{addr: object.AddressOf(obj)})
+			_, err = e.Get(context.Background(), GetPrm{addr: object.AddressOf(obj)})
 			require.Error(t, err)
 			checkShardState(t, e, id[0], i, mode.ReadWrite)
 			checkShardState(t, e, id[1], 0, mode.ReadWrite)
@@ -101,11 +102,11 @@ func TestErrorReporting(t *testing.T) {
 		var prm shard.PutPrm
 		prm.SetObject(obj)
 		e.mtx.RLock()
-		_, err := e.shards[id[0].String()].Put(prm)
+		_, err := e.shards[id[0].String()].Put(context.Background(), prm)
 		e.mtx.RUnlock()
 		require.NoError(t, err)
 
-		_, err = e.Get(GetPrm{addr: object.AddressOf(obj)})
+		_, err = e.Get(context.Background(), GetPrm{addr: object.AddressOf(obj)})
 		require.NoError(t, err)
 
 		checkShardState(t, e, id[0], 0, mode.ReadWrite)
@@ -114,14 +115,14 @@ func TestErrorReporting(t *testing.T) {
 		corruptSubDir(t, filepath.Join(dir, "0"))
 
 		for i := uint32(1); i < errThreshold; i++ {
-			_, err = e.Get(GetPrm{addr: object.AddressOf(obj)})
+			_, err = e.Get(context.Background(), GetPrm{addr: object.AddressOf(obj)})
 			require.Error(t, err)
 			checkShardState(t, e, id[0], i, mode.ReadWrite)
 			checkShardState(t, e, id[1], 0, mode.ReadWrite)
 		}
 
 		for i := uint32(0); i < 2; i++ {
-			_, err = e.Get(GetPrm{addr: object.AddressOf(obj)})
+			_, err = e.Get(context.Background(), GetPrm{addr: object.AddressOf(obj)})
 			require.Error(t, err)
 			checkShardState(t, e, id[0], errThreshold+i, mode.DegradedReadOnly)
 			checkShardState(t, e, id[1], 0, mode.ReadWrite)
@@ -151,7 +152,7 @@ func TestBlobstorFailback(t *testing.T) {
 		var prm shard.PutPrm
 		prm.SetObject(obj)
 		e.mtx.RLock()
-		_, err = e.shards[id[0].String()].Shard.Put(prm)
+		_, err = e.shards[id[0].String()].Shard.Put(context.Background(), prm)
 		e.mtx.RUnlock()
 		require.NoError(t, err)
 		objs = append(objs, obj)
@@ -159,9 +160,9 @@ func TestBlobstorFailback(t *testing.T) {
 
 	for i := range objs {
 		addr := object.AddressOf(objs[i])
-		_, err = e.Get(GetPrm{addr: addr})
+		_, err = e.Get(context.Background(), GetPrm{addr: addr})
 		require.NoError(t, err)
-		_, err = e.GetRange(RngPrm{addr: addr})
+		_, err = e.GetRange(context.Background(), RngPrm{addr: addr})
 		require.NoError(t, err)
 	}
 
@@ -179,15 +180,15 @@ func TestBlobstorFailback(t *testing.T) {
 
 	for i := range objs {
 		addr := object.AddressOf(objs[i])
-		getRes, err := e.Get(GetPrm{addr: addr})
+		getRes, err := e.Get(context.Background(), GetPrm{addr: addr})
 		require.NoError(t, err)
 		require.Equal(t, objs[i], getRes.Object())
 
-		rngRes, err := e.GetRange(RngPrm{addr: addr, off: 1, ln: 10})
+		rngRes, err := e.GetRange(context.Background(), RngPrm{addr: addr, off: 1, ln: 10})
 		require.NoError(t, err)
 		require.Equal(t, objs[i].Payload()[1:11], rngRes.Object().Payload())
 
-		_, err = e.GetRange(RngPrm{addr: addr, off: errSmallSize + 10, ln: 1})
+		_, err = e.GetRange(context.Background(), RngPrm{addr: addr, off: errSmallSize + 10, ln: 1})
 		require.ErrorAs(t, err, &apistatus.ObjectOutOfRange{})
 	}
 
@@ -1,15 +1,18 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/TrueCloudLab/hrw"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -18,11 +21,27 @@ type EvacuateShardPrm struct {
 	shardID      []*shard.ID
 	handler      func(oid.Address, *objectSDK.Object) error
 	ignoreErrors bool
+	async        bool
 }
 
 // EvacuateShardRes represents result of the EvacuateShard operation.
 type EvacuateShardRes struct {
-	count int
+	evacuated *atomic.Uint64
+	skipped   *atomic.Uint64
+	failed    *atomic.Uint64
+	total     *atomic.Uint64
+	bytes     *atomic.Uint64
+}
+
+// NewEvacuateShardRes creates new EvacuateShardRes instance.
+func NewEvacuateShardRes() *EvacuateShardRes {
+	return &EvacuateShardRes{
+		evacuated: atomic.NewUint64(0),
+		skipped:   atomic.NewUint64(0),
+		failed:    atomic.NewUint64(0),
+		total:     atomic.NewUint64(0),
+		bytes:     atomic.NewUint64(0),
+	}
 }
 
 // WithShardIDList sets shard ID.
@@ -40,10 +59,54 @@ func (p *EvacuateShardPrm) WithFaultHandler(f func(oid.Address, *objectSDK.Objec
 	p.handler = f
 }
 
-// Count returns amount of evacuated objects.
+// WithAsync sets flag to run evacuation in the background.
+// The background evacuation is not bound to the context passed to Evacuate,
+// it can be stopped with StopEvacuation.
+func (p *EvacuateShardPrm) WithAsync(async bool) {
+	p.async = async
+}
+
+// Evacuated returns amount of evacuated objects.
 // Objects for which handler returned no error are also assumed evacuated.
-func (p EvacuateShardRes) Count() int {
-	return p.count
+func (p *EvacuateShardRes) Evacuated() uint64 {
+	if p == nil {
+		return 0
+	}
+	return p.evacuated.Load()
+}
+
+// Skipped returns amount of objects which were already present on other shards.
+func (p *EvacuateShardRes) Skipped() uint64 {
+	if p == nil {
+		return 0
+	}
+	return p.skipped.Load()
+}
+
+// Failed returns amount of objects which could not be evacuated.
+func (p *EvacuateShardRes) Failed() uint64 {
+	if p == nil {
+		return 0
+	}
+	return p.failed.Load()
+}
+
+// Total returns total amount of objects in the evacuated shards.
+// The value is taken from the metabase counters and may include
+// objects which are not subject to evacuation.
+func (p *EvacuateShardRes) Total() uint64 {
+	if p == nil {
+		return 0
+	}
+	return p.total.Load()
+}
+
+// Bytes returns total payload size of the evacuated objects.
+func (p *EvacuateShardRes) Bytes() uint64 {
+	if p == nil {
+		return 0
+	}
+	return p.bytes.Load()
 }
 
 const defaultEvacuateBatchSize = 100
@@ -57,137 +120,268 @@ var errMustHaveTwoShards = errors.New("must have at least 1 spare shard")
 
 // Evacuate moves data from one shard to the others.
 // The shard being moved must be in read-only mode.
-func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error) {
-	sidList := make([]string, len(prm.shardID))
+//
+// Progress of every shard is persisted after each processed batch,
+// so an interrupted evacuation continues from the last checkpoint
+// when it is started again for the same shard.
+func (e *StorageEngine) Evacuate(ctx context.Context, prm EvacuateShardPrm) (*EvacuateShardRes, error) {
+	shardIDs := make([]string, len(prm.shardID))
 	for i := range prm.shardID {
-		sidList[i] = prm.shardID[i].String()
+		shardIDs[i] = prm.shardID[i].String()
 	}
 
-	e.mtx.RLock()
-	for i := range sidList {
-		sh, ok := e.shards[sidList[i]]
-		if !ok {
-			e.mtx.RUnlock()
-			return EvacuateShardRes{}, errShardNotFound
+	shards, weights, err := e.getActualShards(shardIDs, prm.handler != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	shardsToEvacuate := make(map[string]*shard.Shard)
+	for i := range shardIDs {
+		for j := range shards {
+			if shards[j].ID().String() == shardIDs[i] {
+				shardsToEvacuate[shardIDs[i]] = shards[j].Shard
+			}
 		}
+	}
 
-		if !sh.GetMode().ReadOnly() {
-			e.mtx.RUnlock()
-			return EvacuateShardRes{}, shard.ErrMustBeReadOnly
+	res := NewEvacuateShardRes()
+	checkpoints := make(map[string]*evacuationCheckpoint, len(shardIDs))
+	for _, id := range shardIDs {
+		sh := shardsToEvacuate[id]
+
+		cp := e.loadEvacuationCheckpoint(sh)
+		res.evacuated.Add(cp.evacuated)
+		res.skipped.Add(cp.skipped)
+		res.failed.Add(cp.failed)
+		res.bytes.Add(cp.bytes)
+		checkpoints[id] = cp
+
+		cc, err := sh.ObjectCounters()
+		if err != nil {
+			e.log.Debug("could not get object counters of the evacuated shard",
+				zap.String("shard_id", id),
+				zap.Error(err))
+			continue
 		}
+		res.total.Add(cc.Phy())
 	}
 
-	if len(e.shards)-len(sidList) < 1 && prm.handler == nil {
-		e.mtx.RUnlock()
-		return EvacuateShardRes{}, errMustHaveTwoShards
+	if prm.async {
+		ctx = context.Background()
 	}
 
-	e.log.Info("started shards evacuation", zap.Strings("shard_ids", sidList))
+	ctx, err = e.evacuateLimiter.TryStart(ctx, shardIDs, res)
+	if err != nil {
+		return nil, err
+	}
 
-	// We must have all shards, to have correct information about their
-	// indexes in a sorted slice and set appropriate marks in the metabase.
-	// Evacuated shard is skipped during put.
-	shards := make([]pooledShard, 0, len(e.shards))
-	for id := range e.shards {
-		shards = append(shards, pooledShard{
-			hashedShard: hashedShard(e.shards[id]),
-			pool:        e.shardPools[id],
-		})
+	run := func() error {
+		// evacuation must not compete with the client requests
+		ctx := shard.ContextWithIOClass(ctx, shard.IOClassBackground)
+		err := e.evacuateShards(ctx, shardIDs, prm, res, checkpoints, shards, weights, shardsToEvacuate)
+		e.evacuateLimiter.Complete(err)
+		return err
 	}
-	e.mtx.RUnlock()
 
-	weights := make([]float64, 0, len(shards))
-	for i := range shards {
-		weights = append(weights, e.shardWeight(shards[i].Shard))
+	if prm.async {
+		e.wg.Add(1)
+		go func() {
+			defer e.wg.Done()
+			_ = run()
+		}()
+		return res, nil
 	}
 
-	shardMap := make(map[string]*shard.Shard)
-	for i := range sidList {
-		for j := range shards {
-			if shards[j].ID().String() == sidList[i] {
-				shardMap[sidList[i]] = shards[j].Shard
-			}
+	return res, run()
+}
+
+func (e *StorageEngine) evacuateShards(ctx context.Context, shardIDs []string, prm EvacuateShardPrm, res *EvacuateShardRes,
+	checkpoints map[string]*evacuationCheckpoint, shards []pooledShard, weights []float64, shardsToEvacuate map[string]*shard.Shard) error {
+	e.log.Info("started shards evacuation",
+		zap.Strings("shard_ids", shardIDs),
+		zap.Bool("async", prm.async))
+
+	for _, id := range shardIDs {
+		err := e.evacuateShard(ctx, id, prm, res, checkpoints[id], shards, weights, shardsToEvacuate)
+		if err != nil {
+			e.log.Error("finished with error shards evacuation",
+				zap.Strings("shard_ids", shardIDs),
+				zap.Uint64("evacuated", res.Evacuated()),
+				zap.Uint64("failed", res.Failed()),
+				zap.Error(err))
+			return err
 		}
 	}
 
-	var listPrm shard.ListWithCursorPrm
-	listPrm.WithCount(defaultEvacuateBatchSize)
+	e.log.Info("finished shards evacuation",
+		zap.Strings("shard_ids", shardIDs),
+		zap.Uint64("evacuated", res.Evacuated()),
+		zap.Uint64("skipped", res.Skipped()),
+		zap.Uint64("failed", res.Failed()))
+	return nil
+}
+
+func (e *StorageEngine) evacuateShard(ctx context.Context, shardID string, prm EvacuateShardPrm, res *EvacuateShardRes,
+	cp *evacuationCheckpoint, shards []pooledShard, weights []float64, shardsToEvacuate map[string]*shard.Shard) error {
+	sh := shardsToEvacuate[shardID]
 
-	var res EvacuateShardRes
+	// Shards are processed sequentially, so the difference between current
+	// result counters and these ones is the progress of this shard.
+	var (
+		evacuatedBase = res.Evacuated() - cp.evacuated
+		skippedBase   = res.Skipped() - cp.skipped
+		failedBase    = res.Failed() - cp.failed
+		bytesBase     = res.Bytes() - cp.bytes
+	)
 
-mainLoop:
-	for n := range sidList {
-		sh := shardMap[sidList[n]]
+	var listPrm shard.ListWithCursorPrm
+	listPrm.WithCount(defaultEvacuateBatchSize)
 
-		var c *meta.Cursor
-		for {
-			listPrm.WithCursor(c)
+	for {
+		listPrm.WithCursor(cp.cursor)
 
-			// TODO (@fyrchik): #1731 this approach doesn't work in degraded modes
-			//  because ListWithCursor works only with the metabase.
-			listRes, err := sh.ListWithCursor(listPrm)
-			if err != nil {
-				if errors.Is(err, meta.ErrEndOfListing) || errors.Is(err, shard.ErrDegradedMode) {
-					continue mainLoop
-				}
-				return res, err
+		// TODO (@fyrchik): #1731 this approach doesn't work in degraded modes
+		//  because ListWithCursor works only with the metabase.
+		listRes, err := sh.ListWithCursor(listPrm)
+		if err != nil {
+			if errors.Is(err, meta.ErrEndOfListing) {
+				e.removeEvacuationCheckpoint(sh)
+				return nil
 			}
+			if errors.Is(err, shard.ErrDegradedMode) {
+				// the checkpoint is kept to resume
+				// when the metabase is available again
+				return nil
+			}
+			return err
+		}
 
-			// TODO (@fyrchik): #1731 parallelize the loop
-			lst := listRes.AddressList()
+		// TODO (@fyrchik): #1731 parallelize the loop
+		err = e.evacuateObjects(ctx, sh, listRes.AddressList(), prm, res, shards, weights, shardsToEvacuate)
+		if err != nil {
+			return err
+		}
 
-		loop:
-			for i := range lst {
-				addr := lst[i].Address
+		cp.cursor = listRes.Cursor()
+		cp.evacuated = res.Evacuated() - evacuatedBase
+		cp.skipped = res.Skipped() - skippedBase
+		cp.failed = res.Failed() - failedBase
+		cp.bytes = res.Bytes() - bytesBase
+		e.storeEvacuationCheckpoint(sh, cp)
+	}
+}
 
-				var getPrm shard.GetPrm
-				getPrm.SetAddress(addr)
+func (e *StorageEngine) evacuateObjects(ctx context.Context, sh *shard.Shard, toEvacuate []objectcore.AddressWithType, prm EvacuateShardPrm, res *EvacuateShardRes,
+	shards []pooledShard, weights []float64, shardsToEvacuate map[string]*shard.Shard) error {
+loop:
+	for i := range toEvacuate {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
 
-				getRes, err := sh.Get(getPrm)
-				if err != nil {
-					if prm.ignoreErrors {
-						continue
-					}
-					return res, err
-				}
+		addr := toEvacuate[i].Address
 
-				hrw.SortHasherSliceByWeightValue(shards, weights, hrw.Hash([]byte(addr.EncodeToString())))
-				for j := range shards {
-					if _, ok := shardMap[shards[j].ID().String()]; ok {
-						continue
-					}
-					putDone, exists := e.putToShard(shards[j].hashedShard, j, shards[j].pool, addr, getRes.Object())
-					if putDone || exists {
-						if putDone {
-							e.log.Debug("object is moved to another shard",
-								zap.String("from", sidList[n]),
-								zap.Stringer("to", shards[j].ID()),
-								zap.Stringer("addr", addr))
-
-							res.count++
-						}
-						continue loop
-					}
-				}
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(addr)
 
-				if prm.handler == nil {
-					// Do not check ignoreErrors flag here because
-					// ignoring errors on put make this command kinda useless.
-					return res, fmt.Errorf("%w: %s", errPutShard, lst[i])
-				}
+		getRes, err := sh.Get(ctx, getPrm)
+		if err != nil {
+			if prm.ignoreErrors {
+				res.failed.Inc()
+				continue
+			}
+			return err
+		}
+
+		obj := getRes.Object()
 
-				err = prm.handler(addr, getRes.Object())
-				if err != nil {
-					return res, err
+		hrw.SortHasherSliceByWeightValue(shards, weights, hrw.Hash([]byte(addr.EncodeToString())))
+		for j := range shards {
+			if _, ok := shardsToEvacuate[shards[j].ID().String()]; ok {
+				continue
+			}
+			putDone, exists := e.putToShard(ctx, shards[j].hashedShard, j, shards[j].pool, addr, obj)
+			if putDone || exists {
+				if putDone {
+					e.log.Debug("object is moved to another shard",
+						zap.Stringer("from", sh.ID()),
+						zap.Stringer("to", shards[j].ID()),
+						zap.Stringer("addr", addr))
+
+					res.evacuated.Inc()
+					res.bytes.Add(obj.PayloadSize())
+				} else {
+					res.skipped.Inc()
 				}
-				res.count++
+				continue loop
 			}
+		}
+
+		if prm.handler == nil {
+			// Do not check ignoreErrors flag here because
+			// ignoring errors on put make this command kinda useless.
+			res.failed.Inc()
+			return fmt.Errorf("%w: %s", errPutShard, toEvacuate[i])
+		}
 
-			c = listRes.Cursor()
+		err = prm.handler(addr, obj)
+		if err != nil {
+			res.failed.Inc()
+			return err
 		}
+		res.evacuated.Inc()
+		res.bytes.Add(obj.PayloadSize())
 	}
+	return nil
+}
 
-	e.log.Info("finished shards evacuation",
-		zap.Strings("shard_ids", sidList))
-	return res, nil
+func (e *StorageEngine) getActualShards(shardIDs []string, handlerDefined bool) ([]pooledShard, []float64, error) {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	for i := range shardIDs {
+		sh, ok := e.shards[shardIDs[i]]
+		if !ok {
+			return nil, nil, errShardNotFound
+		}
+
+		if !sh.GetMode().ReadOnly() {
+			return nil, nil, shard.ErrMustBeReadOnly
+		}
+	}
+
+	if len(e.shards)-len(shardIDs) < 1 && !handlerDefined {
+		return nil, nil, errMustHaveTwoShards
+	}
+
+	// We must have all shards, to have correct information about their
+	// indexes in a sorted slice and set appropriate marks in the metabase.
+	// Evacuated shard is skipped during put.
+	shards := make([]pooledShard, 0, len(e.shards))
+	for id := range e.shards {
+		shards = append(shards, pooledShard{
+			hashedShard: hashedShard(e.shards[id]),
+			pool:        e.shardPools[id],
+		})
+	}
+
+	weights := make([]float64, 0, len(shards))
+	for i := range shards {
+		weights = append(weights, e.shardWeight(shards[i].Shard))
+	}
+
+	return shards, weights, nil
+}
+
+// GetEvacuationState returns the state of the last started evacuation.
+func (e *StorageEngine) GetEvacuationState() *EvacuationState {
+	return e.evacuateLimiter.GetState()
+}
+
+// StopEvacuation stops the running evacuation. Progress of the evacuated
+// shards is kept, so the evacuation can be resumed later.
+func (e *StorageEngine) StopEvacuation() error {
+	return e.evacuateLimiter.CancelIfRunning()
 }
@@ -0,0 +1,157 @@
+package engine
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"go.uber.org/zap"
+)
+
+// evacuationCheckpointSuffix is appended to the metabase path of a shard
+// to get the path of the evacuation checkpoint file.
+const evacuationCheckpointSuffix = ".evacuation"
+
+// evacuationCheckpoint is the persisted progress of a single shard evacuation.
+// It allows evacuation to continue from the last processed batch after
+// it was stopped or the node was restarted.
+//
+// Binary format: evacuated, skipped, failed and bytes counters as
+// little-endian uint64 followed by the marshaled listing cursor.
+type evacuationCheckpoint struct {
+	cursor    *meta.Cursor
+	evacuated uint64
+	skipped   uint64
+	failed    uint64
+	bytes     uint64
+}
+
+const evacuationCheckpointHeaderSize = 4 * 8
+
+func (c *evacuationCheckpoint) marshal() ([]byte, error) {
+	var rawCursor []byte
+	if c.cursor != nil {
+		var err error
+		rawCursor, err = c.cursor.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data := make([]byte, evacuationCheckpointHeaderSize, evacuationCheckpointHeaderSize+len(rawCursor))
+	binary.LittleEndian.PutUint64(data, c.evacuated)
+	binary.LittleEndian.PutUint64(data[8:], c.skipped)
+	binary.LittleEndian.PutUint64(data[16:], c.failed)
+	binary.LittleEndian.PutUint64(data[24:], c.bytes)
+	return append(data, rawCursor...), nil
+}
+
+func (c *evacuationCheckpoint) unmarshal(data []byte) error {
+	if len(data) < evacuationCheckpointHeaderSize {
+		return errors.New("checkpoint is too short")
+	}
+
+	c.evacuated = binary.LittleEndian.Uint64(data)
+	c.skipped = binary.LittleEndian.Uint64(data[8:])
+	c.failed = binary.LittleEndian.Uint64(data[16:])
+	c.bytes = binary.LittleEndian.Uint64(data[24:])
+
+	c.cursor = nil
+	if len(data) > evacuationCheckpointHeaderSize {
+		c.cursor = new(meta.Cursor)
+		return c.cursor.UnmarshalBinary(data[evacuationCheckpointHeaderSize:])
+	}
+	return nil
+}
+
+// evacuationCheckpointPath returns the path of the shard evacuation checkpoint
+// or an empty string if the shard has no metabase path configured.
+func evacuationCheckpointPath(sh *shard.Shard) string {
+	p := sh.DumpInfo().MetaBaseInfo.Path
+	if p == "" {
+		return ""
+	}
+	return p + evacuationCheckpointSuffix
+}
+
+// loadEvacuationCheckpoint reads the checkpoint of the shard evacuation.
+// Returns an empty checkpoint if there is none or it can't be read.
+func (e *StorageEngine) loadEvacuationCheckpoint(sh *shard.Shard) *evacuationCheckpoint {
+	cp := new(evacuationCheckpoint)
+
+	p := evacuationCheckpointPath(sh)
+	if p == "" {
+		return cp
+	}
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			e.log.Warn("could not read evacuation checkpoint, starting from the beginning",
+				zap.Stringer("shard_id", sh.ID()),
+				zap.Error(err))
+		}
+		return cp
+	}
+
+	if err := cp.unmarshal(data); err != nil {
+		e.log.Warn("invalid evacuation checkpoint, starting from the beginning",
+			zap.Stringer("shard_id", sh.ID()),
+			zap.Error(err))
+		return new(evacuationCheckpoint)
+	}
+
+	e.log.Info("resuming shard evacuation from checkpoint",
+		zap.Stringer("shard_id", sh.ID()),
+		zap.Uint64("evacuated", cp.evacuated),
+		zap.Uint64("skipped", cp.skipped),
+		zap.Uint64("failed", cp.failed))
+	return cp
+}
+
+// storeEvacuationCheckpoint persists the checkpoint of the shard evacuation.
+// Errors are logged, because evacuation itself can proceed without it.
+func (e *StorageEngine) storeEvacuationCheckpoint(sh *shard.Shard, cp *evacuationCheckpoint) {
+	p := evacuationCheckpointPath(sh)
+	if p == "" {
+		return
+	}
+
+	err := writeEvacuationCheckpoint(p, cp)
+	if err != nil {
+		e.log.Warn("could not store evacuation checkpoint",
+			zap.Stringer("shard_id", sh.ID()),
+			zap.Error(err))
+	}
+}
+
+func writeEvacuationCheckpoint(p string, cp *evacuationCheckpoint) error {
+	data, err := cp.marshal()
+	if err != nil {
+		return err
+	}
+
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("could not write temporary file: %w", err)
+	}
+	return os.Rename(tmp, p)
+}
+
+// removeEvacuationCheckpoint removes the checkpoint after the shard was fully evacuated.
+func (e *StorageEngine) removeEvacuationCheckpoint(sh *shard.Shard) {
+	p := evacuationCheckpointPath(sh)
+	if p == "" {
+		return
+	}
+
+	err := os.Remove(p)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		e.log.Warn("could not remove evacuation checkpoint",
+			zap.Stringer("shard_id", sh.ID()),
+			zap.Error(err))
+	}
+}
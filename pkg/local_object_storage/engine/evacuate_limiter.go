@@ -0,0 +1,225 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+)
+
+// EvacuateProcessState describes the state of the evacuation process.
+type EvacuateProcessState int
+
+const (
+	// EvacuateProcessStateUndefined means that no evacuation was started since the engine was opened.
+	EvacuateProcessStateUndefined EvacuateProcessState = iota
+	// EvacuateProcessStateRunning means that evacuation is in progress.
+	EvacuateProcessStateRunning
+	// EvacuateProcessStateCompleted means that evacuation has finished, successfully or not.
+	EvacuateProcessStateCompleted
+)
+
+var errEvacuationNotRunning = logicerr.New("there is no running evacuation")
+
+// EvacuationState represents the state of the last started evacuation.
+type EvacuationState struct {
+	shardIDs     []string
+	processState EvacuateProcessState
+	startedAt    time.Time
+	finishedAt   time.Time
+	errMessage   string
+
+	// processedAtStart is the amount of objects processed before the
+	// current run (restored from checkpoints). It is used to estimate
+	// the evacuation speed.
+	processedAtStart uint64
+
+	result *EvacuateShardRes
+}
+
+// ShardIDs returns identifiers of the shards being evacuated.
+func (s *EvacuationState) ShardIDs() []string {
+	if s == nil {
+		return nil
+	}
+	return s.shardIDs
+}
+
+// Evacuated returns amount of evacuated objects.
+func (s *EvacuationState) Evacuated() uint64 {
+	if s == nil {
+		return 0
+	}
+	return s.result.Evacuated()
+}
+
+// Total returns total amount of objects in the evacuated shards.
+// The value is calculated from the metabase counters and is an estimation.
+func (s *EvacuationState) Total() uint64 {
+	if s == nil {
+		return 0
+	}
+	return s.result.Total()
+}
+
+// Failed returns amount of objects which could not be evacuated.
+func (s *EvacuationState) Failed() uint64 {
+	if s == nil {
+		return 0
+	}
+	return s.result.Failed()
+}
+
+// Skipped returns amount of objects which were already present on other shards.
+func (s *EvacuationState) Skipped() uint64 {
+	if s == nil {
+		return 0
+	}
+	return s.result.Skipped()
+}
+
+// Bytes returns total payload size of the evacuated objects.
+func (s *EvacuationState) Bytes() uint64 {
+	if s == nil {
+		return 0
+	}
+	return s.result.Bytes()
+}
+
+// ProcessingStatus returns the state of the evacuation process.
+func (s *EvacuationState) ProcessingStatus() EvacuateProcessState {
+	if s == nil {
+		return EvacuateProcessStateUndefined
+	}
+	return s.processState
+}
+
+// StartedAt returns the time evacuation was started at, nil if it was never started.
+func (s *EvacuationState) StartedAt() *time.Time {
+	if s == nil || s.startedAt.IsZero() {
+		return nil
+	}
+	return &s.startedAt
+}
+
+// FinishedAt returns the time evacuation was finished at, nil if it is not finished.
+func (s *EvacuationState) FinishedAt() *time.Time {
+	if s == nil || s.finishedAt.IsZero() {
+		return nil
+	}
+	return &s.finishedAt
+}
+
+// ErrorMessage returns the error evacuation has finished with, if any.
+func (s *EvacuationState) ErrorMessage() string {
+	if s == nil {
+		return ""
+	}
+	return s.errMessage
+}
+
+// Duration returns evacuation duration, nil if it was never started.
+func (s *EvacuationState) Duration() *time.Duration {
+	if s == nil || s.startedAt.IsZero() {
+		return nil
+	}
+
+	end := s.finishedAt
+	if end.IsZero() {
+		end = time.Now().UTC()
+	}
+
+	d := end.Sub(s.startedAt)
+	return &d
+}
+
+// ETA returns estimated time left until the evacuation is finished.
+// Returns nil if evacuation is not running or the estimation is not possible yet.
+func (s *EvacuationState) ETA() *time.Duration {
+	if s == nil || s.processState != EvacuateProcessStateRunning {
+		return nil
+	}
+
+	processed := s.Evacuated() + s.Failed() + s.Skipped()
+	if processed <= s.processedAtStart {
+		return nil
+	}
+
+	var left time.Duration
+	if total := s.Total(); total > processed {
+		elapsed := time.Since(s.startedAt)
+		speed := float64(processed-s.processedAtStart) / float64(elapsed)
+		left = time.Duration(float64(total-processed) / speed)
+	}
+	return &left
+}
+
+// evacuationLimiter allows only one evacuation to run at a time
+// and keeps the state of the last one.
+type evacuationLimiter struct {
+	state  EvacuationState
+	cancel context.CancelFunc
+
+	guard sync.RWMutex
+}
+
+// TryStart marks evacuation of the provided shards as running.
+// Returns an error if another evacuation is already in progress.
+func (l *evacuationLimiter) TryStart(ctx context.Context, shardIDs []string, result *EvacuateShardRes) (context.Context, error) {
+	l.guard.Lock()
+	defer l.guard.Unlock()
+
+	if l.state.processState == EvacuateProcessStateRunning {
+		return nil, logicerr.New(fmt.Sprintf("evacuate is already running for shard ids %v", l.state.shardIDs))
+	}
+
+	ctx, l.cancel = context.WithCancel(ctx)
+	l.state = EvacuationState{
+		shardIDs:         shardIDs,
+		processState:     EvacuateProcessStateRunning,
+		startedAt:        time.Now().UTC(),
+		processedAtStart: result.Evacuated() + result.Failed() + result.Skipped(),
+		result:           result,
+	}
+
+	return ctx, nil
+}
+
+// Complete marks running evacuation as finished with the provided error.
+func (l *evacuationLimiter) Complete(err error) {
+	l.guard.Lock()
+	defer l.guard.Unlock()
+
+	l.state.processState = EvacuateProcessStateCompleted
+	l.state.finishedAt = time.Now().UTC()
+	if err != nil {
+		l.state.errMessage = err.Error()
+	}
+
+	l.cancel()
+}
+
+// GetState returns a copy of the current evacuation state.
+func (l *evacuationLimiter) GetState() *EvacuationState {
+	l.guard.RLock()
+	defer l.guard.RUnlock()
+
+	s := l.state
+	return &s
+}
+
+// CancelIfRunning cancels running evacuation.
+// Returns an error if there is no evacuation in progress.
+func (l *evacuationLimiter) CancelIfRunning() error {
+	l.guard.Lock()
+	defer l.guard.Unlock()
+
+	if l.state.processState != EvacuateProcessStateRunning {
+		return errEvacuationNotRunning
+	}
+
+	l.cancel()
+	return nil
+}
@@ -1,12 +1,14 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 	"testing"
+	"time"
 
 	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
@@ -60,7 +62,7 @@ func newEngineEvacuate(t *testing.T, shardNum int, objPerShard int) (*StorageEng
 
 		var putPrm shard.PutPrm
 		putPrm.SetObject(obj)
-		_, err := e.shards[sh.String()].Put(putPrm)
+		_, err := e.shards[sh.String()].Put(context.Background(), putPrm)
 		require.NoError(t, err)
 	}
 
@@ -70,7 +72,7 @@ func newEngineEvacuate(t *testing.T, shardNum int, objPerShard int) (*StorageEng
 		var putPrm PutPrm
 		putPrm.WithObject(objects[len(objects)-1])
 
-		_, err := e.Put(putPrm)
+		_, err := e.Put(context.Background(), putPrm)
 		require.NoError(t, err)
 
 		res, err := e.shards[ids[len(ids)-1].String()].List()
@@ -94,7 +96,7 @@ func TestEvacuateShard(t *testing.T) {
 			var prm GetPrm
 			prm.WithAddress(objectCore.AddressOf(objects[i]))
 
-			_, err := e.Get(prm)
+			_, err := e.Get(context.Background(), prm)
 			require.NoError(t, err)
 		}
 	}
@@ -105,16 +107,16 @@ func TestEvacuateShard(t *testing.T) {
 	prm.WithShardIDList(ids[2:3])
 
 	t.Run("must be read-only", func(t *testing.T) {
-		res, err := e.Evacuate(prm)
+		res, err := e.Evacuate(context.Background(), prm)
 		require.ErrorIs(t, err, shard.ErrMustBeReadOnly)
-		require.Equal(t, 0, res.Count())
+		require.Equal(t, uint64(0), res.Evacuated())
 	})
 
 	require.NoError(t, e.shards[evacuateShardID].SetMode(mode.ReadOnly))
 
-	res, err := e.Evacuate(prm)
+	res, err := e.Evacuate(context.Background(), prm)
 	require.NoError(t, err)
-	require.Equal(t, objPerShard, res.count)
+	require.Equal(t, uint64(objPerShard), res.Evacuated())
 
 	// We check that all objects are available both before and after shard removal.
 	// First case is a real-world use-case. It ensures that an object can be put in presense
@@ -123,9 +125,9 @@ func TestEvacuateShard(t *testing.T) {
 	checkHasObjects(t)
 
 	// Calling it again is OK, but all objects are already moved, so no new PUTs should be done.
-	res, err = e.Evacuate(prm)
+	res, err = e.Evacuate(context.Background(), prm)
 	require.NoError(t, err)
-	require.Equal(t, 0, res.count)
+	require.Equal(t, uint64(0), res.Evacuated())
 
 	checkHasObjects(t)
 
@@ -168,15 +170,15 @@ func TestEvacuateNetwork(t *testing.T) {
 		var prm EvacuateShardPrm
 		prm.shardID = ids[0:1]
 
-		res, err := e.Evacuate(prm)
+		res, err := e.Evacuate(context.Background(), prm)
 		require.ErrorIs(t, err, errMustHaveTwoShards)
-		require.Equal(t, 0, res.Count())
+		require.Equal(t, uint64(0), res.Evacuated())
 
 		prm.handler = acceptOneOf(objects, 2)
 
-		res, err = e.Evacuate(prm)
+		res, err = e.Evacuate(context.Background(), prm)
 		require.ErrorIs(t, err, errReplication)
-		require.Equal(t, 2, res.Count())
+		require.Equal(t, uint64(2), res.Evacuated())
 	})
 	t.Run("multiple shards, evacuate one", func(t *testing.T) {
 		e, ids, objects := newEngineEvacuate(t, 2, 3)
@@ -188,16 +190,16 @@ func TestEvacuateNetwork(t *testing.T) {
 		prm.shardID = ids[1:2]
 		prm.handler = acceptOneOf(objects, 2)
 
-		res, err := e.Evacuate(prm)
+		res, err := e.Evacuate(context.Background(), prm)
 		require.ErrorIs(t, err, errReplication)
-		require.Equal(t, 2, res.Count())
+		require.Equal(t, uint64(2), res.Evacuated())
 
 		t.Run("no errors", func(t *testing.T) {
 			prm.handler = acceptOneOf(objects, 3)
 
-			res, err := e.Evacuate(prm)
+			res, err := e.Evacuate(context.Background(), prm)
 			require.NoError(t, err)
-			require.Equal(t, 3, res.Count())
+			require.Equal(t, uint64(3), res.Evacuated())
 		})
 	})
 	t.Run("multiple shards, evacuate many", func(t *testing.T) {
@@ -220,16 +222,166 @@ func TestEvacuateNetwork(t *testing.T) {
 		prm.shardID = evacuateIDs
 		prm.handler = acceptOneOf(objects, totalCount-1)
 
-		res, err := e.Evacuate(prm)
+		res, err := e.Evacuate(context.Background(), prm)
 		require.ErrorIs(t, err, errReplication)
-		require.Equal(t, totalCount-1, res.Count())
+		require.Equal(t, uint64(totalCount-1), res.Evacuated())
 
 		t.Run("no errors", func(t *testing.T) {
 			prm.handler = acceptOneOf(objects, totalCount)
 
-			res, err := e.Evacuate(prm)
+			res, err := e.Evacuate(context.Background(), prm)
 			require.NoError(t, err)
-			require.Equal(t, totalCount, res.Count())
+			require.Equal(t, uint64(totalCount), res.Evacuated())
 		})
 	})
 }
+
+func TestEvacuateAsync(t *testing.T) {
+	e, ids, _ := newEngineEvacuate(t, 1, 3)
+	require.NoError(t, e.shards[ids[0].String()].SetMode(mode.ReadOnly))
+
+	require.Equal(t, EvacuateProcessStateUndefined, e.GetEvacuationState().ProcessingStatus())
+	require.Error(t, e.StopEvacuation())
+
+	blocked := make(chan struct{})
+	unblock := make(chan struct{})
+
+	var prm EvacuateShardPrm
+	prm.WithShardIDList(ids)
+	prm.WithAsync(true)
+	prm.WithFaultHandler(func(oid.Address, *objectSDK.Object) error {
+		select {
+		case blocked <- struct{}{}:
+		default:
+		}
+		<-unblock
+		return nil
+	})
+
+	_, err := e.Evacuate(context.Background(), prm)
+	require.NoError(t, err)
+
+	<-blocked
+
+	st := e.GetEvacuationState()
+	require.Equal(t, EvacuateProcessStateRunning, st.ProcessingStatus())
+	require.Equal(t, []string{ids[0].String()}, st.ShardIDs())
+	require.Equal(t, uint64(3), st.Total())
+	require.NotNil(t, st.StartedAt())
+	require.Nil(t, st.FinishedAt())
+
+	_, err = e.Evacuate(context.Background(), prm)
+	require.Error(t, err, "only one evacuation can be running")
+
+	require.NoError(t, e.StopEvacuation())
+	close(unblock)
+
+	require.Eventually(t, func() bool {
+		return e.GetEvacuationState().ProcessingStatus() == EvacuateProcessStateCompleted
+	}, 3*time.Second, 10*time.Millisecond)
+
+	st = e.GetEvacuationState()
+	require.Equal(t, uint64(1), st.Evacuated())
+	require.Equal(t, context.Canceled.Error(), st.ErrorMessage())
+	require.NotNil(t, st.FinishedAt())
+	require.Nil(t, st.ETA())
+}
+
+func TestEvacuateAsyncClose(t *testing.T) {
+	e, ids, _ := newEngineEvacuate(t, 1, 3)
+	require.NoError(t, e.shards[ids[0].String()].SetMode(mode.ReadOnly))
+
+	blocked := make(chan struct{})
+	unblock := make(chan struct{})
+
+	var prm EvacuateShardPrm
+	prm.WithShardIDList(ids)
+	prm.WithAsync(true)
+	prm.WithFaultHandler(func(oid.Address, *objectSDK.Object) error {
+		select {
+		case blocked <- struct{}{}:
+		default:
+		}
+		<-unblock
+		return nil
+	})
+
+	_, err := e.Evacuate(context.Background(), prm)
+	require.NoError(t, err)
+
+	<-blocked
+
+	closed := make(chan error, 1)
+	go func() { closed <- e.Close() }()
+
+	select {
+	case <-closed:
+		t.Fatal("engine must wait for the running evacuation before closing the shards")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(unblock)
+
+	select {
+	case err := <-closed:
+		require.NoError(t, err)
+	case <-time.After(3 * time.Second):
+		t.Fatal("engine was not closed")
+	}
+
+	st := e.GetEvacuationState()
+	require.Equal(t, EvacuateProcessStateCompleted, st.ProcessingStatus())
+	require.Equal(t, context.Canceled.Error(), st.ErrorMessage())
+}
+
+func TestEvacuateResume(t *testing.T) {
+	e, ids, objects := newEngineEvacuate(t, 1, 5)
+	sh := e.shards[ids[0].String()].Shard
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+
+	var listPrm shard.ListWithCursorPrm
+	listPrm.WithCount(2)
+	listRes, err := sh.ListWithCursor(listPrm)
+	require.NoError(t, err)
+
+	processed := make(map[oid.Address]struct{})
+	for _, a := range listRes.AddressList() {
+		processed[a.Address] = struct{}{}
+	}
+
+	e.storeEvacuationCheckpoint(sh, &evacuationCheckpoint{
+		cursor:    listRes.Cursor(),
+		evacuated: 2,
+	})
+
+	var prm EvacuateShardPrm
+	prm.WithShardIDList(ids)
+	prm.WithFaultHandler(func(addr oid.Address, _ *objectSDK.Object) error {
+		return nil
+	})
+
+	// the metabase can't be listed in the degraded mode
+	require.NoError(t, sh.SetMode(mode.DegradedReadOnly))
+	_, err = e.Evacuate(context.Background(), prm)
+	require.NoError(t, err)
+
+	_, err = os.Stat(evacuationCheckpointPath(sh))
+	require.NoError(t, err, "checkpoint must be kept in the degraded mode")
+
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+
+	prm.WithFaultHandler(func(addr oid.Address, _ *objectSDK.Object) error {
+		_, ok := processed[addr]
+		require.False(t, ok, "object from the checkpoint must not be processed again")
+		processed[addr] = struct{}{}
+		return nil
+	})
+
+	res, err := e.Evacuate(context.Background(), prm)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(objects)), res.Evacuated())
+	require.Equal(t, len(objects), len(processed))
+
+	_, err = os.Stat(evacuationCheckpointPath(sh))
+	require.ErrorIs(t, err, os.ErrNotExist, "checkpoint must be removed after evacuation")
+}
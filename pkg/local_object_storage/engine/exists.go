@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
@@ -9,14 +10,14 @@ import (
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 )
 
-func (e *StorageEngine) exists(addr oid.Address) (bool, error) {
+func (e *StorageEngine) exists(ctx context.Context, addr oid.Address) (bool, error) {
 	var shPrm shard.ExistsPrm
 	shPrm.SetAddress(addr)
 	alreadyRemoved := false
 	exists := false
 
 	e.iterateOverSortedShards(addr, func(_ int, sh hashedShard) (stop bool) {
-		res, err := sh.Exists(shPrm)
+		res, err := sh.Exists(ctx, shPrm)
 		if err != nil {
 			if shard.IsErrRemoved(err) {
 				alreadyRemoved = true
@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+)
+
+// ShardGCStatus is the garbage collector state of a particular shard.
+type ShardGCStatus struct {
+	shard.GCStatus
+
+	id *shard.ID
+}
+
+// ShardID returns the shard identifier.
+func (s ShardGCStatus) ShardID() *shard.ID {
+	return s.id
+}
+
+// GCStatus returns garbage collector states of the specified shards.
+func (e *StorageEngine) GCStatus(ids []*shard.ID) ([]ShardGCStatus, error) {
+	shards, err := e.getShardsByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]ShardGCStatus, 0, len(shards))
+	for i := range shards {
+		res = append(res, ShardGCStatus{
+			GCStatus: shards[i].GCStatus(),
+			id:       shards[i].ID(),
+		})
+	}
+	return res, nil
+}
+
+// TriggerGC starts a full garbage collection pass on the specified shards
+// in background. See shard.Shard.TriggerGC for details.
+func (e *StorageEngine) TriggerGC(ids []*shard.ID) error {
+	shards, err := e.getShardsByIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range shards {
+		if err := shards[i].TriggerGC(); err != nil {
+			return fmt.Errorf("could not trigger garbage collection on shard %s: %w", shards[i].ID(), err)
+		}
+	}
+	return nil
+}
+
+// SetGCPaused pauses or resumes garbage collection on the specified shards.
+func (e *StorageEngine) SetGCPaused(ids []*shard.ID, paused bool) error {
+	shards, err := e.getShardsByIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range shards {
+		if err := shards[i].SetGCPaused(paused); err != nil {
+			return fmt.Errorf("could not change garbage collector state of shard %s: %w", shards[i].ID(), err)
+		}
+	}
+	return nil
+}
+
+// SetGCParams changes garbage collector parameters of the specified shards.
+// Zero values leave the corresponding parameters unchanged.
+func (e *StorageEngine) SetGCParams(ids []*shard.ID, interval time.Duration, batchSize int) error {
+	shards, err := e.getShardsByIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range shards {
+		if err := shards[i].SetGCParams(interval, batchSize); err != nil {
+			return fmt.Errorf("could not change garbage collector parameters of shard %s: %w", shards[i].ID(), err)
+		}
+	}
+	return nil
+}
@@ -1,14 +1,18 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -43,16 +47,22 @@ func (r GetRes) Object() *objectSDK.Object {
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the object has been marked as removed.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) Get(prm GetPrm) (res GetRes, err error) {
+func (e *StorageEngine) Get(ctx context.Context, prm GetPrm) (res GetRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "StorageEngine.Get",
+		trace.WithAttributes(
+			attribute.String("address", prm.addr.EncodeToString()),
+		))
+	defer span.End()
+
 	err = e.execIfNotBlocked(func() error {
-		res, err = e.get(prm)
+		res, err = e.get(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) get(prm GetPrm) (GetRes, error) {
+func (e *StorageEngine) get(ctx context.Context, prm GetPrm) (GetRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddGetDuration)()
 	}
@@ -82,7 +92,7 @@ func (e *StorageEngine) get(prm GetPrm) (GetRes, error) {
 
 		hasDegraded = hasDegraded || noMeta
 
-		res, err := sh.Get(shPrm)
+		res, err := sh.Get(ctx, shPrm)
 		if err != nil {
 			if res.HasMeta() {
 				shardWithMeta = sh
@@ -151,7 +161,7 @@ func (e *StorageEngine) get(prm GetPrm) (GetRes, error) {
 				return false
 			}
 
-			res, err := sh.Get(shPrm)
+			res, err := sh.Get(ctx, shPrm)
 			obj = res.Object()
 			return err == nil
 		})
@@ -174,7 +184,7 @@ func Get(storage *StorageEngine, addr oid.Address) (*objectSDK.Object, error) {
 	var getPrm GetPrm
 	getPrm.WithAddress(addr)
 
-	res, err := storage.Get(getPrm)
+	res, err := storage.Get(context.Background(), getPrm)
 	if err != nil {
 		return nil, err
 	}
@@ -1,14 +1,18 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // HeadPrm groups the parameters of Head operation.
@@ -52,16 +56,23 @@ func (r HeadRes) Header() *objectSDK.Object {
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object was inhumed.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) Head(prm HeadPrm) (res HeadRes, err error) {
+func (e *StorageEngine) Head(ctx context.Context, prm HeadPrm) (res HeadRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "StorageEngine.Head",
+		trace.WithAttributes(
+			attribute.String("address", prm.addr.EncodeToString()),
+			attribute.Bool("raw", prm.raw),
+		))
+	defer span.End()
+
 	err = e.execIfNotBlocked(func() error {
-		res, err = e.head(prm)
+		res, err = e.head(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) head(prm HeadPrm) (HeadRes, error) {
+func (e *StorageEngine) head(ctx context.Context, prm HeadPrm) (HeadRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddHeadDuration)()
 	}
@@ -81,7 +92,7 @@ func (e *StorageEngine) head(prm HeadPrm) (HeadRes, error) {
 	shPrm.SetRaw(prm.raw)
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
-		res, err := sh.Head(shPrm)
+		res, err := sh.Head(ctx, shPrm)
 		if err != nil {
 			switch {
 			case shard.IsErrNotFound(err):
@@ -143,7 +154,7 @@ func Head(storage *StorageEngine, addr oid.Address) (*objectSDK.Object, error) {
 	var headPrm HeadPrm
 	headPrm.WithAddress(addr)
 
-	res, err := storage.Head(headPrm)
+	res, err := storage.Head(context.Background(), headPrm)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +169,7 @@ func HeadRaw(storage *StorageEngine, addr oid.Address, raw bool) (*objectSDK.Obj
 	headPrm.WithAddress(addr)
 	headPrm.WithRaw(raw)
 
-	res, err := storage.Head(headPrm)
+	res, err := storage.Head(context.Background(), headPrm)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -53,11 +54,11 @@ func TestHeadRaw(t *testing.T) {
 		putPrmLink.SetObject(link)
 
 		// put most left object in one shard
-		_, err := s1.Put(putPrmLeft)
+		_, err := s1.Put(context.Background(), putPrmLeft)
 		require.NoError(t, err)
 
 		// put link object in another shard
-		_, err = s2.Put(putPrmLink)
+		_, err = s2.Put(context.Background(), putPrmLink)
 		require.NoError(t, err)
 
 		// head with raw flag should return SplitInfoError
@@ -65,7 +66,7 @@ func TestHeadRaw(t *testing.T) {
 		headPrm.WithAddress(parentAddr)
 		headPrm.WithRaw(true)
 
-		_, err = e.Head(headPrm)
+		_, err = e.Head(context.Background(), headPrm)
 		require.Error(t, err)
 
 		var si *object.SplitInfoError
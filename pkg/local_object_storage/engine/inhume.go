@@ -6,9 +6,12 @@ import (
 
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -48,6 +51,10 @@ func (p *InhumePrm) WithForceRemoval() {
 	p.tombstone = nil
 }
 
+// RemovedObjectsCallback is a callback handling list of objects
+// marked as removed or as garbage.
+type RemovedObjectsCallback func([]oid.Address)
+
 var errInhumeFailure = errors.New("inhume operation failed")
 
 // Inhume calls metabase. Inhume method to mark an object as removed. It won't be
@@ -60,20 +67,31 @@ var errInhumeFailure = errors.New("inhume operation failed")
 // with that object) if WithForceRemoval option has been provided.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) Inhume(prm InhumePrm) (res InhumeRes, err error) {
+func (e *StorageEngine) Inhume(ctx context.Context, prm InhumePrm) (res InhumeRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "StorageEngine.Inhume",
+		trace.WithAttributes(
+			attribute.Int("address_count", len(prm.addrs)),
+			attribute.Bool("force_removal", prm.forceRemoval),
+		))
+	defer span.End()
+
 	err = e.execIfNotBlocked(func() error {
-		res, err = e.inhume(prm)
+		res, err = e.inhume(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) inhume(prm InhumePrm) (InhumeRes, error) {
+func (e *StorageEngine) inhume(ctx context.Context, prm InhumePrm) (InhumeRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddInhumeDuration)()
 	}
 
+	// notify even if the operation fails because some
+	// of the objects could already be marked as removed
+	defer e.notifyRemoved(prm.addrs...)
+
 	var shPrm shard.InhumePrm
 	if prm.forceRemoval {
 		shPrm.ForceRemoval()
@@ -98,12 +116,12 @@ func (e *StorageEngine) inhume(prm InhumePrm) (InhumeRes, error) {
 			shPrm.MarkAsGarbage(prm.addrs[i])
 		}
 
-		ok, err := e.inhumeAddr(prm.addrs[i], shPrm, true)
+		ok, err := e.inhumeAddr(ctx, prm.addrs[i], shPrm, true)
 		if err != nil {
 			return InhumeRes{}, err
 		}
 		if !ok {
-			ok, err := e.inhumeAddr(prm.addrs[i], shPrm, false)
+			ok, err := e.inhumeAddr(ctx, prm.addrs[i], shPrm, false)
 			if err != nil {
 				return InhumeRes{}, err
 			} else if !ok {
@@ -116,7 +134,7 @@ func (e *StorageEngine) inhume(prm InhumePrm) (InhumeRes, error) {
 }
 
 // Returns ok if object was inhumed during this invocation or before.
-func (e *StorageEngine) inhumeAddr(addr oid.Address, prm shard.InhumePrm, checkExists bool) (bool, error) {
+func (e *StorageEngine) inhumeAddr(ctx context.Context, addr oid.Address, prm shard.InhumePrm, checkExists bool) (bool, error) {
 	root := false
 	var errLocked apistatus.ObjectLocked
 	var existPrm shard.ExistsPrm
@@ -134,7 +152,7 @@ func (e *StorageEngine) inhumeAddr(addr oid.Address, prm shard.InhumePrm, checkE
 
 		if checkExists {
 			existPrm.SetAddress(addr)
-			exRes, err := sh.Exists(existPrm)
+			exRes, err := sh.Exists(ctx, existPrm)
 			if err != nil {
 				if shard.IsErrRemoved(err) || shard.IsErrObjectExpired(err) {
 					// inhumed once - no need to be inhumed again
@@ -154,7 +172,7 @@ func (e *StorageEngine) inhumeAddr(addr oid.Address, prm shard.InhumePrm, checkE
 			}
 		}
 
-		_, err := sh.Inhume(prm)
+		_, err := sh.Inhume(ctx, prm)
 		if err != nil {
 			switch {
 			case errors.As(err, &errLocked):
@@ -202,7 +220,24 @@ func (e *StorageEngine) isLocked(addr oid.Address) (bool, error) {
 	return locked, outErr
 }
 
+func (e *StorageEngine) notifyRemoved(addrs ...oid.Address) {
+	if e.removedCallback != nil && len(addrs) != 0 {
+		e.removedCallback(addrs)
+	}
+}
+
+func (e *StorageEngine) processExpiredObjects(_ context.Context, addrs []oid.Address) {
+	e.notifyRemoved(addrs...)
+}
+
 func (e *StorageEngine) processExpiredTombstones(ctx context.Context, addrs []meta.TombstonedObject) {
+	tsAddrs := make([]oid.Address, len(addrs))
+	for i := range addrs {
+		tsAddrs[i] = addrs[i].Tombstone()
+	}
+
+	defer e.notifyRemoved(tsAddrs...)
+
 	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
 		sh.HandleExpiredTombstones(addrs)
 
@@ -216,6 +251,8 @@ func (e *StorageEngine) processExpiredTombstones(ctx context.Context, addrs []me
 }
 
 func (e *StorageEngine) processExpiredLocks(ctx context.Context, lockers []oid.Address) {
+	defer e.notifyRemoved(lockers...)
+
 	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
 		sh.HandleExpiredLocks(lockers)
 
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/stretchr/testify/require"
 )
 
@@ -46,7 +48,7 @@ func TestStorageEngine_Inhume(t *testing.T) {
 		var inhumePrm InhumePrm
 		inhumePrm.WithTarget(tombstoneID, object.AddressOf(parent))
 
-		_, err = e.Inhume(inhumePrm)
+		_, err = e.Inhume(context.Background(), inhumePrm)
 		require.NoError(t, err)
 
 		addrs, err := Select(e, cnr, fs)
@@ -63,22 +65,46 @@ func TestStorageEngine_Inhume(t *testing.T) {
 
 		var putChild shard.PutPrm
 		putChild.SetObject(child)
-		_, err := s1.Put(putChild)
+		_, err := s1.Put(context.Background(), putChild)
 		require.NoError(t, err)
 
 		var putLink shard.PutPrm
 		putLink.SetObject(link)
-		_, err = s2.Put(putLink)
+		_, err = s2.Put(context.Background(), putLink)
 		require.NoError(t, err)
 
 		var inhumePrm InhumePrm
 		inhumePrm.WithTarget(tombstoneID, object.AddressOf(parent))
 
-		_, err = e.Inhume(inhumePrm)
+		_, err = e.Inhume(context.Background(), inhumePrm)
 		require.NoError(t, err)
 
 		addrs, err := Select(e, cnr, fs)
 		require.NoError(t, err)
 		require.Empty(t, addrs)
 	})
+
+	t.Run("removed objects callback", func(t *testing.T) {
+		e := testNewEngineWithShardNum(t, 1)
+		defer e.Close()
+
+		var removed []oid.Address
+		e.removedCallback = func(addrs []oid.Address) {
+			removed = append(removed, addrs...)
+		}
+
+		err := Put(e, parent)
+		require.NoError(t, err)
+
+		var inhumePrm InhumePrm
+		inhumePrm.WithTarget(tombstoneID, object.AddressOf(parent))
+
+		_, err = e.Inhume(context.Background(), inhumePrm)
+		require.NoError(t, err)
+		require.Equal(t, []oid.Address{object.AddressOf(parent)}, removed)
+
+		removed = nil
+		e.processExpiredLocks(context.Background(), []oid.Address{tombstoneID})
+		require.Equal(t, []oid.Address{tombstoneID}, removed)
+	})
 }
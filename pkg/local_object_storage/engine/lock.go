@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
@@ -43,6 +44,19 @@ func (e *StorageEngine) lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error
 	return nil
 }
 
+// IsLocked checks whether the object is locked in any of the shards.
+func (e *StorageEngine) IsLocked(addr oid.Address) (bool, error) {
+	var locked bool
+
+	err := e.execIfNotBlocked(func() error {
+		var err error
+		locked, err = e.isLocked(addr)
+		return err
+	})
+
+	return locked, err
+}
+
 // Returns:
 //   - 0: fail
 //   - 1: locking irregular object
@@ -69,7 +83,7 @@ func (e *StorageEngine) lockSingle(idCnr cid.ID, locker, locked oid.ID, checkExi
 			var existsPrm shard.ExistsPrm
 			existsPrm.SetAddress(addrLocked)
 
-			exRes, err := sh.Exists(existsPrm)
+			exRes, err := sh.Exists(context.Background(), existsPrm)
 			if err != nil {
 				var siErr *objectSDK.SplitInfoError
 				if !errors.As(err, &siErr) {
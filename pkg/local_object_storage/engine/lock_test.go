@@ -107,11 +107,15 @@ func TestLockUserScenario(t *testing.T) {
 	err = e.Lock(cnr, lockerID, []oid.ID{id})
 	require.NoError(t, err)
 
+	locked, err := e.IsLocked(objAddr)
+	require.NoError(t, err)
+	require.True(t, locked)
+
 	// 3.
 	var inhumePrm InhumePrm
 	inhumePrm.WithTarget(tombAddr, objAddr)
 
-	_, err = e.Inhume(inhumePrm)
+	_, err = e.Inhume(context.Background(), inhumePrm)
 	require.ErrorAs(t, err, new(apistatus.ObjectLocked))
 
 	// 4.
@@ -124,7 +128,7 @@ func TestLockUserScenario(t *testing.T) {
 
 	inhumePrm.WithTarget(tombForLockAddr, lockerAddr)
 
-	_, err = e.Inhume(inhumePrm)
+	_, err = e.Inhume(context.Background(), inhumePrm)
 	require.ErrorIs(t, err, meta.ErrLockObjectRemoval)
 
 	// 5.
@@ -135,7 +139,7 @@ func TestLockUserScenario(t *testing.T) {
 
 	inhumePrm.WithTarget(tombAddr, objAddr)
 
-	_, err = e.Inhume(inhumePrm)
+	_, err = e.Inhume(context.Background(), inhumePrm)
 	require.NoError(t, err)
 }
 
@@ -192,7 +196,7 @@ func TestLockExpiration(t *testing.T) {
 	var inhumePrm InhumePrm
 	inhumePrm.WithTarget(objecttest.Address(), objectcore.AddressOf(obj))
 
-	_, err = e.Inhume(inhumePrm)
+	_, err = e.Inhume(context.Background(), inhumePrm)
 	require.ErrorAs(t, err, new(apistatus.ObjectLocked))
 
 	// 3.
@@ -205,7 +209,7 @@ func TestLockExpiration(t *testing.T) {
 	// 4.
 	inhumePrm.WithTarget(objecttest.Address(), objectcore.AddressOf(obj))
 
-	_, err = e.Inhume(inhumePrm)
+	_, err = e.Inhume(context.Background(), inhumePrm)
 	require.NoError(t, err)
 }
 
@@ -259,12 +263,12 @@ func TestLockForceRemoval(t *testing.T) {
 	var inhumePrm InhumePrm
 	inhumePrm.MarkAsGarbage(objectcore.AddressOf(obj))
 
-	_, err = e.Inhume(inhumePrm)
+	_, err = e.Inhume(context.Background(), inhumePrm)
 	require.ErrorAs(t, err, new(apistatus.ObjectLocked))
 
 	inhumePrm.WithTarget(objecttest.Address(), objectcore.AddressOf(obj))
 
-	_, err = e.Inhume(inhumePrm)
+	_, err = e.Inhume(context.Background(), inhumePrm)
 	require.ErrorAs(t, err, new(apistatus.ObjectLocked))
 
 	// 4.
@@ -272,12 +276,12 @@ func TestLockForceRemoval(t *testing.T) {
 	deletePrm.WithAddress(objectcore.AddressOf(lock))
 	deletePrm.WithForceRemoval()
 
-	_, err = e.Delete(deletePrm)
+	_, err = e.Delete(context.Background(), deletePrm)
 	require.NoError(t, err)
 
 	// 5.
 	inhumePrm.MarkAsGarbage(objectcore.AddressOf(obj))
 
-	_, err = e.Inhume(inhumePrm)
+	_, err = e.Inhume(context.Background(), inhumePrm)
 	require.NoError(t, err)
 }
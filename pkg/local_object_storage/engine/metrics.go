@@ -24,6 +24,25 @@ type MetricRegister interface {
 
 	AddToContainerSize(cnrID string, size int64)
 	AddToPayloadCounter(shardID string, size int64)
+
+	AddShardMethodDuration(shardID, method string, d time.Duration)
+	AddStorageMethodDuration(shardID, storage, method string, d time.Duration)
+
+	SetQuotaLimits(kind, id string, soft, hard uint64)
+	IncQuotaExceeded(kind, limit string)
+
+	IncScrubbedObjects(shardID string)
+	IncCorruptedObjects(shardID string)
+
+	SetWriteCacheFillRatio(shardID string, v float64)
+	SetWriteCacheFlushLag(shardID string, d time.Duration)
+
+	AddIOOperations(shardID, class string, ops int, throttled time.Duration)
+	AddIOBytes(shardID, class string, size uint64)
+
+	AddGCObjects(shardID, kind string, n int)
+	SetGCQueueLength(shardID string, v uint64)
+	SetGCLastRun(shardID string, t time.Time)
 }
 
 func elapsed(addFunc func(d time.Duration)) func() {
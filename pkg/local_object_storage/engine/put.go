@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
@@ -8,8 +9,11 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -38,16 +42,25 @@ func (p *PutPrm) WithObject(obj *objectSDK.Object) {
 // Returns an error if executions are blocked (see BlockExecution).
 //
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the object has been marked as removed.
-func (e *StorageEngine) Put(prm PutPrm) (res PutRes, err error) {
+//
+// Returns an error of type apistatus.ObjectAccessDenied if saving a regular object
+// exceeds the hard quota of its container or container owner (see WithQuotaSource).
+func (e *StorageEngine) Put(ctx context.Context, prm PutPrm) (res PutRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "StorageEngine.Put",
+		trace.WithAttributes(
+			attribute.String("address", object.AddressOf(prm.obj).EncodeToString()),
+		))
+	defer span.End()
+
 	err = e.execIfNotBlocked(func() error {
-		res, err = e.put(prm)
+		res, err = e.put(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
+func (e *StorageEngine) put(ctx context.Context, prm PutPrm) (PutRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddPutDuration)()
 	}
@@ -56,11 +69,19 @@ func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
 
 	// In #1146 this check was parallelized, however, it became
 	// much slower on fast machines for 4 shards.
-	_, err := e.exists(addr)
+	exists, err := e.exists(ctx, addr)
 	if err != nil {
 		return PutRes{}, err
 	}
 
+	onPut := func() {}
+	if !exists && prm.obj.Type() == objectSDK.TypeRegular {
+		onPut, err = e.checkQuota(addr.Container(), prm.obj.PayloadSize())
+		if err != nil {
+			return PutRes{}, err
+		}
+	}
+
 	finished := false
 
 	e.iterateOverSortedShards(addr, func(ind int, sh hashedShard) (stop bool) {
@@ -72,13 +93,15 @@ func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
 			return false
 		}
 
-		putDone, exists := e.putToShard(sh, ind, pool, addr, prm.obj)
+		putDone, exists := e.putToShard(ctx, sh, ind, pool, addr, prm.obj)
 		finished = putDone || exists
 		return finished
 	})
 
 	if !finished {
 		err = errPutShard
+	} else if !exists {
+		onPut()
 	}
 
 	return PutRes{}, err
@@ -87,7 +110,7 @@ func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
 // putToShard puts object to sh.
 // First return value is true iff put has been successfully done.
 // Second return value is true iff object already exists.
-func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object) (bool, bool) {
+func (e *StorageEngine) putToShard(ctx context.Context, sh hashedShard, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object) (bool, bool) {
 	var putSuccess, alreadyExists bool
 
 	exitCh := make(chan struct{})
@@ -98,7 +121,7 @@ func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool
 		var existPrm shard.ExistsPrm
 		existPrm.SetAddress(addr)
 
-		exists, err := sh.Exists(existPrm)
+		exists, err := sh.Exists(ctx, existPrm)
 		if err != nil {
 			if shard.IsErrObjectExpired(err) {
 				// object is already found but
@@ -130,7 +153,7 @@ func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool
 		var putPrm shard.PutPrm
 		putPrm.SetObject(obj)
 
-		_, err = sh.Put(putPrm)
+		_, err = sh.Put(ctx, putPrm)
 		if err != nil {
 			if errors.Is(err, shard.ErrReadOnlyMode) || errors.Is(err, blobstor.ErrNoPlaceFound) ||
 				errors.Is(err, common.ErrReadOnly) || errors.Is(err, common.ErrNoSpace) {
@@ -159,7 +182,7 @@ func Put(storage *StorageEngine, obj *objectSDK.Object) error {
 	var putPrm PutPrm
 	putPrm.WithObject(obj)
 
-	_, err := storage.Put(putPrm)
+	_, err := storage.Put(context.Background(), putPrm)
 
 	return err
 }
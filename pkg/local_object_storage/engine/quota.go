@@ -0,0 +1,324 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	"go.uber.org/zap"
+)
+
+// QuotaLimits groups storage limits in bytes of the payload.
+// Zero value of a limit means no limit.
+type QuotaLimits struct {
+	// Soft is the limit which is allowed to be exceeded, exceeding it
+	// is only logged and reported in metrics.
+	Soft uint64
+	// Hard is the limit which is never exceeded, new objects are rejected.
+	Hard uint64
+}
+
+// IsEmpty returns true if no limit is set.
+func (l QuotaLimits) IsEmpty() bool {
+	return l.Soft == 0 && l.Hard == 0
+}
+
+// ContainerQuota groups the limits applied to the objects of a container.
+type ContainerQuota struct {
+	// Container contains the limits for the container itself.
+	Container QuotaLimits
+	// Owner is the owner of the container.
+	Owner user.ID
+	// OwnerLimits contains the limits for all containers of the owner stored in the engine.
+	OwnerLimits QuotaLimits
+}
+
+// QuotaSource provides storage quotas of the containers.
+type QuotaSource interface {
+	// ContainerQuota returns the quota of the container.
+	ContainerQuota(cid.ID) (ContainerQuota, error)
+}
+
+// QuotaInfo groups the information about a quota and its usage.
+type QuotaInfo struct {
+	// Container is the ID of the container the quota is set for.
+	// It is nil for the owner quotas.
+	Container *cid.ID
+	// Owner is the owner of the container or the owner the quota is set for.
+	Owner user.ID
+	// Limits contains the quota limits.
+	Limits QuotaLimits
+	// Size is the payload size of the stored objects at the moment of the last check.
+	Size uint64
+}
+
+// quotaUsageTTL is the interval the cached size of the containers is valid for.
+// Cached size is increased by the saved objects, so it is refreshed only to
+// take the removed objects into account.
+const quotaUsageTTL = time.Minute
+
+const (
+	quotaKindContainer = "container"
+	quotaKindOwner     = "owner"
+
+	quotaLimitSoft = "soft"
+	quotaLimitHard = "hard"
+)
+
+type containerQuotaState struct {
+	quota   ContainerQuota
+	size    uint64
+	updated time.Time
+}
+
+type ownerQuotaState struct {
+	owner   user.ID
+	limits  QuotaLimits
+	size    uint64
+	updated time.Time
+}
+
+// quotaState contains the latest known usage of the quotas.
+type quotaState struct {
+	mtx        sync.Mutex
+	containers map[cid.ID]*containerQuotaState
+	owners     map[string]*ownerQuotaState
+}
+
+func newQuotaState() *quotaState {
+	return &quotaState{
+		containers: make(map[cid.ID]*containerQuotaState),
+		owners:     make(map[string]*ownerQuotaState),
+	}
+}
+
+// WithQuotaSource returns an option to set the source of the container quotas.
+// Quotas are not checked if the source is not set.
+func WithQuotaSource(s QuotaSource) Option {
+	return func(c *cfg) {
+		c.quotaSource = s
+	}
+}
+
+// quotaExceededError returns an error of type apistatus.ObjectAccessDenied
+// describing the exceeded quota.
+func quotaExceededError(kind string) error {
+	var st apistatus.ObjectAccessDenied
+	st.WriteReason(kind + " quota exceeded")
+	return logicerr.Wrap(st)
+}
+
+// checkQuota checks whether an object with the payload of the specified size
+// can be saved in the container. Returns the function which must be called
+// after the object is saved.
+//
+// Quotas are checked on the best-effort basis: concurrent writes are
+// allowed to exceed the hard limit slightly.
+func (e *StorageEngine) checkQuota(cnr cid.ID, size uint64) (func(), error) {
+	if e.quotaSource == nil {
+		return func() {}, nil
+	}
+
+	q, err := e.quotaSource.ContainerQuota(cnr)
+	if err != nil {
+		e.log.Debug("can't get container quota, skip check",
+			zap.Stringer("cid", cnr),
+			zap.String("error", err.Error()))
+		return func() {}, nil
+	}
+
+	if q.Container.IsEmpty() {
+		e.forgetContainerQuota(cnr)
+		if q.OwnerLimits.IsEmpty() {
+			return func() {}, nil
+		}
+	}
+
+	var cnrSize, ownerSize uint64
+
+	if !q.Container.IsEmpty() {
+		cnrSize = e.containerQuotaUsage(cnr, q)
+
+		err := e.checkQuotaLimits(quotaKindContainer, cnr.EncodeToString(), q.Container, cnrSize, size)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !q.OwnerLimits.IsEmpty() {
+		ownerSize = e.ownerQuotaUsage(q)
+
+		err := e.checkQuotaLimits(quotaKindOwner, q.Owner.EncodeToString(), q.OwnerLimits, ownerSize, size)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return func() {
+		e.quotas.mtx.Lock()
+		defer e.quotas.mtx.Unlock()
+
+		if st, ok := e.quotas.containers[cnr]; ok {
+			st.size += size
+		}
+		if st, ok := e.quotas.owners[q.Owner.EncodeToString()]; ok && !q.OwnerLimits.IsEmpty() {
+			st.size += size
+		}
+	}, nil
+}
+
+func (e *StorageEngine) checkQuotaLimits(kind, id string, l QuotaLimits, used, size uint64) error {
+	if l.Hard != 0 && used+size > l.Hard {
+		e.log.Warn("hard quota exceeded, object is rejected",
+			zap.String("kind", kind),
+			zap.String("id", id),
+			zap.Uint64("limit", l.Hard),
+			zap.Uint64("size", used),
+			zap.Uint64("object size", size))
+
+		if e.metrics != nil {
+			e.metrics.IncQuotaExceeded(kind, quotaLimitHard)
+		}
+		return quotaExceededError(kind)
+	}
+
+	if l.Soft != 0 && used+size > l.Soft {
+		e.log.Warn("soft quota exceeded",
+			zap.String("kind", kind),
+			zap.String("id", id),
+			zap.Uint64("limit", l.Soft),
+			zap.Uint64("size", used),
+			zap.Uint64("object size", size))
+
+		if e.metrics != nil {
+			e.metrics.IncQuotaExceeded(kind, quotaLimitSoft)
+		}
+	}
+
+	return nil
+}
+
+// containerQuotaUsage returns the payload size of the container.
+// The value is cached for quotaUsageTTL, because it requires reading
+// the metabases of all shards.
+func (e *StorageEngine) containerQuotaUsage(cnr cid.ID, q ContainerQuota) uint64 {
+	e.quotas.mtx.Lock()
+	st, ok := e.quotas.containers[cnr]
+	if ok && time.Since(st.updated) < quotaUsageTTL {
+		st.quota = q
+		size := st.size
+		e.quotas.mtx.Unlock()
+		return size
+	}
+	e.quotas.mtx.Unlock()
+
+	res, _ := e.containerSize(ContainerSizePrm{cnr: cnr})
+
+	e.quotas.mtx.Lock()
+	e.quotas.containers[cnr] = &containerQuotaState{
+		quota:   q,
+		size:    res.size,
+		updated: time.Now(),
+	}
+	e.quotas.mtx.Unlock()
+
+	if e.metrics != nil {
+		e.metrics.SetQuotaLimits(quotaKindContainer, cnr.EncodeToString(), q.Container.Soft, q.Container.Hard)
+	}
+
+	return res.size
+}
+
+// ownerQuotaUsage returns the payload size of all containers of the owner.
+// The value is cached for quotaUsageTTL, because it requires obtaining
+// the owners of all stored containers.
+func (e *StorageEngine) ownerQuotaUsage(q ContainerQuota) uint64 {
+	key := q.Owner.EncodeToString()
+
+	e.quotas.mtx.Lock()
+	st, ok := e.quotas.owners[key]
+	if ok && time.Since(st.updated) < quotaUsageTTL {
+		st.limits = q.OwnerLimits
+		size := st.size
+		e.quotas.mtx.Unlock()
+		return size
+	}
+	e.quotas.mtx.Unlock()
+
+	var size uint64
+
+	res, _ := e.listContainers()
+	for _, cnr := range res.containers {
+		cq, err := e.quotaSource.ContainerQuota(cnr)
+		if err != nil || !cq.Owner.Equals(q.Owner) {
+			continue
+		}
+
+		csRes, _ := e.containerSize(ContainerSizePrm{cnr: cnr})
+		size += csRes.size
+	}
+
+	e.quotas.mtx.Lock()
+	e.quotas.owners[key] = &ownerQuotaState{
+		owner:   q.Owner,
+		limits:  q.OwnerLimits,
+		size:    size,
+		updated: time.Now(),
+	}
+	e.quotas.mtx.Unlock()
+
+	if e.metrics != nil {
+		e.metrics.SetQuotaLimits(quotaKindOwner, key, q.OwnerLimits.Soft, q.OwnerLimits.Hard)
+	}
+
+	return size
+}
+
+// forgetContainerQuota removes the container from the list of known quotas
+// after its quota has been removed.
+func (e *StorageEngine) forgetContainerQuota(cnr cid.ID) {
+	e.quotas.mtx.Lock()
+	_, ok := e.quotas.containers[cnr]
+	delete(e.quotas.containers, cnr)
+	e.quotas.mtx.Unlock()
+
+	if ok && e.metrics != nil {
+		e.metrics.SetQuotaLimits(quotaKindContainer, cnr.EncodeToString(), 0, 0)
+	}
+}
+
+// QuotaInfo returns the quotas checked by the engine since the start
+// along with the latest known usage.
+func (e *StorageEngine) QuotaInfo() []QuotaInfo {
+	e.quotas.mtx.Lock()
+	defer e.quotas.mtx.Unlock()
+
+	res := make([]QuotaInfo, 0, len(e.quotas.containers)+len(e.quotas.owners))
+
+	for cnr, st := range e.quotas.containers {
+		if st.quota.Container.IsEmpty() {
+			continue
+		}
+
+		id := cnr
+		res = append(res, QuotaInfo{
+			Container: &id,
+			Owner:     st.quota.Owner,
+			Limits:    st.quota.Container,
+			Size:      st.size,
+		})
+	}
+
+	for _, st := range e.quotas.owners {
+		res = append(res, QuotaInfo{
+			Owner:  st.owner,
+			Limits: st.limits,
+			Size:   st.size,
+		})
+	}
+
+	return res
+}
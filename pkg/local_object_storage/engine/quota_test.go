@@ -0,0 +1,111 @@
+package engine
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	usertest "github.com/TrueCloudLab/frostfs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testQuotaSource map[cid.ID]ContainerQuota
+
+func (s testQuotaSource) ContainerQuota(cnr cid.ID) (ContainerQuota, error) {
+	q, ok := s[cnr]
+	if !ok {
+		return ContainerQuota{}, errors.New("container not found")
+	}
+	return q, nil
+}
+
+func generateQuotaObject(t *testing.T, cnr cid.ID) *object.Object {
+	obj := generateObjectWithCID(t, cnr)
+	obj.SetPayloadSize(5)
+	return obj
+}
+
+func TestQuota(t *testing.T) {
+	e := testEngineFromShardOpts(t, 2, nil)
+	defer func() {
+		require.NoError(t, e.Close())
+		require.NoError(t, os.RemoveAll(t.Name()))
+	}()
+
+	owner := usertest.ID()
+	cnr1, cnr2, cnr3 := cidtest.ID(), cidtest.ID(), cidtest.ID()
+	e.quotaSource = testQuotaSource{
+		cnr1: {
+			Container: QuotaLimits{Soft: 5, Hard: 12},
+			Owner:     *owner,
+		},
+		cnr2: {
+			Owner:       *owner,
+			OwnerLimits: QuotaLimits{Hard: 25},
+		},
+		cnr3: {
+			Owner: *usertest.ID(),
+		},
+	}
+
+	t.Run("container", func(t *testing.T) {
+		require.NoError(t, Put(e, generateQuotaObject(t, cnr1)))
+		// soft limit is exceeded but the object is accepted
+		require.NoError(t, Put(e, generateQuotaObject(t, cnr1)))
+
+		err := Put(e, generateQuotaObject(t, cnr1))
+		require.ErrorAs(t, err, new(apistatus.ObjectAccessDenied))
+	})
+	t.Run("cached usage", func(t *testing.T) {
+		e.quotas.mtx.Lock()
+		e.quotas.containers[cnr1].size = 0
+		e.quotas.mtx.Unlock()
+
+		// cached size is used instead of the stored objects
+		require.NoError(t, Put(e, generateQuotaObject(t, cnr1)))
+
+		e.quotas.mtx.Lock()
+		e.quotas.containers[cnr1].updated = time.Time{}
+		e.quotas.mtx.Unlock()
+
+		err := Put(e, generateQuotaObject(t, cnr1))
+		require.ErrorAs(t, err, new(apistatus.ObjectAccessDenied))
+	})
+	t.Run("owner", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			require.NoError(t, Put(e, generateQuotaObject(t, cnr2)))
+		}
+
+		err := Put(e, generateQuotaObject(t, cnr2))
+		require.ErrorAs(t, err, new(apistatus.ObjectAccessDenied))
+	})
+	t.Run("no quota", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			require.NoError(t, Put(e, generateQuotaObject(t, cnr3)))
+		}
+	})
+	t.Run("unknown container", func(t *testing.T) {
+		require.NoError(t, Put(e, generateQuotaObject(t, cidtest.ID())))
+	})
+	t.Run("info", func(t *testing.T) {
+		info := e.QuotaInfo()
+		require.Len(t, info, 2)
+
+		for _, qi := range info {
+			if qi.Container != nil {
+				require.Equal(t, cnr1, *qi.Container)
+				require.Equal(t, QuotaLimits{Soft: 5, Hard: 12}, qi.Limits)
+				require.Equal(t, uint64(15), qi.Size)
+			} else {
+				require.True(t, owner.Equals(qi.Owner))
+				require.Equal(t, QuotaLimits{Hard: 25}, qi.Limits)
+				require.Equal(t, uint64(25), qi.Size)
+			}
+		}
+	})
+}
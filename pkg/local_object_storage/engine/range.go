@@ -1,14 +1,19 @@
 package engine
 
 import (
+	"context"
 	"errors"
+	"strconv"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -56,16 +61,24 @@ func (r RngRes) Object() *objectSDK.Object {
 // Returns ErrRangeOutOfBounds if the requested object range is out of bounds.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) GetRange(prm RngPrm) (res RngRes, err error) {
+func (e *StorageEngine) GetRange(ctx context.Context, prm RngPrm) (res RngRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "StorageEngine.GetRange",
+		trace.WithAttributes(
+			attribute.String("address", prm.addr.EncodeToString()),
+			attribute.String("offset", strconv.FormatUint(prm.off, 10)),
+			attribute.String("length", strconv.FormatUint(prm.ln, 10)),
+		))
+	defer span.End()
+
 	err = e.execIfNotBlocked(func() error {
-		res, err = e.getRange(prm)
+		res, err = e.getRange(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) getRange(prm RngPrm) (RngRes, error) {
+func (e *StorageEngine) getRange(ctx context.Context, prm RngPrm) (RngRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddRangeDuration)()
 	}
@@ -94,7 +107,7 @@ func (e *StorageEngine) getRange(prm RngPrm) (RngRes, error) {
 		hasDegraded = hasDegraded || noMeta
 		shPrm.SetIgnoreMeta(noMeta)
 
-		res, err := sh.GetRange(shPrm)
+		res, err := sh.GetRange(ctx, shPrm)
 		if err != nil {
 			if res.HasMeta() {
 				shardWithMeta = sh
@@ -158,7 +171,7 @@ func (e *StorageEngine) getRange(prm RngPrm) (RngRes, error) {
 				return false
 			}
 
-			res, err := sh.GetRange(shPrm)
+			res, err := sh.GetRange(ctx, shPrm)
 			if shard.IsErrOutOfRange(err) {
 				var errOutOfRange apistatus.ObjectOutOfRange
 
@@ -189,7 +202,7 @@ func GetRange(storage *StorageEngine, addr oid.Address, rng *objectSDK.Range) ([
 	rangePrm.WithAddress(addr)
 	rangePrm.WithPayloadRange(rng)
 
-	res, err := storage.GetRange(rangePrm)
+	res, err := storage.GetRange(context.Background(), rangePrm)
 	if err != nil {
 		return nil, err
 	}
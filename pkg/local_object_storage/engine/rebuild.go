@@ -0,0 +1,90 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+)
+
+// RebuildPrm groups the parameters of StartRebuild operation.
+type RebuildPrm struct {
+	shardIDs    []*shard.ID
+	fillPercent int
+}
+
+// SetShardIDs sets the list of shards to rebuild.
+//
+// Option is required.
+func (p *RebuildPrm) SetShardIDs(ids []*shard.ID) {
+	p.shardIDs = ids
+}
+
+// SetFillPercent sets the minimal percentage of live data in a blobovnicza.
+// Blobovniczas with less live data are rebuilt. If not set, only blobovniczas
+// which don't match the current configuration are rebuilt.
+func (p *RebuildPrm) SetFillPercent(v int) {
+	p.fillPercent = v
+}
+
+// ShardRebuildState is the rebuild state of a particular shard.
+type ShardRebuildState struct {
+	shard.RebuildState
+
+	id *shard.ID
+}
+
+// ShardID returns the shard identifier.
+func (s ShardRebuildState) ShardID() *shard.ID {
+	return s.id
+}
+
+// StartRebuild starts blobstor rebuild on the specified shards in background.
+// See shard.Shard.StartRebuild for details.
+func (e *StorageEngine) StartRebuild(prm RebuildPrm) error {
+	shards, err := e.getShardsByIDs(prm.shardIDs)
+	if err != nil {
+		return err
+	}
+
+	var shPrm shard.RebuildPrm
+	shPrm.SetFillPercent(prm.fillPercent)
+
+	for i := range shards {
+		if err := shards[i].StartRebuild(shPrm); err != nil {
+			return fmt.Errorf("could not start rebuild on shard %s: %w", shards[i].ID(), err)
+		}
+	}
+	return nil
+}
+
+// RebuildState returns rebuild states of the specified shards.
+func (e *StorageEngine) RebuildState(ids []*shard.ID) ([]ShardRebuildState, error) {
+	shards, err := e.getShardsByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]ShardRebuildState, 0, len(shards))
+	for i := range shards {
+		res = append(res, ShardRebuildState{
+			RebuildState: shards[i].RebuildState(),
+			id:           shards[i].ID(),
+		})
+	}
+	return res, nil
+}
+
+func (e *StorageEngine) getShardsByIDs(ids []*shard.ID) ([]*shard.Shard, error) {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	shards := make([]*shard.Shard, 0, len(ids))
+	for i := range ids {
+		sh, ok := e.shards[ids[i].String()]
+		if !ok {
+			return nil, errShardNotFound
+		}
+		shards = append(shards, sh.Shard)
+	}
+	return shards, nil
+}
@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+)
+
+// ScrubPrm groups the parameters of StartScrub operation.
+type ScrubPrm struct {
+	shardIDs  []*shard.ID
+	rateLimit uint32
+}
+
+// SetShardIDs sets the list of shards to check.
+//
+// Option is required.
+func (p *ScrubPrm) SetShardIDs(ids []*shard.ID) {
+	p.shardIDs = ids
+}
+
+// SetRateLimit sets the maximum number of objects checked per second
+// on each shard. Zero means no limit.
+func (p *ScrubPrm) SetRateLimit(v uint32) {
+	p.rateLimit = v
+}
+
+// ShardScrubState is the scrub state of a particular shard.
+type ShardScrubState struct {
+	shard.ScrubState
+
+	id *shard.ID
+}
+
+// ShardID returns the shard identifier.
+func (s ShardScrubState) ShardID() *shard.ID {
+	return s.id
+}
+
+// StartScrub starts payload integrity check on the specified shards in background.
+// See shard.Shard.StartScrub for details.
+func (e *StorageEngine) StartScrub(prm ScrubPrm) error {
+	shards, err := e.getShardsByIDs(prm.shardIDs)
+	if err != nil {
+		return err
+	}
+
+	var shPrm shard.ScrubPrm
+	shPrm.SetRateLimit(prm.rateLimit)
+
+	for i := range shards {
+		if err := shards[i].StartScrub(shPrm); err != nil {
+			return fmt.Errorf("could not start scrub on shard %s: %w", shards[i].ID(), err)
+		}
+	}
+	return nil
+}
+
+// StopScrub stops payload integrity check on the specified shards.
+func (e *StorageEngine) StopScrub(ids []*shard.ID) error {
+	shards, err := e.getShardsByIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range shards {
+		shards[i].StopScrub()
+	}
+	return nil
+}
+
+// ScrubState returns scrub states of the specified shards.
+func (e *StorageEngine) ScrubState(ids []*shard.ID) ([]ShardScrubState, error) {
+	shards, err := e.getShardsByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]ShardScrubState, 0, len(shards))
+	for i := range shards {
+		res = append(res, ShardScrubState{
+			ScrubState: shards[i].ScrubState(),
+			id:         shards[i].ID(),
+		})
+	}
+	return res, nil
+}
@@ -1,16 +1,28 @@
 package engine
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // SelectPrm groups the parameters of Select operation.
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+
+	limit      int
+	startAfter *oid.ID
 }
 
 // SelectRes groups the resulting values of Select operation.
@@ -28,6 +40,19 @@ func (p *SelectPrm) WithFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// WithLimit is a Select option to set the maximum number of the selected objects.
+// If the limit is set, the objects are selected in the ascending order of their
+// IDs, so the next page can be selected with WithStartAfter. Zero means no limit.
+func (p *SelectPrm) WithLimit(limit int) {
+	p.limit = limit
+}
+
+// WithStartAfter is a Select option to select the objects with IDs greater
+// than id. It is applied only if the limit is set.
+func (p *SelectPrm) WithStartAfter(id oid.ID) {
+	p.startAfter = &id
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
@@ -38,16 +63,22 @@ func (r SelectRes) AddressList() []oid.Address {
 // Returns any error encountered that did not allow to completely select the objects.
 //
 // Returns an error if executions are blocked (see BlockExecution).
-func (e *StorageEngine) Select(prm SelectPrm) (res SelectRes, err error) {
+func (e *StorageEngine) Select(ctx context.Context, prm SelectPrm) (res SelectRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "StorageEngine.Select",
+		trace.WithAttributes(
+			attribute.String("container_id", prm.cnr.EncodeToString()),
+		))
+	defer span.End()
+
 	err = e.execIfNotBlocked(func() error {
-		res, err = e._select(prm)
+		res, err = e._select(ctx, prm)
 		return err
 	})
 
 	return
 }
 
-func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
+func (e *StorageEngine) _select(ctx context.Context, prm SelectPrm) (SelectRes, error) {
 	if e.metrics != nil {
 		defer elapsed(e.metrics.AddSearchDuration)()
 	}
@@ -60,9 +91,13 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 	var shPrm shard.SelectPrm
 	shPrm.SetContainerID(prm.cnr)
 	shPrm.SetFilters(prm.filters)
+	shPrm.SetLimit(prm.limit)
+	if prm.startAfter != nil {
+		shPrm.SetStartAfter(*prm.startAfter)
+	}
 
 	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
-		res, err := sh.Select(shPrm)
+		res, err := sh.Select(ctx, shPrm)
 		if err != nil {
 			e.reportShardError(sh, "could not select objects from shard", err)
 			return false
@@ -78,11 +113,168 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 		return false
 	})
 
+	if prm.limit > 0 {
+		// every shard returns the first objects in the order of IDs,
+		// the first objects of the merged list are the requested ones
+		sort.Slice(addrList, func(i, j int) bool {
+			a, b := addrList[i].Object(), addrList[j].Object()
+			return bytes.Compare(a[:], b[:]) < 0
+		})
+		if len(addrList) > prm.limit {
+			addrList = addrList[:prm.limit]
+		}
+	}
+
 	return SelectRes{
 		addrList: addrList,
 	}, outError
 }
 
+// SelectIndexedPrm groups the parameters of SelectIndexed operation.
+type SelectIndexedPrm struct {
+	shPrm shard.SelectIndexedPrm
+
+	limit      int
+	descending bool
+}
+
+// SelectIndexedRes groups the resulting values of SelectIndexed operation.
+type SelectIndexedRes struct {
+	objects []meta.IndexedObject
+}
+
+// WithContainerID is a SelectIndexed option to set the container id to search in.
+func (p *SelectIndexedPrm) WithContainerID(cnr cid.ID) {
+	p.shPrm.SetContainerID(cnr)
+}
+
+// WithAttribute is a SelectIndexed option to set the indexed attribute
+// the objects are selected and ordered by.
+func (p *SelectIndexedPrm) WithAttribute(attr string) {
+	p.shPrm.SetAttribute(attr)
+}
+
+// WithLowerBound is a SelectIndexed option to select the objects with the
+// attribute value greater than (or equal to if inclusive is set) v.
+func (p *SelectIndexedPrm) WithLowerBound(v string, inclusive bool) {
+	p.shPrm.SetLowerBound(v, inclusive)
+}
+
+// WithUpperBound is a SelectIndexed option to select the objects with the
+// attribute value less than (or equal to if inclusive is set) v.
+func (p *SelectIndexedPrm) WithUpperBound(v string, inclusive bool) {
+	p.shPrm.SetUpperBound(v, inclusive)
+}
+
+// WithPrefix is a SelectIndexed option to select the objects with the
+// attribute value starting with the prefix.
+func (p *SelectIndexedPrm) WithPrefix(prefix string) {
+	p.shPrm.SetPrefix(prefix)
+}
+
+// WithFilters is a SelectIndexed option to set additional object filters.
+func (p *SelectIndexedPrm) WithFilters(fs object.SearchFilters) {
+	p.shPrm.SetFilters(fs)
+}
+
+// WithLimit is a SelectIndexed option to set the maximum number of the
+// selected objects. Zero means no limit.
+func (p *SelectIndexedPrm) WithLimit(limit int) {
+	p.limit = limit
+	p.shPrm.SetLimit(limit)
+}
+
+// WithDescending is a SelectIndexed option to select the objects
+// in the descending order of the attribute values.
+func (p *SelectIndexedPrm) WithDescending(descending bool) {
+	p.descending = descending
+	p.shPrm.SetDescending(descending)
+}
+
+// WithStartAfter is a SelectIndexed option to select the objects with IDs
+// greater than id. It is allowed for the selection of a single attribute value only.
+func (p *SelectIndexedPrm) WithStartAfter(id oid.ID) {
+	p.shPrm.SetStartAfter(id)
+}
+
+// Objects returns the selected objects in the requested order.
+func (r SelectIndexedRes) Objects() []meta.IndexedObject {
+	return r.objects
+}
+
+// SelectIndexed selects objects from local storage using the sorted attribute index.
+//
+// Returns meta.ErrAttributeNotIndexed if any shard has no index on the attribute
+// and meta.ErrInvalidIndexQuery if the query doesn't match the index type,
+// Select must be used in these cases.
+//
+// Returns an error if executions are blocked (see BlockExecution).
+func (e *StorageEngine) SelectIndexed(ctx context.Context, prm SelectIndexedPrm) (res SelectIndexedRes, err error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "StorageEngine.SelectIndexed")
+	defer span.End()
+
+	err = e.execIfNotBlocked(func() error {
+		res, err = e.selectIndexed(ctx, prm)
+		return err
+	})
+
+	return
+}
+
+func (e *StorageEngine) selectIndexed(ctx context.Context, prm SelectIndexedPrm) (SelectIndexedRes, error) {
+	if e.metrics != nil {
+		defer elapsed(e.metrics.AddSearchDuration)()
+	}
+
+	var (
+		objects  []meta.IndexedObject
+		outError error
+	)
+
+	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
+		res, err := sh.SelectIndexed(ctx, prm.shPrm)
+		if err != nil {
+			if errors.Is(err, meta.ErrAttributeNotIndexed) || errors.Is(err, meta.ErrInvalidIndexQuery) {
+				outError = err
+				return true
+			}
+
+			e.reportShardError(sh, "could not select objects from shard", err)
+			return false
+		}
+
+		objects = append(objects, res.Objects()...)
+		return false
+	})
+	if outError != nil {
+		return SelectIndexedRes{}, outError
+	}
+
+	// every shard returns sorted objects, merge them
+	sort.Slice(objects, func(i, j int) bool {
+		if prm.descending {
+			return objects[i].Compare(objects[j]) > 0
+		}
+		return objects[i].Compare(objects[j]) < 0
+	})
+
+	res := objects[:0]
+	for i := range objects { // save only unique values
+		if len(res) != 0 && res[len(res)-1].Compare(objects[i]) == 0 {
+			continue
+		}
+		res = append(res, objects[i])
+
+		if prm.limit > 0 && len(res) >= prm.limit {
+			break
+		}
+	}
+
+	return SelectIndexedRes{
+		objects: res,
+	}, nil
+}
+
 // List returns `limit` available physically storage object addresses in engine.
 // If limit is zero, then returns all available object addresses.
 //
@@ -138,7 +330,7 @@ func Select(storage *StorageEngine, cnr cid.ID, fs object.SearchFilters) ([]oid.
 	selectPrm.WithContainerID(cnr)
 	selectPrm.WithFilters(fs)
 
-	res, err := storage.Select(selectPrm)
+	res, err := storage.Select(context.Background(), selectPrm)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,9 @@
 package engine
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
@@ -16,6 +18,8 @@ import (
 
 var errShardNotFound = logicerr.New("shard not found")
 
+var errDetachAllShards = logicerr.New("could not detach all shards")
+
 type hashedShard struct {
 	shardWrapper
 	hash uint64
@@ -60,6 +64,50 @@ func (m *metricsWithID) AddToPayloadSize(size int64) {
 	m.mw.AddToPayloadCounter(m.id, size)
 }
 
+func (m *metricsWithID) AddMethodDuration(method string, d time.Duration) {
+	m.mw.AddShardMethodDuration(m.id, method, d)
+}
+
+func (m *metricsWithID) AddStorageMethodDuration(storage, method string, d time.Duration) {
+	m.mw.AddStorageMethodDuration(m.id, storage, method, d)
+}
+
+func (m *metricsWithID) IncScrubbedObjects() {
+	m.mw.IncScrubbedObjects(m.id)
+}
+
+func (m *metricsWithID) IncCorruptedObjects() {
+	m.mw.IncCorruptedObjects(m.id)
+}
+
+func (m *metricsWithID) SetWriteCacheFillRatio(v float64) {
+	m.mw.SetWriteCacheFillRatio(m.id, v)
+}
+
+func (m *metricsWithID) SetWriteCacheFlushLag(d time.Duration) {
+	m.mw.SetWriteCacheFlushLag(m.id, d)
+}
+
+func (m *metricsWithID) AddIOOperations(class string, ops int, throttled time.Duration) {
+	m.mw.AddIOOperations(m.id, class, ops, throttled)
+}
+
+func (m *metricsWithID) AddIOBytes(class string, size uint64) {
+	m.mw.AddIOBytes(m.id, class, size)
+}
+
+func (m *metricsWithID) AddGCObjects(kind string, n int) {
+	m.mw.AddGCObjects(m.id, kind, n)
+}
+
+func (m *metricsWithID) SetGCQueueLength(v uint64) {
+	m.mw.SetGCQueueLength(m.id, v)
+}
+
+func (m *metricsWithID) SetGCLastRun(t time.Time) {
+	m.mw.SetGCLastRun(m.id, t)
+}
+
 // AddShard adds a new shard to the storage engine.
 //
 // Returns any error encountered that did not allow adding a shard.
@@ -82,6 +130,52 @@ func (e *StorageEngine) AddShard(opts ...shard.Option) (*shard.ID, error) {
 	return sh.ID(), nil
 }
 
+// AttachShard creates a new shard, opens and initializes it and adds it
+// to the running storage engine.
+//
+// Returns any error encountered that did not allow attaching a shard.
+// Otherwise returns the ID of the attached shard.
+func (e *StorageEngine) AttachShard(opts ...shard.Option) (*shard.ID, error) {
+	sh, err := e.openShard(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.cfg.metrics != nil {
+		e.cfg.metrics.SetReadonly(sh.ID().String(), sh.GetMode() != mode.ReadWrite)
+	}
+
+	e.log.Info("added new shard", zap.Stringer("id", sh.ID()))
+
+	return sh.ID(), nil
+}
+
+func (e *StorageEngine) openShard(opts []shard.Option) (*shard.Shard, error) {
+	sh, err := e.createShard(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create a shard: %w", err)
+	}
+
+	idStr := sh.ID().String()
+
+	err = sh.Open()
+	if err == nil {
+		err = sh.Init()
+	}
+	if err != nil {
+		_ = sh.Close()
+		return nil, fmt.Errorf("could not init %s shard: %w", idStr, err)
+	}
+
+	err = e.addShard(sh)
+	if err != nil {
+		_ = sh.Close()
+		return nil, fmt.Errorf("could not add %s shard: %w", idStr, err)
+	}
+
+	return sh, nil
+}
+
 func (e *StorageEngine) createShard(opts []shard.Option) (*shard.Shard, error) {
 	id, err := generateShardID()
 	if err != nil {
@@ -104,6 +198,7 @@ func (e *StorageEngine) createShard(opts []shard.Option) (*shard.Shard, error) {
 	sh := shard.New(append(opts,
 		shard.WithID(id),
 		shard.WithExpiredTombstonesCallback(e.processExpiredTombstones),
+		shard.WithExpiredObjectsCallback(e.processExpiredObjects),
 		shard.WithExpiredLocksCallback(e.processExpiredLocks),
 		shard.WithDeletedLockCallback(e.processDeletedLocks),
 		shard.WithReportErrorFunc(e.reportShardErrorBackground),
@@ -184,6 +279,43 @@ func (e *StorageEngine) removeShards(ids ...string) {
 	}
 }
 
+// DetachShards closes the shards with the specified IDs and removes them
+// from the running storage engine.
+//
+// Returns an error if any of the shards is not found or if no shards
+// would be left in the engine.
+func (e *StorageEngine) DetachShards(ids []*shard.ID) error {
+	if len(ids) == 0 {
+		return errors.New("no shards to detach")
+	}
+
+	strIDs := make([]string, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+
+	e.mtx.RLock()
+	for _, id := range ids {
+		strID := id.String()
+		if _, ok := e.shards[strID]; !ok {
+			e.mtx.RUnlock()
+			return fmt.Errorf("%w: %s", errShardNotFound, strID)
+		}
+		if _, ok := seen[strID]; !ok {
+			seen[strID] = struct{}{}
+			strIDs = append(strIDs, strID)
+		}
+	}
+	left := len(e.shards) - len(strIDs)
+	e.mtx.RUnlock()
+
+	if left == 0 {
+		return errDetachAllShards
+	}
+
+	e.removeShards(strIDs...)
+
+	return nil
+}
+
 func generateShardID() (*shard.ID, error) {
 	uid, err := uuid.NewRandom()
 	if err != nil {
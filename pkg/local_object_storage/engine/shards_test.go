@@ -2,8 +2,13 @@ package engine
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,3 +49,37 @@ func TestRemoveShard(t *testing.T) {
 		require.True(t, ok != removed)
 	}
 }
+
+func TestAttachDetachShards(t *testing.T) {
+	const shardNum = 2
+
+	path := t.TempDir()
+	e, _ := engineWithShards(t, path, shardNum)
+	t.Cleanup(func() { _ = e.Close() })
+
+	id, err := e.AttachShard(
+		shard.WithBlobStorOptions(
+			blobstor.WithStorages(newStorages(filepath.Join(path, "new"), errSmallSize))),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(path, "new.metabase")),
+			meta.WithPermissions(0700),
+			meta.WithEpochState(epochState{}),
+		),
+	)
+	require.NoError(t, err)
+	require.Equal(t, shardNum+1, len(e.shards))
+	require.Equal(t, shardNum+1, len(e.shardPools))
+	require.Equal(t, mode.ReadWrite, e.shards[id.String()].GetMode())
+
+	require.ErrorIs(t, e.DetachShards([]*shard.ID{shard.NewIDFromBytes([]byte("unknown"))}), errShardNotFound)
+	require.NoError(t, e.DetachShards([]*shard.ID{id, id}))
+	require.Equal(t, shardNum, len(e.shards))
+	require.Equal(t, shardNum, len(e.shardPools))
+
+	ids := make([]*shard.ID, 0, shardNum)
+	for _, sh := range e.DumpInfo().Shards {
+		ids = append(ids, sh.ID)
+	}
+	require.ErrorIs(t, e.DetachShards(ids), errDetachAllShards)
+	require.Equal(t, shardNum, len(e.shards))
+}
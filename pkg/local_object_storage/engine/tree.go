@@ -136,6 +136,29 @@ func (e *StorageEngine) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID pil
 	return nil, err
 }
 
+// TreeListChildren implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeListChildren(cid cidSDK.ID, treeID string, nodeID pilorama.Node, prm pilorama.ListChildrenPrm) ([]pilorama.Node, []byte, error) {
+	var err error
+	var nodes []pilorama.Node
+	var next []byte
+	for _, sh := range e.sortShardsByWeight(cid) {
+		nodes, next, err = sh.TreeListChildren(cid, treeID, nodeID, prm)
+		if err != nil {
+			if err == shard.ErrPiloramaDisabled {
+				break
+			}
+			if !errors.Is(err, pilorama.ErrTreeNotFound) {
+				e.reportShardError(sh, "can't perform `TreeListChildren`", err,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			continue
+		}
+		return nodes, next, nil
+	}
+	return nil, nil, err
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (e *StorageEngine) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (pilorama.Move, error) {
 	var err error
@@ -143,7 +166,7 @@ func (e *StorageEngine) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64
 	for _, sh := range e.sortShardsByWeight(cid) {
 		lm, err = sh.TreeGetOpLog(cid, treeID, height)
 		if err != nil {
-			if err == shard.ErrPiloramaDisabled {
+			if err == shard.ErrPiloramaDisabled || errors.Is(err, pilorama.ErrLogCompacted) {
 				break
 			}
 			if !errors.Is(err, pilorama.ErrTreeNotFound) {
@@ -204,6 +227,129 @@ func (e *StorageEngine) TreeList(cid cidSDK.ID) ([]string, error) {
 	return resIDs, nil
 }
 
+// TreeCompactLog implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeCompactLog(cid cidSDK.ID, treeID string, height uint64) error {
+	var err error
+	for _, sh := range e.sortShardsByWeight(cid) {
+		err = sh.TreeCompactLog(cid, treeID, height)
+		if err != nil {
+			if err == shard.ErrPiloramaDisabled {
+				break
+			}
+			if !errors.Is(err, pilorama.ErrTreeNotFound) && !errors.Is(err, shard.ErrReadOnlyMode) {
+				e.reportShardError(sh, "can't perform `TreeCompactLog`", err,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			continue
+		}
+		return nil
+	}
+	return err
+}
+
+// TreeLogHorizon implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeLogHorizon(cid cidSDK.ID, treeID string) (uint64, error) {
+	var err error
+	var height uint64
+	for _, sh := range e.sortShardsByWeight(cid) {
+		height, err = sh.TreeLogHorizon(cid, treeID)
+		if err != nil {
+			if err == shard.ErrPiloramaDisabled || errors.Is(err, pilorama.ErrTreeRestoring) {
+				break
+			}
+			if !errors.Is(err, pilorama.ErrTreeNotFound) {
+				e.reportShardError(sh, "can't perform `TreeLogHorizon`", err,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			continue
+		}
+		return height, nil
+	}
+	return height, err
+}
+
+// TreeLastSyncHeight implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeLastSyncHeight(cid cidSDK.ID, treeID string) (uint64, error) {
+	var err error
+	var height uint64
+	for _, sh := range e.sortShardsByWeight(cid) {
+		height, err = sh.TreeLastSyncHeight(cid, treeID)
+		if err != nil {
+			if err == shard.ErrPiloramaDisabled {
+				break
+			}
+			if !errors.Is(err, pilorama.ErrTreeNotFound) {
+				e.reportShardError(sh, "can't perform `TreeLastSyncHeight`", err,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			continue
+		}
+		return height, nil
+	}
+	return height, err
+}
+
+// TreeUpdateLastSyncHeight implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeUpdateLastSyncHeight(cid cidSDK.ID, treeID string, height uint64) error {
+	index, lst, err := e.getTreeShard(cid, treeID)
+	if err != nil {
+		return err
+	}
+
+	err = lst[index].TreeUpdateLastSyncHeight(cid, treeID, height)
+	if err != nil && !errors.Is(err, shard.ErrReadOnlyMode) && err != shard.ErrPiloramaDisabled {
+		e.reportShardError(lst[index], "can't perform `TreeUpdateLastSyncHeight`", err,
+			zap.Stringer("cid", cid),
+			zap.String("tree", treeID))
+	}
+	return err
+}
+
+// TreeSnapshot implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeSnapshot(cid cidSDK.ID, treeID string, f func(uint64, []pilorama.SnapshotNode) error) error {
+	var err error
+	for _, sh := range e.sortShardsByWeight(cid) {
+		err = sh.TreeSnapshot(cid, treeID, f)
+		if err != nil {
+			if errors.Is(err, pilorama.ErrTreeNotFound) {
+				continue
+			}
+			// f could have already been called, the snapshot can't be continued
+			// from another shard.
+			if err != shard.ErrPiloramaDisabled {
+				e.reportShardError(sh, "can't perform `TreeSnapshot`", err,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			break
+		}
+		return nil
+	}
+	return err
+}
+
+// TreeRestore implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeRestore(d pilorama.CIDDescriptor, treeID string, height uint64, next func() ([]pilorama.SnapshotNode, error)) error {
+	index, lst, err := e.getTreeShard(d.CID, treeID)
+	if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+		return err
+	}
+
+	err = lst[index].TreeRestore(d, treeID, height, next)
+	if err != nil {
+		if !errors.Is(err, shard.ErrReadOnlyMode) && err != shard.ErrPiloramaDisabled {
+			e.reportShardError(lst[index], "can't perform `TreeRestore`", err,
+				zap.Stringer("cid", d.CID),
+				zap.String("tree", treeID))
+		}
+		return err
+	}
+	return nil
+}
+
 // TreeExists implements the pilorama.Forest interface.
 func (e *StorageEngine) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
 	_, _, err := e.getTreeShard(cid, treeID)
@@ -8,6 +8,7 @@ import (
 type FlushWriteCachePrm struct {
 	shardID      *shard.ID
 	ignoreErrors bool
+	disable      bool
 }
 
 // SetShardID is an option to set shard ID.
@@ -22,6 +23,12 @@ func (p *FlushWriteCachePrm) SetIgnoreErrors(ignore bool) {
 	p.ignoreErrors = ignore
 }
 
+// SetDisable sets the flag to disable write-cache after the flush
+// until the shard mode is set to read-write again.
+func (p *FlushWriteCachePrm) SetDisable(disable bool) {
+	p.disable = disable
+}
+
 // FlushWriteCacheRes groups the resulting values of FlushWriteCache operation.
 type FlushWriteCacheRes struct{}
 
@@ -37,6 +44,7 @@ func (e *StorageEngine) FlushWriteCache(p FlushWriteCachePrm) (FlushWriteCacheRe
 
 	var prm shard.FlushWriteCachePrm
 	prm.SetIgnoreErrors(p.ignoreErrors)
+	prm.SetDisable(p.disable)
 
 	return FlushWriteCacheRes{}, sh.FlushWriteCache(prm)
 }
@@ -0,0 +1,362 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	"go.etcd.io/bbolt"
+)
+
+// SnapshotPrm groups the parameters of Snapshot operation.
+type SnapshotPrm struct {
+	since uint64
+}
+
+// SetSince sets the checkpoint of the previous snapshot. Only the objects
+// put after the checkpoint are returned. Zero means all the objects.
+func (p *SnapshotPrm) SetSince(checkpoint uint64) {
+	p.since = checkpoint
+}
+
+// SnapshotObject describes a physically stored object.
+type SnapshotObject struct {
+	addr      oid.Address
+	storageID []byte
+}
+
+// Address returns the object address.
+func (o SnapshotObject) Address() oid.Address {
+	return o.addr
+}
+
+// StorageID returns the ID of the storage the object is stored in,
+// nil if it is unknown.
+func (o SnapshotObject) StorageID() []byte {
+	return o.storageID
+}
+
+// LockedObject describes an object locked by a LOCK object.
+type LockedObject struct {
+	addr   oid.Address
+	locker oid.ID
+}
+
+// Address returns the locked object address.
+func (o LockedObject) Address() oid.Address {
+	return o.addr
+}
+
+// Locker returns the ID of the LOCK object from the same container.
+func (o LockedObject) Locker() oid.ID {
+	return o.locker
+}
+
+// SnapshotRes groups the resulting values of Snapshot operation.
+type SnapshotRes struct {
+	checkpoint uint64
+	objects    []SnapshotObject
+	graveyard  []TombstonedObject
+	garbage    []oid.Address
+	locked     []LockedObject
+}
+
+// Checkpoint returns the checkpoint of the snapshot which can be used
+// to get the objects put after the snapshot.
+func (r SnapshotRes) Checkpoint() uint64 {
+	return r.checkpoint
+}
+
+// Objects returns the physically stored objects put after the requested
+// checkpoint in the order of their puts.
+func (r SnapshotRes) Objects() []SnapshotObject {
+	return r.objects
+}
+
+// Graveyard returns all the objects covered with tombstones.
+func (r SnapshotRes) Graveyard() []TombstonedObject {
+	return r.graveyard
+}
+
+// Garbage returns all the objects marked as garbage
+// which are not covered with tombstones.
+func (r SnapshotRes) Garbage() []oid.Address {
+	return r.garbage
+}
+
+// Locked returns all the locked objects.
+func (r SnapshotRes) Locked() []LockedObject {
+	return r.locked
+}
+
+// Snapshot returns a consistent view of the stored objects and their
+// metadata. Objects are selected by the checkpoint of the previous
+// snapshot, while the graveyard, garbage and locks are always returned
+// completely.
+func (db *DB) Snapshot(prm SnapshotPrm) (SnapshotRes, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return SnapshotRes{}, ErrDegradedMode
+	}
+
+	var res SnapshotRes
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		var err error
+
+		res.checkpoint, res.objects, err = snapshotObjects(tx, prm.since)
+		if err != nil {
+			return err
+		}
+
+		res.graveyard, res.garbage, err = snapshotGraveyard(tx)
+		if err != nil {
+			return err
+		}
+
+		res.locked, err = snapshotLocked(tx)
+		return err
+	})
+	return res, err
+}
+
+func snapshotObjects(tx *bbolt.Tx, since uint64) (uint64, []SnapshotObject, error) {
+	b := tx.Bucket(changesBucketName)
+	if b == nil {
+		return 0, nil, nil
+	}
+
+	type change struct {
+		seq uint64
+		obj SnapshotObject
+	}
+
+	var (
+		changes []change
+		key     = make([]byte, bucketKeySize)
+	)
+	err := b.ForEach(func(k, v []byte) error {
+		if len(v) != 8 {
+			return fmt.Errorf("invalid sequence number length: %d", len(v))
+		}
+
+		seq := binary.BigEndian.Uint64(v)
+		if seq <= since {
+			return nil
+		}
+
+		var c change
+		c.seq = seq
+		if err := decodeAddressFromKey(&c.obj.addr, k); err != nil {
+			return fmt.Errorf("invalid object address: %w", err)
+		}
+
+		small := tx.Bucket(smallBucketName(c.obj.addr.Container(), key))
+		if small != nil {
+			if id := small.Get(objectKey(c.obj.addr.Object(), make([]byte, objectKeySize))); id != nil {
+				c.obj.storageID = slice.Copy(id)
+			}
+		}
+
+		changes = append(changes, c)
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].seq < changes[j].seq })
+
+	objs := make([]SnapshotObject, len(changes))
+	for i := range changes {
+		objs[i] = changes[i].obj
+	}
+	return b.Sequence(), objs, nil
+}
+
+func snapshotGraveyard(tx *bbolt.Tx) ([]TombstonedObject, []oid.Address, error) {
+	var graves []TombstonedObject
+
+	graveyard := tx.Bucket(graveyardBucketName)
+	if graveyard != nil {
+		err := graveyard.ForEach(func(k, v []byte) error {
+			g, err := graveFromKV(k, v)
+			if err != nil {
+				return err
+			}
+			graves = append(graves, g)
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var garbage []oid.Address
+
+	garbageBkt := tx.Bucket(garbageBucketName)
+	if garbageBkt != nil {
+		err := garbageBkt.ForEach(func(k, _ []byte) error {
+			if graveyard != nil && graveyard.Get(k) != nil {
+				return nil
+			}
+
+			g, err := garbageFromKV(k)
+			if err != nil {
+				return err
+			}
+			garbage = append(garbage, g.Address())
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return graves, garbage, nil
+}
+
+func snapshotLocked(tx *bbolt.Tx) ([]LockedObject, error) {
+	b := tx.Bucket(bucketNameLocked)
+	if b == nil {
+		return nil, nil
+	}
+
+	var res []LockedObject
+	err := b.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return nil // not a container bucket
+		}
+
+		var cnr cid.ID
+		if err := cnr.Decode(k); err != nil {
+			return fmt.Errorf("invalid container ID: %w", err)
+		}
+
+		return b.Bucket(k).ForEach(func(k, v []byte) error {
+			var lo LockedObject
+			lo.addr.SetContainer(cnr)
+
+			var obj oid.ID
+			if err := obj.Decode(k); err != nil {
+				return fmt.Errorf("invalid locked object ID: %w", err)
+			}
+			lo.addr.SetObject(obj)
+
+			lockers, err := decodeList(v)
+			if err != nil {
+				return fmt.Errorf("decode list of object lockers: %w", err)
+			}
+
+			for i := range lockers {
+				if err := lo.locker.Decode(lockers[i]); err != nil {
+					return fmt.Errorf("invalid locker ID: %w", err)
+				}
+				res = append(res, lo)
+			}
+			return nil
+		})
+	})
+	return res, err
+}
+
+// putChange assigns the next sequence number to the physically stored object.
+func putChange(tx *bbolt.Tx, addr oid.Address) error {
+	b, err := tx.CreateBucketIfNotExists(changesBucketName)
+	if err != nil {
+		return err
+	}
+
+	return putChangeWithKey(b, addressKey(addr, make([]byte, addressKeySize)))
+}
+
+func putChangeWithKey(b *bbolt.Bucket, addrKey []byte) error {
+	seq, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	val := make([]byte, 8)
+	binary.BigEndian.PutUint64(val, seq)
+	return b.Put(addrKey, val)
+}
+
+// fillChangesBatchSize is the number of objects processed in a single
+// transaction during the change tracking migration.
+const fillChangesBatchSize = 10000
+
+// fillChanges assigns sequence numbers to the objects stored before the
+// change tracking was introduced. Objects which already have the numbers
+// are skipped, so the migration can be safely repeated.
+func fillChanges(db *bbolt.DB, p *migrationProgress) error {
+	var lastBucket, lastKey []byte
+
+	for {
+		var n uint64
+		err := db.Update(func(tx *bbolt.Tx) error {
+			changes, err := tx.CreateBucketIfNotExists(changesBucketName)
+			if err != nil {
+				return err
+			}
+
+			c := tx.Cursor()
+			name, _ := c.First()
+			if lastBucket != nil {
+				name, _ = c.Seek(lastBucket)
+			}
+
+			var cnr cid.ID
+			for ; name != nil; name, _ = c.Next() {
+				cidRaw, prefix := parseContainerIDWithPrefix(&cnr, name)
+				if cidRaw == nil {
+					continue
+				}
+
+				switch prefix {
+				case primaryPrefix, tombstonePrefix, storageGroupPrefix, lockersPrefix:
+				default:
+					continue
+				}
+
+				bc := tx.Bucket(name).Cursor()
+				k, _ := bc.First()
+				if lastKey != nil && bytes.Equal(name, lastBucket) {
+					k, _ = bc.Seek(lastKey)
+				}
+
+				for ; k != nil; k, _ = bc.Next() {
+					if n == fillChangesBatchSize {
+						lastBucket = slice.Copy(name)
+						lastKey = slice.Copy(k)
+						return nil
+					}
+
+					addrKey := append(slice.Copy(cidRaw), k...)
+					if changes.Get(addrKey) == nil {
+						if err := putChangeWithKey(changes, addrKey); err != nil {
+							return err
+						}
+					}
+					n++
+				}
+			}
+
+			lastBucket = nil
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		p.add(n)
+
+		if lastBucket == nil {
+			return nil
+		}
+	}
+}
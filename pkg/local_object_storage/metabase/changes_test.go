@@ -0,0 +1,110 @@
+package meta_test
+
+import (
+	"testing"
+
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_Snapshot(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	var addrs []oid.Address
+	put := func(n int) {
+		for i := 0; i < n; i++ {
+			obj := generateObjectWithCID(t, cnr)
+
+			var prm meta.PutPrm
+			prm.SetObject(obj)
+			if i%2 == 0 {
+				prm.SetStorageID([]byte{byte(i)})
+			}
+
+			_, err := db.Put(prm)
+			require.NoError(t, err)
+
+			addrs = append(addrs, objectcore.AddressOf(obj))
+		}
+	}
+	snapshot := func(since uint64) meta.SnapshotRes {
+		var prm meta.SnapshotPrm
+		prm.SetSince(since)
+
+		res, err := db.Snapshot(prm)
+		require.NoError(t, err)
+		return res
+	}
+	snapshotAddrs := func(res meta.SnapshotRes) []oid.Address {
+		var res2 []oid.Address
+		for _, obj := range res.Objects() {
+			res2 = append(res2, obj.Address())
+		}
+		return res2
+	}
+
+	res := snapshot(0)
+	require.Zero(t, res.Checkpoint())
+	require.Empty(t, res.Objects())
+
+	put(3)
+
+	res = snapshot(0)
+	require.Equal(t, addrs, snapshotAddrs(res))
+	require.Equal(t, []byte{0}, res.Objects()[0].StorageID())
+	require.Nil(t, res.Objects()[1].StorageID())
+
+	checkpoint := res.Checkpoint()
+	require.Empty(t, snapshot(checkpoint).Objects())
+
+	put(2)
+
+	res = snapshot(checkpoint)
+	require.Equal(t, addrs[3:], snapshotAddrs(res))
+	require.Greater(t, res.Checkpoint(), checkpoint)
+
+	// metadata is returned completely
+	tomb := oidtest.Address()
+	tomb.SetContainer(cnr)
+
+	var inhumePrm meta.InhumePrm
+	inhumePrm.SetTombstoneAddress(tomb)
+	inhumePrm.SetAddresses(addrs[0])
+	_, err := db.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	inhumePrm = meta.InhumePrm{}
+	inhumePrm.SetGCMark()
+	inhumePrm.SetAddresses(addrs[1])
+	_, err = db.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	locker := oidtest.ID()
+	require.NoError(t, db.Lock(cnr, locker, []oid.ID{addrs[2].Object()}))
+
+	res = snapshot(res.Checkpoint())
+	require.Empty(t, res.Objects())
+	require.Len(t, res.Graveyard(), 1)
+	require.Equal(t, addrs[0], res.Graveyard()[0].Address())
+	require.Equal(t, tomb, res.Graveyard()[0].Tombstone())
+	require.Equal(t, []oid.Address{addrs[1]}, res.Garbage())
+	require.Len(t, res.Locked(), 1)
+	require.Equal(t, addrs[2], res.Locked()[0].Address())
+	require.Equal(t, locker, res.Locked()[0].Locker())
+
+	// physically removed objects are not returned
+	var delPrm meta.DeletePrm
+	delPrm.SetAddresses(addrs[1])
+	_, err = db.Delete(delPrm)
+	require.NoError(t, err)
+
+	res = snapshot(0)
+	require.Equal(t, append([]oid.Address{addrs[0]}, addrs[2:]...), snapshotAddrs(res))
+	require.Empty(t, res.Garbage())
+}
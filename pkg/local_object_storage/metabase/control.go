@@ -73,12 +73,21 @@ func (db *DB) openBolt() error {
 
 // Init initializes metabase. It creates static (CID-independent) buckets in underlying BoltDB instance.
 //
-// Returns ErrOutdatedVersion if a database at the provided path is outdated.
+// If a database at the provided path has an older version, it is upgraded
+// with the registered migrations, see MigrationPlan.
+// Returns ErrOutdatedVersion if a database at the provided path is outdated
+// and can't be migrated.
 //
 // Does nothing if metabase has already been initialized and filled. To roll back the database to its initial state,
 // use Reset.
 func (db *DB) Init() error {
-	return db.init(false)
+	if err := db.init(false); err != nil {
+		return err
+	}
+
+	// resume the indexing interrupted by the previous close
+	db.startIndexBackfill()
+	return nil
 }
 
 // Reset resets metabase. Works similar to Init but cleans up all static buckets and
@@ -91,6 +100,8 @@ func (db *DB) Reset() error {
 		return ErrDegradedMode
 	}
 
+	db.forgetIndexes()
+
 	return db.init(true)
 }
 
@@ -106,6 +117,13 @@ func (db *DB) init(reset bool) error {
 		string(garbageBucketName):         {},
 		string(shardInfoBucket):           {},
 		string(bucketNameLocked):          {},
+		string(changesBucketName):         {},
+	}
+
+	if !reset {
+		if err := db.migrate(); err != nil {
+			return err
+		}
 	}
 
 	return db.boltDB.Update(func(tx *bbolt.Tx) error {
@@ -173,6 +191,8 @@ func (db *DB) SyncCounters() error {
 
 // Close closes boltDB instance.
 func (db *DB) Close() error {
+	db.forgetIndexes()
+
 	if db.boltDB != nil {
 		return db.boltDB.Close()
 	}
@@ -11,6 +11,7 @@ import (
 
 var objectPhyCounterKey = []byte("phy_counter")
 var objectLogicCounterKey = []byte("logic_counter")
+var garbageCounterKey = []byte("garbage_counter")
 
 type objectType uint8
 
@@ -18,6 +19,7 @@ const (
 	_ objectType = iota
 	phy
 	logical
+	garbage
 )
 
 // ObjectCounters groups object counter
@@ -86,6 +88,8 @@ func (db *DB) updateCounter(tx *bbolt.Tx, typ objectType, delta uint64, inc bool
 		counterKey = objectPhyCounterKey
 	case logical:
 		counterKey = objectLogicCounterKey
+	case garbage:
+		counterKey = garbageCounterKey
 	default:
 		panic("unknown object type counter")
 	}
@@ -110,8 +114,8 @@ func (db *DB) updateCounter(tx *bbolt.Tx, typ objectType, delta uint64, inc bool
 }
 
 // syncCounter updates object counters according to metabase state:
-// it counts all the physically/logically stored objects using internal
-// indexes. Tx MUST be writable.
+// it counts all the physically/logically stored objects and the objects
+// marked with GC mark using internal indexes. Tx MUST be writable.
 //
 // Does nothing if counters are not empty and force is false. If force is
 // true, updates the counters anyway.
@@ -121,6 +125,13 @@ func syncCounter(tx *bbolt.Tx, force bool) error {
 		return fmt.Errorf("could not get shard info bucket: %w", err)
 	}
 
+	if force || len(b.Get(garbageCounterKey)) != 8 {
+		err = syncGarbageCounter(tx, b)
+		if err != nil {
+			return err
+		}
+	}
+
 	if !force && len(b.Get(objectPhyCounterKey)) == 8 && len(b.Get(objectLogicCounterKey)) == 8 {
 		// the counters are already inited
 		return nil
@@ -170,3 +181,24 @@ func syncCounter(tx *bbolt.Tx, force bool) error {
 
 	return nil
 }
+
+// syncGarbageCounter sets the garbage counter to the number of objects
+// marked with GC mark. Tx MUST be writable.
+func syncGarbageCounter(tx *bbolt.Tx, b *bbolt.Bucket) error {
+	var counter uint64
+
+	garbageBKT := tx.Bucket(garbageBucketName)
+	if garbageBKT != nil {
+		counter = uint64(garbageBKT.Stats().KeyN)
+	}
+
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, counter)
+
+	err := b.Put(garbageCounterKey, data)
+	if err != nil {
+		return fmt.Errorf("could not update garbage counter: %w", err)
+	}
+
+	return nil
+}
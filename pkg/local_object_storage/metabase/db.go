@@ -14,9 +14,11 @@ import (
 	v2object "github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	"github.com/mr-tron/base58"
 	"go.etcd.io/bbolt"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -41,6 +43,16 @@ type DB struct {
 
 	matchers map[object.SearchMatchType]matcher
 
+	indexMtx sync.RWMutex
+	// indexedContainers contains the containers with
+	// the index definitions stored in the database.
+	indexedContainers map[cid.ID]struct{}
+
+	// backfilling is true if the background indexing is running.
+	backfilling atomic.Bool
+	// backfillSignal is set when the background indexing must be (re)started.
+	backfillSignal atomic.Bool
+
 	boltDB *bbolt.DB
 
 	initialized bool
@@ -60,6 +72,10 @@ type cfg struct {
 	log *logger.Logger
 
 	epochState EpochState
+
+	indexSource IndexSource
+
+	indexBatchSize int
 }
 
 func defaultCfg() *cfg {
@@ -70,6 +86,7 @@ func defaultCfg() *cfg {
 		boltBatchDelay: bbolt.DefaultMaxBatchDelay,
 		boltBatchSize:  bbolt.DefaultMaxBatchSize,
 		log:            &logger.Logger{Logger: zap.L()},
+		indexBatchSize: defaultIndexBackfillBatchSize,
 	}
 }
 
@@ -86,7 +103,8 @@ func New(opts ...Option) *DB {
 	}
 
 	return &DB{
-		cfg: c,
+		cfg:               c,
+		indexedContainers: make(map[cid.ID]struct{}),
 		matchers: map[object.SearchMatchType]matcher{
 			object.MatchUnknown: {
 				matchSlow:   unknownMatcher,
@@ -172,11 +172,16 @@ func (db *DB) delete(tx *bbolt.Tx, addr oid.Address, refCounter referenceCounter
 	removeAvailableObject := inGraveyardWithKey(addrKey, graveyardBKT, garbageBKT) == 0
 
 	// remove record from the garbage bucket
-	if garbageBKT != nil {
+	if garbageBKT != nil && garbageBKT.Get(addrKey) != nil {
 		err := garbageBKT.Delete(addrKey)
 		if err != nil {
 			return false, false, 0, fmt.Errorf("could not remove from garbage bucket: %w", err)
 		}
+
+		err = db.updateCounter(tx, garbage, 1, false)
+		if err != nil {
+			return false, false, 0, fmt.Errorf("could not decrease garbage counter: %w", err)
+		}
 	}
 
 	// unmarshal object, work only with physically stored (raw == true) objects
@@ -241,6 +246,13 @@ func (db *DB) deleteObject(
 		return fmt.Errorf("can't remove fake bucket tree indexes: %w", err)
 	}
 
+	cnr, _ := obj.ContainerID()
+	idx, _ := getIndexDefinitions(tx, cnr)
+	err = updateAttributeIndexes(tx, obj, idx, delAttributeIndexItem)
+	if err != nil {
+		return fmt.Errorf("can't remove attribute indexes: %w", err)
+	}
+
 	return nil
 }
 
@@ -366,6 +378,10 @@ func delUniqueIndexes(tx *bbolt.Tx, obj *objectSDK.Object, isParent bool) error
 		name: toMoveItBucketName,
 		key:  addrKey,
 	})
+	delUniqueIndexItem(tx, namedBucketItem{ // remove from change tracking index
+		name: changesBucketName,
+		key:  addrKey,
+	})
 
 	return nil
 }
@@ -2,6 +2,7 @@ package meta
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 
@@ -70,6 +71,27 @@ func (db *DB) IterateOverGarbage(p GarbageIterationPrm) error {
 	})
 }
 
+// GarbageCount returns the number of objects marked with GC mark.
+func (db *DB) GarbageCount() (uint64, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return 0, ErrDegradedMode
+	}
+
+	var n uint64
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		if b := tx.Bucket(shardInfoBucket); b != nil {
+			if data := b.Get(garbageCounterKey); len(data) == 8 {
+				n = binary.LittleEndian.Uint64(data)
+			}
+		}
+		return nil
+	})
+	return n, err
+}
+
 // TombstonedObject represents descriptor of the
 // object that has been covered with tombstone.
 type TombstonedObject struct {
@@ -433,3 +433,47 @@ func TestDB_DropGraves(t *testing.T) {
 	require.NoError(t, err)
 	require.Zero(t, counter)
 }
+
+func TestDB_GarbageCount(t *testing.T) {
+	db := newDB(t)
+
+	requireGarbage := func(exp uint64) {
+		n, err := db.GarbageCount()
+		require.NoError(t, err)
+		require.Equal(t, exp, n)
+	}
+
+	requireGarbage(0)
+
+	obj1 := generateObject(t)
+	obj2 := generateObject(t)
+	require.NoError(t, putBig(db, obj1))
+	require.NoError(t, putBig(db, obj2))
+
+	addr1 := object.AddressOf(obj1)
+	addr2 := object.AddressOf(obj2)
+
+	var inhumePrm meta.InhumePrm
+	inhumePrm.SetAddresses(addr1)
+	inhumePrm.SetGCMark()
+
+	// repeated marks are counted once
+	for i := 0; i < 2; i++ {
+		_, err := db.Inhume(inhumePrm)
+		require.NoError(t, err)
+		requireGarbage(1)
+	}
+
+	// objects covered with a tombstone are marked too
+	require.NoError(t, metaInhume(db, addr2, oidtest.Address()))
+	requireGarbage(2)
+
+	require.NoError(t, db.SyncCounters())
+	requireGarbage(2)
+
+	require.NoError(t, metaDelete(db, addr1))
+	requireGarbage(1)
+
+	require.NoError(t, db.Reset())
+	requireGarbage(0)
+}
@@ -0,0 +1,821 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// IndexType defines the order of the attribute values in the sorted index.
+type IndexType uint8
+
+const (
+	// IndexString orders the values as byte strings.
+	IndexString IndexType = iota
+	// IndexNumeric orders the values as signed 64-bit decimal integers.
+	// Values which are not valid integers are not indexed.
+	IndexNumeric
+)
+
+// String implements fmt.Stringer.
+func (t IndexType) String() string {
+	switch t {
+	case IndexString:
+		return "string"
+	case IndexNumeric:
+		return "numeric"
+	default:
+		return "unknown(" + strconv.Itoa(int(t)) + ")"
+	}
+}
+
+// AttributeIndex describes the sorted index of the object attribute.
+type AttributeIndex struct {
+	// Attribute is the key of the indexed attribute.
+	Attribute string
+	// Type is the order of the attribute values.
+	Type IndexType
+}
+
+// IndexSource provides the definitions of the container attribute indexes.
+//
+// Indexes are requested once per container on the first object put,
+// the definitions are stored in the metabase and are never requested again,
+// so the source must return the same result for the container every time.
+type IndexSource interface {
+	// AttributeIndexes returns the sorted indexes of the container objects attributes.
+	AttributeIndexes(cid.ID) ([]AttributeIndex, error)
+}
+
+// WithIndexSource returns option to maintain the sorted indexes of the object
+// attributes defined by the source. No indexes are created without the source.
+func WithIndexSource(s IndexSource) Option {
+	return func(c *cfg) {
+		c.indexSource = s
+	}
+}
+
+var (
+	// ErrAttributeNotIndexed is returned on selecting objects by the attribute
+	// which has no sorted index in the container.
+	ErrAttributeNotIndexed = logicerr.New("attribute is not indexed")
+
+	// ErrInvalidIndexQuery is returned on selecting objects by the bounds or
+	// prefix which can't be applied to the index type.
+	ErrInvalidIndexQuery = logicerr.New("invalid index query")
+)
+
+// attributeIndexBucketName returns <CID>_index_<attributeKey>.
+func attributeIndexBucketName(cnr cid.ID, attributeKey string, key []byte) []byte {
+	key[0] = attributeIndexPrefix
+	cnr.Encode(key[1:])
+	return append(key[:bucketKeySize], attributeKey...)
+}
+
+// encodeIndexValue returns the representation of the attribute value which
+// is ordered according to the index type when compared as bytes.
+// The second return value is false if the value can't be indexed.
+//
+// String values are terminated by 0x00 0x01 with 0x00 bytes escaped as 0x00 0xFF,
+// so that a value is always less than the values it is a prefix of, regardless
+// of the object ID following it in the index key.
+func encodeIndexValue(t IndexType, v string) ([]byte, bool) {
+	switch t {
+	case IndexString:
+		return append(escapeIndexString(v), 0x00, 0x01), true
+	case IndexNumeric:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+
+		res := make([]byte, 8)
+		binary.BigEndian.PutUint64(res, uint64(n)^(1<<63))
+		return res, true
+	default:
+		return nil, false
+	}
+}
+
+func escapeIndexString(v string) []byte {
+	res := make([]byte, 0, len(v)+2)
+	for i := 0; i < len(v); i++ {
+		res = append(res, v[i])
+		if v[i] == 0x00 {
+			res = append(res, 0xFF)
+		}
+	}
+	return res
+}
+
+// successor returns the smallest byte string greater than all the strings
+// with the prefix p, nil if there is no such string.
+func successor(p []byte) []byte {
+	res := slice.Copy(p)
+	for i := len(res) - 1; i >= 0; i-- {
+		if res[i] != 0xFF {
+			res[i]++
+			return res[:i+1]
+		}
+	}
+	return nil
+}
+
+// indexDefinitionsKey is the key of the container index definitions
+// in the shard info bucket.
+func indexDefinitionsKey(cnr cid.ID) []byte {
+	key := make([]byte, len(indexDefinitionsPrefix)+cidSize)
+	copy(key, indexDefinitionsPrefix)
+	cnr.Encode(key[len(indexDefinitionsPrefix):])
+	return key
+}
+
+var indexDefinitionsPrefix = []byte("index_")
+
+// indexBackfillKey is the key of the position of the background indexing
+// of the container objects in the shard info bucket.
+func indexBackfillKey(cnr cid.ID) []byte {
+	key := make([]byte, len(indexBackfillPrefix)+cidSize)
+	copy(key, indexBackfillPrefix)
+	cnr.Encode(key[len(indexBackfillPrefix):])
+	return key
+}
+
+var indexBackfillPrefix = []byte("backfill_")
+
+// indexBackfillPending returns true if the container objects are not indexed yet.
+func indexBackfillPending(tx *bbolt.Tx, cnr cid.ID) bool {
+	b := tx.Bucket(shardInfoBucket)
+	return b != nil && b.Get(indexBackfillKey(cnr)) != nil
+}
+
+func encodeIndexDefinitions(idx []AttributeIndex) []byte {
+	w := io.NewBufBinWriter()
+	w.WriteVarUint(uint64(len(idx)))
+	for i := range idx {
+		w.WriteB(byte(idx[i].Type))
+		w.WriteString(idx[i].Attribute)
+	}
+	return w.Bytes()
+}
+
+func decodeIndexDefinitions(data []byte) ([]AttributeIndex, error) {
+	r := io.NewBinReaderFromBuf(data)
+	idx := make([]AttributeIndex, r.ReadVarUint())
+	for i := range idx {
+		idx[i].Type = IndexType(r.ReadB())
+		idx[i].Attribute = r.ReadString()
+	}
+	return idx, r.Err
+}
+
+// getIndexDefinitions returns the attribute indexes of the container.
+// The second return value is false if the definitions are not stored.
+func getIndexDefinitions(tx *bbolt.Tx, cnr cid.ID) ([]AttributeIndex, bool) {
+	b := tx.Bucket(shardInfoBucket)
+	if b == nil {
+		return nil, false
+	}
+
+	data := b.Get(indexDefinitionsKey(cnr))
+	if data == nil {
+		return nil, false
+	}
+
+	idx, err := decodeIndexDefinitions(data)
+	if err != nil {
+		return nil, false
+	}
+	return idx, true
+}
+
+// indexesToLearn returns the attribute indexes of the container if they
+// are not stored in the database yet and must be created by the next Put.
+func (db *DB) indexesToLearn(cnr cid.ID) ([]AttributeIndex, bool) {
+	db.indexMtx.RLock()
+	_, known := db.indexedContainers[cnr]
+	db.indexMtx.RUnlock()
+
+	if known {
+		return nil, false
+	}
+
+	_ = db.boltDB.View(func(tx *bbolt.Tx) error {
+		_, known = getIndexDefinitions(tx, cnr)
+		return nil
+	})
+	if known {
+		db.rememberIndexes(cnr)
+		return nil, false
+	}
+
+	if db.indexSource == nil {
+		return nil, false
+	}
+
+	idx, err := db.indexSource.AttributeIndexes(cnr)
+	if err != nil {
+		// the index is built on the next successful request
+		db.log.Debug("can't get container attribute indexes",
+			zap.Stringer("cid", cnr),
+			zap.Error(err))
+		return nil, false
+	}
+	return idx, true
+}
+
+func (db *DB) rememberIndexes(cnr cid.ID) {
+	db.indexMtx.Lock()
+	db.indexedContainers[cnr] = struct{}{}
+	db.indexMtx.Unlock()
+}
+
+func (db *DB) forgetIndexes() {
+	db.indexMtx.Lock()
+	db.indexedContainers = make(map[cid.ID]struct{})
+	db.indexMtx.Unlock()
+}
+
+// createIndexes stores the container index definitions. If the container
+// already has objects in the database, they are indexed in the background,
+// see backfillIndexes.
+func createIndexes(tx *bbolt.Tx, cnr cid.ID, idx []AttributeIndex) error {
+	if _, ok := getIndexDefinitions(tx, cnr); ok {
+		return nil
+	}
+
+	b, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+	if err != nil {
+		return fmt.Errorf("can't create auxiliary bucket: %w", err)
+	}
+
+	err = b.Put(indexDefinitionsKey(cnr), encodeIndexDefinitions(idx))
+	if err != nil || len(idx) == 0 || !containerHasObjects(tx, cnr) {
+		return err
+	}
+
+	return b.Put(indexBackfillKey(cnr), []byte{})
+}
+
+// updateAttributeIndexes applies f to the sorted index items of the object attributes.
+func updateAttributeIndexes(tx *bbolt.Tx, obj *objectSDK.Object, idx []AttributeIndex, f updateIndexItemFunc) error {
+	if len(idx) == 0 {
+		return nil
+	}
+
+	id, _ := obj.ID()
+	cnr, _ := obj.ContainerID()
+
+	attrs := obj.Attributes()
+	for i := range idx {
+		for j := range attrs {
+			if attrs[j].Key() != idx[i].Attribute {
+				continue
+			}
+
+			val, ok := encodeIndexValue(idx[i].Type, attrs[j].Value())
+			if !ok {
+				continue
+			}
+
+			err := f(tx, namedBucketItem{
+				name: attributeIndexBucketName(cnr, idx[i].Attribute, make([]byte, bucketKeySize)),
+				key:  append(val, objectKey(id, make([]byte, objectKeySize))...),
+				val:  []byte(attrs[j].Value()),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func putAttributeIndexItem(tx *bbolt.Tx, item namedBucketItem) error {
+	bkt, err := tx.CreateBucketIfNotExists(item.name)
+	if err != nil {
+		return fmt.Errorf("can't create index %v: %w", item.name, err)
+	}
+
+	return bkt.Put(item.key, item.val)
+}
+
+func delAttributeIndexItem(tx *bbolt.Tx, item namedBucketItem) error {
+	bkt := tx.Bucket(item.name)
+	if bkt != nil {
+		_ = bkt.Delete(item.key) // ignore error, best effort there
+	}
+	return nil
+}
+
+// SelectIndexedPrm groups the parameters of SelectIndexed operation.
+type SelectIndexedPrm struct {
+	cnr     cid.ID
+	attr    string
+	filters objectSDK.SearchFilters
+
+	lower, upper       *string
+	lowerInc, upperInc bool
+	prefix             string
+
+	limit      int
+	descending bool
+	startAfter *oid.ID
+}
+
+// SelectIndexedRes groups the resulting values of SelectIndexed operation.
+type SelectIndexedRes struct {
+	objects []IndexedObject
+}
+
+// IndexedObject is an object selected by the sorted attribute index.
+type IndexedObject struct {
+	addr  oid.Address
+	value string
+	key   []byte
+}
+
+// Address returns the object address.
+func (o IndexedObject) Address() oid.Address {
+	return o.addr
+}
+
+// Value returns the value of the indexed attribute.
+func (o IndexedObject) Value() string {
+	return o.value
+}
+
+// Compare compares the objects in the index order. Objects with the same
+// attribute value are ordered by their IDs.
+func (o IndexedObject) Compare(other IndexedObject) int {
+	return bytes.Compare(o.key, other.key)
+}
+
+// SetContainerID is a SelectIndexed option to set the container id to search in.
+func (p *SelectIndexedPrm) SetContainerID(cnr cid.ID) {
+	p.cnr = cnr
+}
+
+// SetAttribute is a SelectIndexed option to set the indexed attribute
+// the objects are selected and ordered by.
+func (p *SelectIndexedPrm) SetAttribute(attr string) {
+	p.attr = attr
+}
+
+// SetLowerBound is a SelectIndexed option to select the objects with the
+// attribute value greater than (or equal to if inclusive is set) v.
+func (p *SelectIndexedPrm) SetLowerBound(v string, inclusive bool) {
+	p.lower, p.lowerInc = &v, inclusive
+}
+
+// SetUpperBound is a SelectIndexed option to select the objects with the
+// attribute value less than (or equal to if inclusive is set) v.
+func (p *SelectIndexedPrm) SetUpperBound(v string, inclusive bool) {
+	p.upper, p.upperInc = &v, inclusive
+}
+
+// SetPrefix is a SelectIndexed option to select the objects with the
+// attribute value starting with the prefix. Allowed for string indexes only.
+func (p *SelectIndexedPrm) SetPrefix(prefix string) {
+	p.prefix = prefix
+}
+
+// SetFilters is a SelectIndexed option to set additional object filters.
+func (p *SelectIndexedPrm) SetFilters(fs objectSDK.SearchFilters) {
+	p.filters = fs
+}
+
+// SetLimit is a SelectIndexed option to set the maximum number of the
+// selected objects. Zero means no limit.
+func (p *SelectIndexedPrm) SetLimit(limit int) {
+	p.limit = limit
+}
+
+// SetDescending is a SelectIndexed option to select the objects
+// in the descending order of the attribute values.
+func (p *SelectIndexedPrm) SetDescending(descending bool) {
+	p.descending = descending
+}
+
+// SetStartAfter is a SelectIndexed option to select the objects with IDs
+// greater than id. It is allowed for the selection of a single attribute value
+// only, since the objects with the same value are ordered by their IDs.
+func (p *SelectIndexedPrm) SetStartAfter(id oid.ID) {
+	p.startAfter = &id
+}
+
+// Objects returns the selected objects in the requested order.
+func (r SelectIndexedRes) Objects() []IndexedObject {
+	return r.objects
+}
+
+// indexRange is the range of the encoded attribute values.
+type indexRange struct {
+	lower, upper       []byte
+	lowerInc, upperInc bool
+	prefix             []byte
+}
+
+func newIndexRange(t IndexType, prm SelectIndexedPrm) (indexRange, error) {
+	var r indexRange
+
+	encodeBound := func(v *string) ([]byte, error) {
+		if v == nil {
+			return nil, nil
+		}
+
+		val, ok := encodeIndexValue(t, *v)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid %s bound %q", ErrInvalidIndexQuery, t, *v)
+		}
+		return val, nil
+	}
+
+	var err error
+	if r.lower, err = encodeBound(prm.lower); err != nil {
+		return r, err
+	}
+	if r.upper, err = encodeBound(prm.upper); err != nil {
+		return r, err
+	}
+	r.lowerInc, r.upperInc = prm.lowerInc, prm.upperInc
+
+	if prm.prefix != "" {
+		if t != IndexString {
+			return r, fmt.Errorf("%w: prefix in %s index", ErrInvalidIndexQuery, t)
+		}
+		r.prefix = escapeIndexString(prm.prefix)
+	}
+
+	return r, nil
+}
+
+// compare returns -1 if the encoded value is below the range,
+// 1 if it is above the range and 0 if it is in the range.
+func (r indexRange) compare(v []byte) int {
+	if !bytes.HasPrefix(v, r.prefix) {
+		return bytes.Compare(v, r.prefix)
+	}
+	if r.lower != nil {
+		if c := bytes.Compare(v, r.lower); c < 0 || c == 0 && !r.lowerInc {
+			return -1
+		}
+	}
+	if r.upper != nil {
+		if c := bytes.Compare(v, r.upper); c > 0 || c == 0 && !r.upperInc {
+			return 1
+		}
+	}
+	return 0
+}
+
+// singleValue returns true if the range contains a single value.
+func (r indexRange) singleValue() bool {
+	return r.lower != nil && r.lowerInc && r.upperInc && len(r.prefix) == 0 &&
+		bytes.Equal(r.lower, r.upper)
+}
+
+// seekLower returns the key to start the ascending iteration from.
+func (r indexRange) seekLower() []byte {
+	if bytes.Compare(r.lower, r.prefix) > 0 {
+		return r.lower
+	}
+	return r.prefix
+}
+
+// seekUpper returns the key following the keys of the range,
+// nil if the range is not bounded from above.
+func (r indexRange) seekUpper() []byte {
+	var res []byte
+	if r.upper != nil {
+		res = r.upper
+		if r.upperInc {
+			// encoded values are never prefixes of each other
+			res = successor(r.upper)
+		}
+	}
+	if len(r.prefix) != 0 {
+		if s := successor(r.prefix); s != nil && (res == nil || bytes.Compare(s, res) < 0) {
+			res = s
+		}
+	}
+	return res
+}
+
+// SelectIndexed returns the objects with the attribute values in the requested
+// range ordered by the attribute value using the sorted attribute index.
+//
+// Returns ErrAttributeNotIndexed if the container has no index on the attribute.
+func (db *DB) SelectIndexed(prm SelectIndexedPrm) (res SelectIndexedRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	}
+
+	currEpoch := db.epochState.CurrentEpoch()
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		res.objects, err = db.selectIndexed(tx, prm, currEpoch)
+		return err
+	})
+	return res, err
+}
+
+func (db *DB) selectIndexed(tx *bbolt.Tx, prm SelectIndexedPrm, currEpoch uint64) ([]IndexedObject, error) {
+	idx, ok := getIndexDefinitions(tx, prm.cnr)
+	if !ok {
+		if containerHasObjects(tx, prm.cnr) {
+			return nil, ErrAttributeNotIndexed
+		}
+		// the index is created with the first container object
+		return nil, nil
+	}
+	if indexBackfillPending(tx, prm.cnr) {
+		return nil, ErrAttributeNotIndexed
+	}
+
+	i := 0
+	for ; i < len(idx) && idx[i].Attribute != prm.attr; i++ {
+	}
+	if i == len(idx) {
+		return nil, ErrAttributeNotIndexed
+	}
+
+	r, err := newIndexRange(idx[i].Type, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	var startKey []byte
+	if prm.startAfter != nil {
+		if !r.singleValue() || prm.descending {
+			return nil, fmt.Errorf("%w: start object in range query", ErrInvalidIndexQuery)
+		}
+		startKey = append(slice.Copy(r.lower), prm.startAfter[:]...)
+	}
+
+	var matched map[oid.ID]struct{}
+	if len(prm.filters) != 0 {
+		addrs, err := db.selectObjects(tx, prm.cnr, prm.filters, currEpoch)
+		if err != nil {
+			return nil, err
+		}
+
+		matched = make(map[oid.ID]struct{}, len(addrs))
+		for i := range addrs {
+			matched[addrs[i].Object()] = struct{}{}
+		}
+	}
+
+	b := tx.Bucket(attributeIndexBucketName(prm.cnr, prm.attr, make([]byte, bucketKeySize)))
+	if b == nil {
+		return nil, nil
+	}
+
+	var (
+		res  []IndexedObject
+		c    = b.Cursor()
+		k, v []byte
+		next = c.Next
+		out  = 1 // the direction of the iteration end
+	)
+
+	if prm.descending {
+		next, out = c.Prev, -1
+		if s := r.seekUpper(); s != nil {
+			if k, v = c.Seek(s); k == nil {
+				k, v = c.Last()
+			}
+		} else {
+			k, v = c.Last()
+		}
+	} else if startKey != nil {
+		if k, v = c.Seek(startKey); bytes.Equal(k, startKey) {
+			k, v = c.Next()
+		}
+	} else {
+		k, v = c.Seek(r.seekLower())
+	}
+
+	for ; k != nil; k, v = next() {
+		if len(k) <= objectKeySize {
+			continue
+		}
+
+		val := k[:len(k)-objectKeySize]
+		if cmp := r.compare(val); cmp == out {
+			break
+		} else if cmp != 0 {
+			continue
+		}
+
+		var id oid.ID
+		if err := id.Decode(k[len(val):]); err != nil {
+			continue
+		}
+
+		if matched != nil {
+			if _, ok := matched[id]; !ok {
+				continue
+			}
+		}
+
+		var addr oid.Address
+		addr.SetContainer(prm.cnr)
+		addr.SetObject(id)
+
+		if matched == nil && objectStatus(tx, addr, currEpoch) > 0 {
+			continue // ignore removed objects
+		}
+
+		res = append(res, IndexedObject{
+			addr:  addr,
+			value: string(v),
+			key:   slice.Copy(k),
+		})
+		if prm.limit > 0 && len(res) >= prm.limit {
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// containerHasObjects returns true if there are any objects of the container.
+func containerHasObjects(tx *bbolt.Tx, cnr cid.ID) bool {
+	for _, name := range allBucketNames(cnr) {
+		if b := tx.Bucket(name); b != nil {
+			if k, _ := b.Cursor().First(); k != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// defaultIndexBackfillBatchSize is the number of the objects indexed
+// in a single transaction by the background indexing.
+const defaultIndexBackfillBatchSize = 1000
+
+// indexBackfillBuckets are the buckets with the objects headers
+// indexed by the background indexing in order.
+var indexBackfillBuckets = []func(cid.ID, []byte) []byte{
+	primaryBucketName,
+	tombstoneBucketName,
+	storageGroupBucketName,
+	bucketNameLockers,
+}
+
+// startIndexBackfill starts the background indexing of the objects put
+// before the container index definitions were stored, if it is not running.
+func (db *DB) startIndexBackfill() {
+	db.backfillSignal.Store(true)
+	if db.backfilling.CAS(false, true) {
+		go db.backfillIndexes()
+	}
+}
+
+// backfillIndexes indexes the objects of the containers with the pending
+// background indexing in small batches, so that the puts are not blocked.
+// It stops when there is nothing to index or the database is not writable,
+// the indexing is resumed on the next Init.
+func (db *DB) backfillIndexes() {
+	for {
+		db.backfillSignal.Store(false)
+
+		for {
+			more, err := db.backfillIndexBatch()
+			if err != nil {
+				if !errors.Is(err, bbolt.ErrDatabaseNotOpen) {
+					db.log.Warn("can't index container objects", zap.Error(err))
+				}
+				break
+			}
+			if !more {
+				break
+			}
+		}
+
+		db.backfilling.Store(false)
+		if !db.backfillSignal.Load() || !db.backfilling.CAS(false, true) {
+			return
+		}
+	}
+}
+
+// backfillIndexBatch indexes the next batch of the objects.
+// Returns false if there is nothing to index.
+func (db *DB) backfillIndexBatch() (bool, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() || db.mode.ReadOnly() {
+		return false, nil
+	}
+
+	var more bool
+	err := db.boltDB.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(shardInfoBucket)
+		if b == nil {
+			return nil
+		}
+
+		k, v := b.Cursor().Seek(indexBackfillPrefix)
+		if k == nil || !bytes.HasPrefix(k, indexBackfillPrefix) {
+			return nil
+		}
+		more = true
+
+		key := slice.Copy(k)
+
+		var cnr cid.ID
+		if err := cnr.Decode(key[len(indexBackfillPrefix):]); err != nil {
+			return b.Delete(key)
+		}
+
+		idx, ok := getIndexDefinitions(tx, cnr)
+		if !ok {
+			return b.Delete(key)
+		}
+
+		next, err := backfillContainerIndexes(tx, cnr, idx, slice.Copy(v), db.indexBatchSize)
+		if err != nil {
+			return fmt.Errorf("container %s: %w", cnr, err)
+		}
+		if next == nil {
+			return b.Delete(key)
+		}
+		return b.Put(key, next)
+	})
+	return more, err
+}
+
+// backfillContainerIndexes indexes up to batchSize container objects following
+// the position and returns the next position, nil if all objects are indexed.
+// The position is the index of the bucket in indexBackfillBuckets followed by
+// the last indexed key, empty position means the beginning.
+func backfillContainerIndexes(tx *bbolt.Tx, cnr cid.ID, idx []AttributeIndex, pos []byte, batchSize int) ([]byte, error) {
+	var (
+		i    int
+		last []byte
+		n    int
+	)
+	if len(pos) != 0 {
+		i, last = int(pos[0]), pos[1:]
+	}
+
+	for ; i < len(indexBackfillBuckets); i, last = i+1, nil {
+		b := tx.Bucket(indexBackfillBuckets[i](cnr, make([]byte, bucketKeySize)))
+		if b == nil {
+			continue
+		}
+
+		c := b.Cursor()
+		k, v := c.First()
+		if len(last) != 0 {
+			k, v = c.Seek(last)
+			if bytes.Equal(k, last) {
+				k, v = c.Next()
+			}
+		}
+
+		for ; k != nil; k, v = c.Next() {
+			if n == batchSize {
+				return append([]byte{byte(i)}, last...), nil
+			}
+
+			obj := objectSDK.New()
+			if err := obj.Unmarshal(v); err != nil {
+				return nil, fmt.Errorf("can't unmarshal object header: %w", err)
+			}
+
+			err := updateAttributeIndexes(tx, obj, idx, putAttributeIndexItem)
+			if err == nil && obj.Parent() != nil {
+				if _, ok := obj.Parent().ID(); ok {
+					err = updateAttributeIndexes(tx, obj.Parent(), idx, putAttributeIndexItem)
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			last = slice.Copy(k)
+			n++
+		}
+	}
+	return nil, nil
+}
@@ -1,12 +1,23 @@
 package meta
 
 import (
+	"errors"
 	"math"
 	"math/rand"
+	"path/filepath"
+	"strconv"
 	"testing"
+	"time"
 
+	checksumtest "github.com/TrueCloudLab/frostfs-sdk-go/checksum/test"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	usertest "github.com/TrueCloudLab/frostfs-sdk-go/user/test"
 	"github.com/nspcc-dev/neo-go/pkg/io"
 	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
 )
 
 func Test_getVarUint(t *testing.T) {
@@ -60,3 +71,93 @@ func Test_decodeList(t *testing.T) {
 		})
 	})
 }
+
+type testIndexSource map[cid.ID][]AttributeIndex
+
+func (s testIndexSource) AttributeIndexes(cnr cid.ID) ([]AttributeIndex, error) {
+	idx, ok := s[cnr]
+	if !ok {
+		return nil, errors.New("container not found")
+	}
+	return idx, nil
+}
+
+func TestIndexBackfill(t *testing.T) {
+	src := make(testIndexSource)
+	db := New(WithPath(filepath.Join(t.TempDir(), "meta")),
+		WithPermissions(0600), WithEpochState(epochStateImpl{}),
+		WithIndexSource(src))
+	db.indexBatchSize = 2
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+	defer db.Close()
+
+	cnr := cidtest.ID()
+	put := func(t *testing.T, ts int) {
+		var attr objectSDK.Attribute
+		attr.SetKey("Timestamp")
+		attr.SetValue(strconv.Itoa(ts))
+
+		obj := objectSDK.New()
+		obj.SetContainerID(cnr)
+		obj.SetID(oidtest.ID())
+		obj.SetOwnerID(usertest.ID())
+		obj.SetPayloadChecksum(checksumtest.Checksum())
+		obj.SetAttributes(attr)
+
+		var prm PutPrm
+		prm.SetObject(obj)
+		_, err := db.Put(prm)
+		require.NoError(t, err)
+	}
+
+	const objectCount = 5
+	for i := 0; i < objectCount; i++ {
+		put(t, i)
+	}
+
+	var prm SelectIndexedPrm
+	prm.SetContainerID(cnr)
+	prm.SetAttribute("Timestamp")
+
+	pending := func() bool {
+		var ok bool
+		require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+			ok = indexBackfillPending(tx, cnr)
+			return nil
+		}))
+		return ok
+	}
+
+	// stop the background indexing to run it step by step
+	db.backfilling.Store(true)
+
+	src[cnr] = []AttributeIndex{{Attribute: "Timestamp", Type: IndexNumeric}}
+	put(t, objectCount)
+	require.True(t, pending())
+
+	for i := 0; i < 2; i++ {
+		more, err := db.backfillIndexBatch()
+		require.NoError(t, err)
+		require.True(t, more)
+		require.True(t, pending())
+
+		_, err = db.SelectIndexed(prm)
+		require.ErrorIs(t, err, ErrAttributeNotIndexed)
+	}
+
+	// the indexing is resumed after reopen
+	require.NoError(t, db.Close())
+	require.NoError(t, db.Open(false))
+	db.backfilling.Store(false)
+	require.NoError(t, db.Init())
+
+	require.Eventually(t, func() bool { return !pending() }, 5*time.Second, 10*time.Millisecond)
+
+	res, err := db.SelectIndexed(prm)
+	require.NoError(t, err)
+	require.Len(t, res.Objects(), objectCount+1)
+	for i, obj := range res.Objects() {
+		require.Equal(t, strconv.Itoa(i), obj.Value())
+	}
+}
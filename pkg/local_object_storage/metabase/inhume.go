@@ -130,6 +130,7 @@ func (db *DB) Inhume(prm InhumePrm) (res InhumeRes, err error) {
 
 	currEpoch := db.epochState.CurrentEpoch()
 	var inhumed uint64
+	var garbageMarked uint64
 
 	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
 		garbageBKT := tx.Bucket(garbageBucketName)
@@ -237,12 +238,20 @@ func (db *DB) Inhume(prm InhumePrm) (res InhumeRes, err error) {
 
 				// if tombstone appears object must be
 				// additionally marked with GC
+				if garbageBKT.Get(targetKey) == nil {
+					garbageMarked++
+				}
+
 				err = garbageBKT.Put(targetKey, zeroValue)
 				if err != nil {
 					return err
 				}
 			}
 
+			if prm.tomb == nil && bkt.Get(targetKey) == nil {
+				garbageMarked++
+			}
+
 			// consider checking if target is already in graveyard?
 			err = bkt.Put(targetKey, value)
 			if err != nil {
@@ -264,7 +273,12 @@ func (db *DB) Inhume(prm InhumePrm) (res InhumeRes, err error) {
 			}
 		}
 
-		return db.updateCounter(tx, logical, inhumed, false)
+		err := db.updateCounter(tx, logical, inhumed, false)
+		if err != nil {
+			return err
+		}
+
+		return db.updateCounter(tx, garbage, garbageMarked, true)
 	})
 
 	res.availableImhumed = inhumed
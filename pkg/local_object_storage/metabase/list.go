@@ -1,6 +1,9 @@
 package meta
 
 import (
+	"encoding/binary"
+	"errors"
+
 	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
@@ -20,6 +23,29 @@ type Cursor struct {
 	inBucketOffset []byte
 }
 
+// MarshalBinary encodes cursor into a binary form which can be used
+// to continue listing after the storage is reopened.
+func (c *Cursor) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, binary.MaxVarintLen64+len(c.bucketName)+len(c.inBucketOffset))
+	n := binary.PutUvarint(buf, uint64(len(c.bucketName)))
+	n += copy(buf[n:], c.bucketName)
+	n += copy(buf[n:], c.inBucketOffset)
+	return buf[:n], nil
+}
+
+// UnmarshalBinary decodes cursor from the data produced by MarshalBinary.
+func (c *Cursor) UnmarshalBinary(data []byte) error {
+	ln, n := binary.Uvarint(data)
+	if n <= 0 || uint64(len(data)-n) < ln {
+		return errors.New("invalid cursor data")
+	}
+
+	data = data[n:]
+	c.bucketName = append([]byte(nil), data[:ln]...)
+	c.inBucketOffset = append([]byte(nil), data[ln:]...)
+	return nil
+}
+
 // ListPrm contains parameters for ListWithCursor operation.
 type ListPrm struct {
 	count  int
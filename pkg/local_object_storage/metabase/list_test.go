@@ -162,6 +162,29 @@ func TestLisObjectsWithCursor(t *testing.T) {
 		_, _, err := metaListWithCursor(db, 0, nil)
 		require.ErrorIs(t, err, meta.ErrEndOfListing)
 	})
+
+	t.Run("marshaled cursor", func(t *testing.T) {
+		got := make([]object.AddressWithType, 0, total)
+
+		var cursor *meta.Cursor
+		for {
+			res, c, err := metaListWithCursor(db, 3, cursor)
+			if errors.Is(err, meta.ErrEndOfListing) {
+				break
+			}
+			require.NoError(t, err)
+			got = append(got, res...)
+
+			data, err := c.MarshalBinary()
+			require.NoError(t, err)
+
+			cursor = new(meta.Cursor)
+			require.NoError(t, cursor.UnmarshalBinary(data))
+		}
+
+		require.Equal(t, expected, sortAddresses(got))
+		require.Error(t, new(meta.Cursor).UnmarshalBinary([]byte{10, 1}))
+	})
 }
 
 func TestAddObjectDuringListingWithCursor(t *testing.T) {
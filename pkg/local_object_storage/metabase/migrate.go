@@ -0,0 +1,201 @@
+package meta
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// migration upgrades the metabase schema by one version.
+type migration struct {
+	description string
+
+	// apply performs the upgrade. It is allowed to commit changes in
+	// several transactions, so it must be idempotent: if it is interrupted,
+	// the stored version is not changed and the whole step is applied
+	// again on the next initialization.
+	apply func(db *bbolt.DB, p *migrationProgress) error
+}
+
+// migrations contains upgrade steps keyed by the version they upgrade from.
+// A step upgrading from version N produces the database of version N+1.
+var migrations = map[uint64]migration{
+	2: {
+		description: "add sorted attribute indexes",
+		apply: func(*bbolt.DB, *migrationProgress) error {
+			// Indexes are created lazily on the next container object put,
+			// the version is increased to prevent deleting the objects
+			// by the nodes which are not aware of the indexes.
+			return nil
+		},
+	},
+	3: {
+		description: "track the order of the object puts",
+		apply:       fillChanges,
+	},
+}
+
+// migrationProgressInterval is the minimal interval between progress messages.
+const migrationProgressInterval = 10 * time.Second
+
+// migrationProgress reports the progress of a single migration step.
+type migrationProgress struct {
+	log      *logger.Logger
+	from     uint64
+	lastLog  time.Time
+	progress uint64
+}
+
+// add marks n more items as processed and periodically logs the progress.
+func (p *migrationProgress) add(n uint64) {
+	p.progress += n
+
+	if time.Since(p.lastLog) < migrationProgressInterval {
+		return
+	}
+	p.lastLog = time.Now()
+
+	p.log.Info("metabase migration progress",
+		zap.Uint64("from", p.from),
+		zap.Uint64("to", p.from+1),
+		zap.Uint64("processed", p.progress))
+}
+
+// MigrationStep describes a single metabase upgrade step.
+type MigrationStep struct {
+	// From is the version the step upgrades from.
+	From uint64
+	// To is the version of the database after the step is applied.
+	To uint64
+	// Description is a human-readable description of the step.
+	Description string
+}
+
+// MigrationPlan returns the list of steps required to upgrade the metabase
+// to the current version. The database is not modified, so the method can be
+// used to check what Init is going to do. Empty list means that no migration
+// is needed.
+//
+// Returns ErrOutdatedVersion if the database can't be migrated and must be
+// resynchronized.
+func (db *DB) MigrationPlan() ([]MigrationStep, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	return db.migrationPlan()
+}
+
+// Migrate upgrades the metabase to the current version.
+// Does nothing if the metabase is fresh or already up to date.
+//
+// Returns ErrOutdatedVersion if the database can't be migrated and must be
+// resynchronized.
+func (db *DB) Migrate() error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	return db.migrate()
+}
+
+func (db *DB) migrationPlan() ([]MigrationStep, error) {
+	if !db.initialized {
+		return nil, nil
+	}
+
+	var (
+		stored uint64
+		known  bool
+	)
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		stored, known = readVersion(tx)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can't read metabase version: %w", err)
+	}
+
+	if !known {
+		return nil, ErrOutdatedVersion
+	}
+
+	return planMigrations(stored)
+}
+
+func planMigrations(stored uint64) ([]MigrationStep, error) {
+	if stored > version {
+		return nil, fmt.Errorf("%w: expected=%d, stored=%d", ErrOutdatedVersion, version, stored)
+	}
+
+	steps := make([]MigrationStep, 0, version-stored)
+	for v := stored; v < version; v++ {
+		m, ok := migrations[v]
+		if !ok {
+			return nil, fmt.Errorf("%w: no migration from version %d", ErrOutdatedVersion, v)
+		}
+
+		steps = append(steps, MigrationStep{
+			From:        v,
+			To:          v + 1,
+			Description: m.description,
+		})
+	}
+	return steps, nil
+}
+
+func (db *DB) migrate() error {
+	steps, err := db.migrationPlan()
+	if err != nil || len(steps) == 0 {
+		return err
+	}
+
+	db.log.Info("metabase is outdated, migrating",
+		zap.Uint64("stored version", steps[0].From),
+		zap.Uint64("target version", version))
+
+	for _, step := range steps {
+		db.log.Info("applying metabase migration",
+			zap.Uint64("from", step.From),
+			zap.Uint64("to", step.To),
+			zap.String("description", step.Description))
+
+		start := time.Now()
+		p := &migrationProgress{
+			log:     db.log,
+			from:    step.From,
+			lastLog: start,
+		}
+
+		err := migrations[step.From].apply(db.boltDB, p)
+		if err != nil {
+			return fmt.Errorf("could not migrate metabase from version %d to %d: %w", step.From, step.To, err)
+		}
+
+		err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+			return updateVersion(tx, step.To)
+		})
+		if err != nil {
+			return fmt.Errorf("could not update metabase version to %d: %w", step.To, err)
+		}
+
+		db.log.Info("metabase migration applied",
+			zap.Uint64("version", step.To),
+			zap.Uint64("processed", p.progress),
+			zap.Duration("duration", time.Since(start)))
+	}
+
+	return nil
+}
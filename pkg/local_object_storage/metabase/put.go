@@ -64,10 +64,29 @@ func (db *DB) Put(prm PutPrm) (res PutRes, err error) {
 
 	currEpoch := db.epochState.CurrentEpoch()
 
+	var (
+		idx   []AttributeIndex
+		learn bool
+	)
+	cnr, ok := prm.obj.ContainerID()
+	if ok {
+		idx, learn = db.indexesToLearn(cnr)
+	}
+
 	err = db.boltDB.Batch(func(tx *bbolt.Tx) error {
+		if learn {
+			if err := createIndexes(tx, cnr, idx); err != nil {
+				return fmt.Errorf("can't create attribute indexes: %w", err)
+			}
+		}
 		return db.put(tx, prm.obj, prm.id, nil, currEpoch)
 	})
 	if err == nil {
+		if learn {
+			db.rememberIndexes(cnr)
+			db.startIndexBackfill()
+		}
+
 		storagelog.Write(db.log,
 			storagelog.AddressField(objectCore.AddressOf(prm.obj)),
 			storagelog.OpField("metabase PUT"))
@@ -140,6 +159,12 @@ func (db *DB) put(
 		return fmt.Errorf("can't put fake bucket tree indexes: %w", err)
 	}
 
+	idx, _ := getIndexDefinitions(tx, cnr)
+	err = updateAttributeIndexes(tx, obj, idx, putAttributeIndexItem)
+	if err != nil {
+		return fmt.Errorf("can't put attribute indexes: %w", err)
+	}
+
 	// update container volume size estimation
 	if obj.Type() == objectSDK.TypeRegular && !isParent {
 		err = changeContainerSize(tx, cnr, obj.PayloadSize(), true)
@@ -160,6 +185,11 @@ func (db *DB) put(
 		if err != nil {
 			return fmt.Errorf("could not increase logical object counter: %w", err)
 		}
+
+		err = putChange(tx, object.AddressOf(obj))
+		if err != nil {
+			return fmt.Errorf("could not track object put: %w", err)
+		}
 	}
 
 	return nil
@@ -1,9 +1,11 @@
 package meta
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	v2object "github.com/TrueCloudLab/frostfs-api-go/v2/object"
@@ -32,6 +34,9 @@ type (
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+
+	limit      int
+	startAfter *oid.ID
 }
 
 // SelectRes groups the resulting values of Select operation.
@@ -49,6 +54,19 @@ func (p *SelectPrm) SetFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// SetLimit is a Select option to set the maximum number of the selected objects.
+// If the limit is set, the objects are selected in the ascending order of their
+// IDs, so the next page can be selected with SetStartAfter. Zero means no limit.
+func (p *SelectPrm) SetLimit(limit int) {
+	p.limit = limit
+}
+
+// SetStartAfter is a Select option to select the objects with IDs greater
+// than id. It is applied only if the limit is set.
+func (p *SelectPrm) SetStartAfter(id oid.ID) {
+	p.startAfter = &id
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
@@ -70,7 +88,16 @@ func (db *DB) Select(prm SelectPrm) (res SelectRes, err error) {
 	currEpoch := db.epochState.CurrentEpoch()
 
 	return res, db.boltDB.View(func(tx *bbolt.Tx) error {
-		res.addrList, err = db.selectObjects(tx, prm.cnr, prm.filters, currEpoch)
+		if prm.limit > 0 {
+			var after []byte
+			if prm.startAfter != nil {
+				after = objectKey(*prm.startAfter, make([]byte, objectKeySize))
+			}
+
+			res.addrList, err = db.selectObjectsPage(tx, prm.cnr, prm.filters, currEpoch, prm.limit, after)
+		} else {
+			res.addrList, err = db.selectObjects(tx, prm.cnr, prm.filters, currEpoch)
+		}
 
 		return err
 	})
@@ -111,30 +138,163 @@ func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, c
 			continue // ignore objects with unmatched fast filters
 		}
 
-		var id oid.ID
-		err = id.Decode([]byte(a))
+		addr, ok, err := db.matchObject(tx, cnr, []byte(a), group.slowFilters, currEpoch)
 		if err != nil {
 			return nil, err
+		} else if ok {
+			res = append(res, addr)
 		}
+	}
 
-		var addr oid.Address
-		addr.SetContainer(cnr)
-		addr.SetObject(id)
+	return res, nil
+}
 
-		if objectStatus(tx, addr, currEpoch) > 0 {
-			continue // ignore removed objects
+// selectObjectsPage returns at most limit objects with IDs greater than after
+// in the ascending order of their IDs.
+func (db *DB) selectObjectsPage(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, currEpoch uint64, limit int, after []byte) ([]oid.Address, error) {
+	group, err := groupFilters(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	if group.withCnrFilter && !cnr.Equals(group.cnr) {
+		return nil, nil
+	}
+
+	var next func() []byte
+
+	if len(group.fastFilters) == 0 {
+		// go through the buckets in order to not keep all the objects in memory
+		c := newObjectsCursor(tx, after,
+			primaryBucketName(cnr, make([]byte, bucketKeySize)),
+			tombstoneBucketName(cnr, make([]byte, bucketKeySize)),
+			storageGroupBucketName(cnr, make([]byte, bucketKeySize)),
+			parentBucketName(cnr, make([]byte, bucketKeySize)),
+			bucketNameLockers(cnr, make([]byte, bucketKeySize)))
+		next = c.next
+	} else {
+		mAddr := make(map[string]int)
+		for i := range group.fastFilters {
+			db.selectFastFilter(tx, cnr, group.fastFilters[i], mAddr, i)
 		}
 
-		if !db.matchSlowFilters(tx, addr, group.slowFilters, currEpoch) {
-			continue // ignore objects with unmatched slow filters
+		keys := make([]string, 0, len(mAddr))
+		for a, ind := range mAddr {
+			if ind == len(group.fastFilters) && a > string(after) {
+				keys = append(keys, a)
+			}
 		}
+		sort.Strings(keys)
 
-		res = append(res, addr)
+		next = func() []byte {
+			if len(keys) == 0 {
+				return nil
+			}
+
+			k := keys[0]
+			keys = keys[1:]
+			return []byte(k)
+		}
+	}
+
+	res := make([]oid.Address, 0, limit)
+
+	for k := next(); k != nil && len(res) < limit; k = next() {
+		addr, ok, err := db.matchObject(tx, cnr, k, group.slowFilters, currEpoch)
+		if err != nil {
+			return nil, err
+		} else if ok {
+			res = append(res, addr)
+		}
 	}
 
 	return res, nil
 }
 
+// matchObject returns the address of the object with the key if the object is
+// available and matches the slow filters.
+func (db *DB) matchObject(tx *bbolt.Tx, cnr cid.ID, key []byte, slowFilters object.SearchFilters, currEpoch uint64) (oid.Address, bool, error) {
+	var id oid.ID
+	err := id.Decode(key)
+	if err != nil {
+		return oid.Address{}, false, err
+	}
+
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(id)
+
+	if objectStatus(tx, addr, currEpoch) > 0 {
+		return addr, false, nil // ignore removed objects
+	}
+
+	if !db.matchSlowFilters(tx, addr, slowFilters, currEpoch) {
+		return addr, false, nil // ignore objects with unmatched slow filters
+	}
+
+	return addr, true, nil
+}
+
+// objectsCursor iterates over the keys of several buckets
+// in the ascending order skipping the duplicates.
+type objectsCursor struct {
+	cursors []*bbolt.Cursor
+	keys    [][]byte
+	last    []byte
+}
+
+func newObjectsCursor(tx *bbolt.Tx, after []byte, names ...[]byte) *objectsCursor {
+	c := new(objectsCursor)
+	c.last = after
+
+	for i := range names {
+		b := tx.Bucket(names[i])
+		if b == nil {
+			continue
+		}
+
+		cur := b.Cursor()
+
+		var k []byte
+		if after == nil {
+			k, _ = cur.First()
+		} else {
+			k, _ = cur.Seek(after)
+		}
+
+		c.cursors = append(c.cursors, cur)
+		c.keys = append(c.keys, k)
+	}
+
+	return c
+}
+
+// next returns the next key, nil if there are no more keys.
+func (c *objectsCursor) next() []byte {
+	for {
+		minInd := -1
+		for i := range c.keys {
+			if c.keys[i] != nil && (minInd == -1 || bytes.Compare(c.keys[i], c.keys[minInd]) < 0) {
+				minInd = i
+			}
+		}
+
+		if minInd == -1 {
+			return nil
+		}
+
+		k := c.keys[minInd]
+		c.keys[minInd], _ = c.cursors[minInd].Next()
+
+		if c.last != nil && bytes.Compare(k, c.last) <= 0 {
+			continue
+		}
+
+		c.last = k
+		return k
+	}
+}
+
 // selectAll adds to resulting cache all available objects in metabase.
 func (db *DB) selectAll(tx *bbolt.Tx, cnr cid.ID, to map[string]int) {
 	bucketName := make([]byte, bucketKeySize)
@@ -0,0 +1,214 @@
+package meta_test
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type indexSource map[cid.ID][]meta.AttributeIndex
+
+func (s indexSource) AttributeIndexes(cnr cid.ID) ([]meta.AttributeIndex, error) {
+	idx, ok := s[cnr]
+	if !ok {
+		return nil, errors.New("container not found")
+	}
+	return idx, nil
+}
+
+func TestDB_SelectIndexed(t *testing.T) {
+	cnr := cidtest.ID()
+	src := indexSource{cnr: {
+		{Attribute: "Timestamp", Type: meta.IndexNumeric},
+		{Attribute: "FilePath", Type: meta.IndexString},
+	}}
+
+	db := newDB(t, meta.WithIndexSource(src))
+
+	timestamps := []string{"-5", "3", "10", "20", "100", "invalid"}
+	paths := []string{"a", "a\x00", "ab", "b/1", "b/2", ""}
+
+	addrs := make([]oid.Address, len(timestamps))
+	for i := range timestamps {
+		obj := generateObjectWithCID(t, cnr)
+		addAttribute(obj, "Timestamp", timestamps[i])
+		if paths[i] != "" {
+			addAttribute(obj, "FilePath", paths[i])
+		}
+		addAttribute(obj, "Type", strconv.Itoa(i%2))
+		require.NoError(t, putBig(db, obj))
+
+		addrs[i] = object.AddressOf(obj)
+	}
+
+	selectIndexed := func(t *testing.T, f func(*meta.SelectIndexedPrm), exp ...int) {
+		var prm meta.SelectIndexedPrm
+		prm.SetContainerID(cnr)
+		f(&prm)
+
+		res, err := db.SelectIndexed(prm)
+		require.NoError(t, err)
+
+		actual := make([]oid.Address, 0, len(res.Objects()))
+		for _, o := range res.Objects() {
+			actual = append(actual, o.Address())
+		}
+
+		expected := make([]oid.Address, 0, len(exp))
+		for _, i := range exp {
+			expected = append(expected, addrs[i])
+		}
+		require.Equal(t, expected, actual)
+	}
+
+	t.Run("numeric", func(t *testing.T) {
+		selectIndexed(t, func(prm *meta.SelectIndexedPrm) {
+			prm.SetAttribute("Timestamp")
+		}, 0, 1, 2, 3, 4)
+		selectIndexed(t, func(prm *meta.SelectIndexedPrm) {
+			prm.SetAttribute("Timestamp")
+			prm.SetLowerBound("3", false)
+			prm.SetUpperBound("20", true)
+		}, 2, 3)
+		selectIndexed(t, func(prm *meta.SelectIndexedPrm) {
+			prm.SetAttribute("Timestamp")
+			prm.SetUpperBound("20", false)
+			prm.SetDescending(true)
+		}, 2, 1, 0)
+		selectIndexed(t, func(prm *meta.SelectIndexedPrm) {
+			prm.SetAttribute("Timestamp")
+			prm.SetLowerBound("3", true)
+			prm.SetUpperBound("100", true)
+			prm.SetDescending(true)
+			prm.SetLimit(2)
+		}, 4, 3)
+	})
+	t.Run("string", func(t *testing.T) {
+		selectIndexed(t, func(prm *meta.SelectIndexedPrm) {
+			prm.SetAttribute("FilePath")
+		}, 0, 1, 2, 3, 4)
+		selectIndexed(t, func(prm *meta.SelectIndexedPrm) {
+			prm.SetAttribute("FilePath")
+			prm.SetPrefix("a")
+			prm.SetDescending(true)
+		}, 2, 1, 0)
+		selectIndexed(t, func(prm *meta.SelectIndexedPrm) {
+			prm.SetAttribute("FilePath")
+			prm.SetPrefix("b/")
+			prm.SetLowerBound("b/1", false)
+		}, 4)
+		selectIndexed(t, func(prm *meta.SelectIndexedPrm) {
+			prm.SetAttribute("FilePath")
+			prm.SetUpperBound("a\x00", true)
+		}, 0, 1)
+	})
+	t.Run("filters", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("Type", "1", objectSDK.MatchStringEqual)
+
+		selectIndexed(t, func(prm *meta.SelectIndexedPrm) {
+			prm.SetAttribute("Timestamp")
+			prm.SetFilters(fs)
+			prm.SetLimit(2)
+		}, 1, 3)
+	})
+	t.Run("start after", func(t *testing.T) {
+		cnr := cidtest.ID()
+		src[cnr] = []meta.AttributeIndex{{Attribute: "FilePath", Type: meta.IndexString}}
+
+		ids := make([]oid.ID, 3)
+		for i := range ids {
+			obj := generateObjectWithCID(t, cnr)
+			addAttribute(obj, "FilePath", "x")
+			require.NoError(t, putBig(db, obj))
+
+			ids[i], _ = obj.ID()
+		}
+		sort.Slice(ids, func(i, j int) bool {
+			return bytes.Compare(ids[i][:], ids[j][:]) < 0
+		})
+
+		var prm meta.SelectIndexedPrm
+		prm.SetContainerID(cnr)
+		prm.SetAttribute("FilePath")
+		prm.SetLowerBound("x", true)
+		prm.SetUpperBound("x", true)
+		prm.SetLimit(2)
+		prm.SetStartAfter(ids[0])
+
+		res, err := db.SelectIndexed(prm)
+		require.NoError(t, err)
+		require.Len(t, res.Objects(), 2)
+		require.Equal(t, ids[1], res.Objects()[0].Address().Object())
+		require.Equal(t, ids[2], res.Objects()[1].Address().Object())
+
+		prm.SetUpperBound("y", true)
+		_, err = db.SelectIndexed(prm)
+		require.ErrorIs(t, err, meta.ErrInvalidIndexQuery)
+	})
+	t.Run("removed", func(t *testing.T) {
+		require.NoError(t, metaInhume(db, addrs[1], oidtest.Address()))
+		require.NoError(t, metaDelete(db, addrs[2]))
+
+		selectIndexed(t, func(prm *meta.SelectIndexedPrm) {
+			prm.SetAttribute("Timestamp")
+			prm.SetUpperBound("100", false)
+		}, 0, 3)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		var prm meta.SelectIndexedPrm
+		prm.SetContainerID(cnr)
+		prm.SetAttribute("Type")
+
+		_, err := db.SelectIndexed(prm)
+		require.ErrorIs(t, err, meta.ErrAttributeNotIndexed)
+
+		prm.SetAttribute("Timestamp")
+		prm.SetLowerBound("abc", true)
+		_, err = db.SelectIndexed(prm)
+		require.Error(t, err)
+	})
+	t.Run("existing objects", func(t *testing.T) {
+		cnr := cidtest.ID()
+
+		obj := generateObjectWithCID(t, cnr)
+		addAttribute(obj, "Timestamp", "1")
+		require.NoError(t, putBig(db, obj))
+
+		var prm meta.SelectIndexedPrm
+		prm.SetContainerID(cnr)
+		prm.SetAttribute("Timestamp")
+
+		_, err := db.SelectIndexed(prm)
+		require.ErrorIs(t, err, meta.ErrAttributeNotIndexed)
+
+		// the index is built when the definitions are available
+		src[cnr] = []meta.AttributeIndex{{Attribute: "Timestamp", Type: meta.IndexNumeric}}
+
+		next := generateObjectWithCID(t, cnr)
+		addAttribute(next, "Timestamp", "0")
+		require.NoError(t, putBig(db, next))
+
+		// the existing objects are indexed in the background
+		var res meta.SelectIndexedRes
+		require.Eventually(t, func() bool {
+			res, err = db.SelectIndexed(prm)
+			return err == nil
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Len(t, res.Objects(), 2)
+		require.Equal(t, object.AddressOf(next), res.Objects()[0].Address())
+		require.Equal(t, "1", res.Objects()[1].Value())
+	})
+}
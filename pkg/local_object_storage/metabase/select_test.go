@@ -1,7 +1,9 @@
 package meta_test
 
 import (
+	"bytes"
 	"encoding/hex"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -822,6 +824,74 @@ func TestExpiredObjects(t *testing.T) {
 	})
 }
 
+func TestDB_SelectPaginated(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	const objCount = 10
+
+	var (
+		all      []oid.Address
+		filtered []oid.Address
+	)
+	for i := 0; i < objCount; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		if i%2 == 0 {
+			addAttribute(obj, "foo", "bar")
+		}
+		require.NoError(t, putBig(db, obj))
+
+		all = append(all, object.AddressOf(obj))
+		if i%2 == 0 {
+			filtered = append(filtered, object.AddressOf(obj))
+		}
+	}
+
+	// removed objects are skipped
+	require.NoError(t, metaInhume(db, all[objCount-1], oidtest.Address()))
+	all = all[:objCount-1]
+
+	sortAddresses := func(addrs []oid.Address) {
+		sort.Slice(addrs, func(i, j int) bool {
+			a, b := addrs[i].Object(), addrs[j].Object()
+			return bytes.Compare(a[:], b[:]) < 0
+		})
+	}
+	sortAddresses(all)
+	sortAddresses(filtered)
+
+	selectAll := func(t *testing.T, fs objectSDK.SearchFilters, limit int) []oid.Address {
+		var (
+			prm meta.SelectPrm
+			res []oid.Address
+		)
+		prm.SetContainerID(cnr)
+		prm.SetFilters(fs)
+		prm.SetLimit(limit)
+
+		for {
+			page, err := db.Select(prm)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(page.AddressList()), limit)
+
+			res = append(res, page.AddressList()...)
+			if len(page.AddressList()) < limit {
+				return res
+			}
+			prm.SetStartAfter(page.AddressList()[limit-1].Object())
+		}
+	}
+
+	for _, limit := range []int{1, 2, 3, objCount} {
+		require.Equal(t, all, selectAll(t, nil, limit), "limit %d", limit)
+
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("foo", "bar", objectSDK.MatchStringEqual)
+		require.Equal(t, filtered, selectAll(t, fs, limit), "limit %d", limit)
+	}
+}
+
 func benchmarkSelect(b *testing.B, db *meta.DB, cid cidSDK.ID, fs objectSDK.SearchFilters, expected int) {
 	var prm meta.SelectPrm
 	prm.SetContainerID(cid)
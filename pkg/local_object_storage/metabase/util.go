@@ -22,6 +22,9 @@ var (
 	garbageBucketName         = []byte{garbagePrefix}
 	toMoveItBucketName        = []byte{toMoveItPrefix}
 	containerVolumeBucketName = []byte{containerVolumePrefix}
+	// changesBucketName stores the sequence numbers of the physically
+	// stored objects in the order of their puts.
+	changesBucketName = []byte{changesPrefix}
 
 	zeroValue = []byte{0xFF}
 )
@@ -111,6 +114,24 @@ const (
 	//  Key: split ID
 	//  Value: list of object IDs
 	splitPrefix
+
+	//======================
+	// Sorted index buckets.
+	//======================
+
+	// attributeIndexPrefix is used for prefixing buckets containing sorted user attribute indexes.
+	//  Key: attribute value encoded according to the index type + object ID
+	//  Value: attribute value
+	attributeIndexPrefix
+
+	//=======================
+	// Change tracking bucket.
+	//=======================
+
+	// changesPrefix is used for the bucket tracking the order of the object puts.
+	//  Key: object address
+	//  Value: sequence number of the object put as big-endian uint64
+	changesPrefix
 )
 
 const (
@@ -9,29 +9,19 @@ import (
 )
 
 // version contains current metabase version.
-const version = 2
+const version = 4
 
 var versionKey = []byte("version")
 
 // ErrOutdatedVersion is returned on initializing
 // an existing metabase that is not compatible with
-// the current code version.
+// the current code version and can't be migrated.
 var ErrOutdatedVersion = logicerr.New("invalid version, resynchronization is required")
 
 func checkVersion(tx *bbolt.Tx, initialized bool) error {
-	var knownVersion bool
-
-	b := tx.Bucket(shardInfoBucket)
-	if b != nil {
-		data := b.Get(versionKey)
-		if len(data) == 8 {
-			knownVersion = true
-
-			stored := binary.LittleEndian.Uint64(data)
-			if stored != version {
-				return fmt.Errorf("%w: expected=%d, stored=%d", ErrOutdatedVersion, version, stored)
-			}
-		}
+	stored, knownVersion := readVersion(tx)
+	if knownVersion && stored != version {
+		return fmt.Errorf("%w: expected=%d, stored=%d", ErrOutdatedVersion, version, stored)
 	}
 
 	if !initialized {
@@ -49,6 +39,21 @@ func checkVersion(tx *bbolt.Tx, initialized bool) error {
 	return nil
 }
 
+// readVersion returns the version stored in the database.
+// The second return value is false if there is no valid version.
+func readVersion(tx *bbolt.Tx) (uint64, bool) {
+	b := tx.Bucket(shardInfoBucket)
+	if b == nil {
+		return 0, false
+	}
+
+	data := b.Get(versionKey)
+	if len(data) != 8 {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint64(data), true
+}
+
 func updateVersion(tx *bbolt.Tx, version uint64) error {
 	data := make([]byte, 8)
 	binary.LittleEndian.PutUint64(data, version)
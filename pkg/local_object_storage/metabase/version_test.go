@@ -7,6 +7,11 @@ import (
 	"path/filepath"
 	"testing"
 
+	checksumtest "github.com/TrueCloudLab/frostfs-sdk-go/checksum/test"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	usertest "github.com/TrueCloudLab/frostfs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
 	"go.etcd.io/bbolt"
 )
@@ -85,3 +90,174 @@ func TestVersion(t *testing.T) {
 		})
 	})
 }
+
+func TestMigration(t *testing.T) {
+	dir := t.TempDir()
+
+	testBucket := []byte("migration test")
+	const objectCount = 10
+
+	var calls int
+	failAfter := -1
+
+	orig, ok := migrations[version-1]
+	t.Cleanup(func() {
+		if ok {
+			migrations[version-1] = orig
+		} else {
+			delete(migrations, version-1)
+		}
+	})
+
+	migrations[version-1] = migration{
+		description: "fill test bucket",
+		apply: func(db *bbolt.DB, p *migrationProgress) error {
+			calls++
+			for i := 0; i < objectCount; i++ {
+				if i == failAfter {
+					return errors.New("interrupted")
+				}
+
+				// Commit every key separately to check that partially
+				// applied step is restarted.
+				err := db.Update(func(tx *bbolt.Tx) error {
+					b, err := tx.CreateBucketIfNotExists(testBucket)
+					if err != nil {
+						return err
+					}
+					return b.Put([]byte{byte(i)}, []byte{byte(i)})
+				})
+				if err != nil {
+					return err
+				}
+				p.add(1)
+			}
+			return nil
+		},
+	}
+
+	newOutdatedDB := func(t *testing.T, stored uint64) *DB {
+		db := New(WithPath(filepath.Join(dir, t.Name())),
+			WithPermissions(0600), WithEpochState(epochStateImpl{}))
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			return updateVersion(tx, stored)
+		}))
+		require.NoError(t, db.Close())
+		require.NoError(t, db.Open(false))
+		return db
+	}
+	checkVersion := func(t *testing.T, db *DB, expected uint64) {
+		require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+			stored, ok := readVersion(tx)
+			require.True(t, ok)
+			require.Equal(t, expected, stored)
+			return nil
+		}))
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		db := newOutdatedDB(t, version-1)
+		defer db.Close()
+
+		steps, err := db.MigrationPlan()
+		require.NoError(t, err)
+		require.Equal(t, []MigrationStep{{
+			From:        version - 1,
+			To:          version,
+			Description: "fill test bucket",
+		}}, steps)
+
+		checkVersion(t, db, version-1)
+		require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+			require.Nil(t, tx.Bucket(testBucket))
+			return nil
+		}))
+	})
+	t.Run("apply", func(t *testing.T) {
+		calls, failAfter = 0, objectCount/2
+
+		db := newOutdatedDB(t, version-1)
+		require.ErrorContains(t, db.Init(), "interrupted")
+		checkVersion(t, db, version-1)
+		require.NoError(t, db.Close())
+
+		failAfter = -1
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+		require.Equal(t, 2, calls)
+		checkVersion(t, db, version)
+		require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+			require.Equal(t, objectCount, tx.Bucket(testBucket).Stats().KeyN)
+			return nil
+		}))
+
+		steps, err := db.MigrationPlan()
+		require.NoError(t, err)
+		require.Empty(t, steps)
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+		require.Equal(t, 2, calls)
+		require.NoError(t, db.Close())
+	})
+	t.Run("no migration path", func(t *testing.T) {
+		// version 2 is the oldest one which can be migrated
+		db := newOutdatedDB(t, 1)
+		defer db.Close()
+
+		_, err := db.MigrationPlan()
+		require.ErrorIs(t, err, ErrOutdatedVersion)
+		require.ErrorIs(t, db.Init(), ErrOutdatedVersion)
+	})
+	t.Run("newer version", func(t *testing.T) {
+		db := newOutdatedDB(t, version+1)
+		defer db.Close()
+
+		require.ErrorIs(t, db.Migrate(), ErrOutdatedVersion)
+		require.ErrorIs(t, db.Init(), ErrOutdatedVersion)
+	})
+}
+
+func TestMigrationFillChanges(t *testing.T) {
+	db := New(WithPath(filepath.Join(t.TempDir(), "meta")),
+		WithPermissions(0600), WithEpochState(epochStateImpl{}))
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+
+	const objectCount = 5
+
+	cnr := cidtest.ID()
+	for i := 0; i < objectCount; i++ {
+		obj := objectSDK.New()
+		obj.SetContainerID(cnr)
+		obj.SetID(oidtest.ID())
+		obj.SetOwnerID(usertest.ID())
+		obj.SetPayloadChecksum(checksumtest.Checksum())
+
+		var prm PutPrm
+		prm.SetObject(obj)
+		_, err := db.Put(prm)
+		require.NoError(t, err)
+	}
+
+	// simulate the metabase of the previous version
+	require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+		if err := tx.DeleteBucket(changesBucketName); err != nil {
+			return err
+		}
+		return updateVersion(tx, 3)
+	}))
+	require.NoError(t, db.Close())
+
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+	defer db.Close()
+
+	res, err := db.Snapshot(SnapshotPrm{})
+	require.NoError(t, err)
+	require.Len(t, res.Objects(), objectCount)
+	require.Equal(t, uint64(objectCount), res.Checkpoint())
+}
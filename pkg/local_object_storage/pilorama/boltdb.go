@@ -29,6 +29,12 @@ type boltForest struct {
 	mtx     sync.Mutex
 	batches []*batch
 
+	// indexStop stops the background building of the sorted children index.
+	indexStop chan struct{}
+	indexWg   sync.WaitGroup
+	// indexBatchSize is the number of keys indexed in a single transaction.
+	indexBatchSize int
+
 	cfg
 }
 
@@ -59,9 +65,13 @@ var ErrReadOnlyMode = logicerr.New("pilorama is in a read-only mode")
 // - 'p' + node (id) -> parent (id),
 // - 'm' + node (id) -> serialized meta,
 // - 'c' + parent (id) + child (id) -> 0/1,
-// - 'i' + 0 + attrKey + 0 + attrValue + 0 + parent (id) + node (id) -> 0/1 (1 for automatically created nodes).
+// - 'i' + 0 + attrKey + 0 + attrValue + 0 + parent (id) + node (id) -> 0/1 (1 for automatically created nodes),
+// - 'l' + parent (id) + attrKey + attrValue + node (id) -> nil (children sorted by the attribute value),
+// - 'v' -> 1 if the 'l' index is built,
+// - 'h' -> height below which the log operations are removed (big-endian).
 func NewBoltForest(opts ...Option) ForestStorage {
 	b := boltForest{
+		indexBatchSize: defaultIndexBatchSize,
 		cfg: cfg{
 			perm:          os.ModePerm,
 			maxBatchDelay: bbolt.DefaultMaxBatchDelay,
@@ -122,19 +132,30 @@ func (t *boltForest) Init() error {
 	if t.mode.NoMetabase() || t.db.IsReadOnly() {
 		return nil
 	}
-	return t.db.Update(func(tx *bbolt.Tx) error {
+	err := t.db.Update(func(tx *bbolt.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(dataBucket)
 		if err != nil {
 			return err
 		}
 		_, err = tx.CreateBucketIfNotExists(logBucket)
-		if err != nil {
-			return err
-		}
-		return nil
+		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	t.indexStop = make(chan struct{})
+	t.indexWg.Add(1)
+	go t.buildSortedIndex(t.indexStop)
+
+	return nil
 }
 func (t *boltForest) Close() error {
+	if t.indexStop != nil {
+		close(t.indexStop)
+		t.indexWg.Wait()
+		t.indexStop = nil
+	}
 	if t.db != nil {
 		return t.db.Close()
 	}
@@ -164,7 +185,11 @@ func (t *boltForest) TreeMove(d CIDDescriptor, treeID string, m *Move) (*Move, e
 			return err
 		}
 
-		lm.Time = t.getLatestTimestamp(bLog, d.Position, d.Size)
+		if getHorizon(bTree) == restoringHorizon {
+			return ErrTreeRestoring
+		}
+
+		lm.Time = t.getLatestTimestamp(bLog, bTree, d.Position, d.Size)
 		if lm.Child == RootID {
 			lm.Child = t.findSpareID(bTree)
 		}
@@ -220,12 +245,16 @@ func (t *boltForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string,
 			return err
 		}
 
+		if getHorizon(bTree) == restoringHorizon {
+			return ErrTreeRestoring
+		}
+
 		i, node, err := t.getPathPrefix(bTree, attr, path)
 		if err != nil {
 			return err
 		}
 
-		ts := t.getLatestTimestamp(bLog, d.Position, d.Size)
+		ts := t.getLatestTimestamp(bLog, bTree, d.Position, d.Size)
 		lm = make([]Move, len(path)-i+1)
 		for j := i; j < len(path); j++ {
 			lm[j-i] = Move{
@@ -260,13 +289,13 @@ func (t *boltForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string,
 }
 
 // getLatestTimestamp returns timestamp for a new operation which is guaranteed to be bigger than
-// all timestamps corresponding to already stored operations.
-func (t *boltForest) getLatestTimestamp(bLog *bbolt.Bucket, pos, size int) uint64 {
-	var ts uint64
+// all timestamps corresponding to already stored operations, including the compacted ones.
+func (t *boltForest) getLatestTimestamp(bLog, bTree *bbolt.Bucket, pos, size int) uint64 {
+	ts := getHorizon(bTree)
 
 	c := bLog.Cursor()
 	key, _ := c.Last()
-	if len(key) != 0 {
+	if len(key) != 0 && binary.BigEndian.Uint64(key) > ts {
 		ts = binary.BigEndian.Uint64(key)
 	}
 	return nextTimestamp(ts, uint64(pos), uint64(size))
@@ -402,11 +431,27 @@ func (t *boltForest) getTreeBuckets(tx *bbolt.Tx, treeRoot []byte) (*bbolt.Bucke
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := bData.Put(indexVersionKey, []byte{1}); err != nil {
+		return nil, nil, err
+	}
 	return bLog, bData, nil
 }
 
 // applyOperations applies log operations. Assumes lm are sorted by timestamp.
 func (t *boltForest) applyOperation(logBucket, treeBucket *bbolt.Bucket, ms []*Move, lm *Move) error {
+	horizon := getHorizon(treeBucket)
+	if horizon == restoringHorizon {
+		return ErrTreeRestoring
+	}
+
+	// Operations below the horizon are already reflected in the tree state.
+	for len(ms) != 0 && ms[0].Time < horizon {
+		ms = ms[1:]
+	}
+	if len(ms) == 0 {
+		return nil
+	}
+
 	var tmp Move
 	var cKey [17]byte
 
@@ -503,6 +548,12 @@ func (t *boltForest) redo(b *bbolt.Bucket, key []byte, op *Move, rawMeta []byte)
 				if err != nil {
 					return err
 				}
+
+				key = sortedChildKey(key, meta.Items[i].Key, meta.Items[i].Value, parent, op.Child)
+				err = b.Delete(key)
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -522,6 +573,11 @@ func (t *boltForest) removeNode(b *bbolt.Bucket, key []byte, node, parent Node)
 				if err != nil {
 					return err
 				}
+
+				err = b.Delete(sortedChildKey(nil, meta.Items[i].Key, meta.Items[i].Value, parent, node))
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -553,6 +609,11 @@ func (t *boltForest) addNode(b *bbolt.Bucket, key []byte, child, parent Node, ti
 		if err != nil {
 			return err
 		}
+
+		key = sortedChildKey(key, meta.Items[i].Key, meta.Items[i].Value, parent, child)
+		if err := b.Put(key, nil); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -764,6 +825,10 @@ func (t *boltForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (
 			return ErrTreeNotFound
 		}
 
+		if height < getHorizon(treeRoot.Bucket(dataBucket)) {
+			return ErrLogCompacted
+		}
+
 		c := treeRoot.Bucket(logBucket).Cursor()
 		if _, data := c.Seek(key); data != nil {
 			return t.moveFromBytes(&lm, data)
@@ -0,0 +1,490 @@
+package pilorama
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"sort"
+
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"go.etcd.io/bbolt"
+)
+
+// SnapshotNode represents the state of a single tree node.
+type SnapshotNode struct {
+	ID     Node
+	Parent Node
+	// Timestamp is the timestamp of the operation the node first appeared with.
+	Timestamp Timestamp
+	// Meta is the current node meta, Meta.Time is the timestamp of the last
+	// operation on the node.
+	Meta Meta
+}
+
+// horizonKey stores the height below which the log operations are compacted, big-endian.
+var horizonKey = []byte{'h'}
+
+// syncHeightKey stores the height the tree is synchronized up to from all the container nodes, big-endian.
+var syncHeightKey = []byte{'y'}
+
+// restoringHorizon is stored as a horizon while the tree is being restored from a snapshot.
+// No operations can be applied to such a tree.
+const restoringHorizon = math.MaxUint64
+
+const (
+	// compactBatchSize is the maximum amount of log operations removed in a single transaction.
+	compactBatchSize = 10000
+	// snapshotBatchSize is the maximum amount of nodes passed to the TreeSnapshot callback at once.
+	snapshotBatchSize = 1000
+)
+
+// getHorizon returns the height below which the log operations of the tree are compacted.
+func getHorizon(b *bbolt.Bucket) uint64 {
+	data := b.Get(horizonKey)
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
+
+func putHorizon(b *bbolt.Bucket, height uint64) error {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, height)
+	return b.Put(horizonKey, data)
+}
+
+// TreeCompactLog implements the Forest interface.
+func (t *boltForest) TreeCompactLog(cid cidSDK.ID, treeID string, height uint64) error {
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if t.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	fullID := bucketName(cid, treeID)
+
+	// The horizon is moved first, so the operations which are being removed
+	// are never applied again.
+	err := t.db.Update(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(fullID)
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		b := treeRoot.Bucket(dataBucket)
+
+		horizon := getHorizon(b)
+		if horizon == restoringHorizon {
+			return ErrTreeRestoring
+		}
+		if height <= horizon {
+			// finish the compaction interrupted previously, if any
+			height = horizon
+			return nil
+		}
+		return putHorizon(b, height)
+	})
+	if err != nil {
+		return err
+	}
+
+	for done := false; !done; {
+		err := t.db.Update(func(tx *bbolt.Tx) error {
+			treeRoot := tx.Bucket(fullID)
+			if treeRoot == nil {
+				return ErrTreeNotFound
+			}
+
+			bLog := treeRoot.Bucket(logBucket)
+			bTree := treeRoot.Bucket(dataBucket)
+
+			var keys [][]byte
+
+			c := bLog.Cursor()
+			for k, _ := c.First(); len(k) == 8 && binary.BigEndian.Uint64(k) < height; k, _ = c.Next() {
+				if len(keys) == compactBatchSize {
+					break
+				}
+				keys = append(keys, append([]byte(nil), k...))
+			}
+			done = len(keys) < compactBatchSize
+
+			key := make([]byte, 9)
+			for i := range keys {
+				if err := bTree.Delete(oldKey(key, binary.BigEndian.Uint64(keys[i]))); err != nil {
+					return err
+				}
+				if err := bLog.Delete(keys[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TreeLogHorizon implements the Forest interface.
+func (t *boltForest) TreeLogHorizon(cid cidSDK.ID, treeID string) (uint64, error) {
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return 0, ErrDegradedMode
+	}
+
+	var horizon uint64
+
+	err := t.db.View(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(bucketName(cid, treeID))
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		horizon = getHorizon(treeRoot.Bucket(dataBucket))
+		if horizon == restoringHorizon {
+			return ErrTreeRestoring
+		}
+		return nil
+	})
+
+	return horizon, err
+}
+
+// TreeLastSyncHeight implements the Forest interface.
+func (t *boltForest) TreeLastSyncHeight(cid cidSDK.ID, treeID string) (uint64, error) {
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return 0, ErrDegradedMode
+	}
+
+	var height uint64
+
+	err := t.db.View(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(bucketName(cid, treeID))
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		data := treeRoot.Bucket(dataBucket).Get(syncHeightKey)
+		if len(data) == 8 {
+			height = binary.BigEndian.Uint64(data)
+		}
+		return nil
+	})
+
+	return height, err
+}
+
+// TreeUpdateLastSyncHeight implements the Forest interface.
+func (t *boltForest) TreeUpdateLastSyncHeight(cid cidSDK.ID, treeID string, height uint64) error {
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if t.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, height)
+
+	return t.db.Batch(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(bucketName(cid, treeID))
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+		return treeRoot.Bucket(dataBucket).Put(syncHeightKey, data)
+	})
+}
+
+// TreeSnapshot implements the Forest interface.
+//
+// Every batch is read in a separate transaction, so that the long snapshot
+// streaming doesn't block the database. The nodes changed while the snapshot
+// is being taken are fixed by the log synchronization continued from the
+// height taken at the beginning, because every operation sets both the parent
+// and the meta of the node.
+func (t *boltForest) TreeSnapshot(cid cidSDK.ID, treeID string, f func(uint64, []SnapshotNode) error) error {
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	fullID := bucketName(cid, treeID)
+
+	var (
+		height uint64
+		next   = []byte{'s'}
+		nodes  = make([]SnapshotNode, 0, snapshotBatchSize)
+	)
+
+	err := t.db.View(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(fullID)
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		height = getHorizon(treeRoot.Bucket(dataBucket))
+		if height == restoringHorizon {
+			return ErrTreeRestoring
+		}
+
+		if k, _ := treeRoot.Bucket(logBucket).Cursor().Last(); len(k) == 8 {
+			if ts := binary.BigEndian.Uint64(k) + 1; height < ts {
+				height = ts
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for next != nil {
+		nodes = nodes[:0]
+
+		err := t.db.View(func(tx *bbolt.Tx) error {
+			treeRoot := tx.Bucket(fullID)
+			if treeRoot == nil {
+				return ErrTreeNotFound
+			}
+
+			b := treeRoot.Bucket(dataBucket)
+
+			c := b.Cursor()
+			k, _ := c.Seek(next)
+			for ; len(k) == 9 && k[0] == 's' && len(nodes) < snapshotBatchSize; k, _ = c.Next() {
+				n := SnapshotNode{ID: binary.LittleEndian.Uint64(k[1:])}
+
+				parent, ts, rawMeta, _ := t.getState(b, k)
+				if err := n.Meta.FromBytes(rawMeta); err != nil {
+					return err
+				}
+				n.Parent = parent
+				n.Timestamp = ts
+
+				nodes = append(nodes, n)
+			}
+
+			if len(k) == 9 && k[0] == 's' {
+				next = append(next[:0], k...)
+			} else {
+				next = nil
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(nodes) != 0 || next == nil {
+			if err := f(height, nodes); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// TreeRestore implements the Forest interface.
+func (t *boltForest) TreeRestore(d CIDDescriptor, treeID string, height uint64, next func() ([]SnapshotNode, error)) error {
+	if !d.checkValid() {
+		return ErrInvalidCIDDescriptor
+	}
+
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if t.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	fullID := bucketName(d.CID, treeID)
+
+	err := t.db.Update(func(tx *bbolt.Tx) error {
+		// the existing tree or the tree left after the interrupted restoration is replaced
+		if tx.Bucket(fullID) != nil {
+			if err := tx.DeleteBucket(fullID); err != nil {
+				return err
+			}
+		}
+
+		_, bTree, err := t.getTreeBuckets(tx, fullID)
+		if err != nil {
+			return err
+		}
+		return putHorizon(bTree, restoringHorizon)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = t.restoreNodes(fullID, height, next)
+	if err != nil {
+		_ = t.db.Update(func(tx *bbolt.Tx) error {
+			return tx.DeleteBucket(fullID)
+		})
+	}
+	return err
+}
+
+func (t *boltForest) restoreNodes(fullID []byte, height uint64, next func() ([]SnapshotNode, error)) error {
+	for {
+		nodes, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		err = t.db.Update(func(tx *bbolt.Tx) error {
+			treeRoot := tx.Bucket(fullID)
+			if treeRoot == nil {
+				return ErrTreeNotFound
+			}
+
+			b := treeRoot.Bucket(dataBucket)
+			key := make([]byte, 17)
+			for i := range nodes {
+				err := t.addNode(b, key, nodes[i].ID, nodes[i].Parent, nodes[i].Timestamp,
+					nodes[i].Meta, nodes[i].Meta.Bytes())
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return t.db.Update(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(fullID)
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+		return putHorizon(treeRoot.Bucket(dataBucket), height)
+	})
+}
+
+// TreeCompactLog implements the Forest interface.
+func (f *memoryForest) TreeCompactLog(cid cidSDK.ID, treeID string, height uint64) error {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return ErrTreeNotFound
+	}
+
+	if height <= s.horizon {
+		return nil
+	}
+
+	n := sort.Search(len(s.operations), func(i int) bool {
+		return s.operations[i].Time >= height
+	})
+	s.operations = append(s.operations[:0], s.operations[n:]...)
+	s.horizon = height
+	return nil
+}
+
+// TreeLogHorizon implements the Forest interface.
+func (f *memoryForest) TreeLogHorizon(cid cidSDK.ID, treeID string) (uint64, error) {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return 0, ErrTreeNotFound
+	}
+	return s.horizon, nil
+}
+
+// TreeLastSyncHeight implements the Forest interface.
+func (f *memoryForest) TreeLastSyncHeight(cid cidSDK.ID, treeID string) (uint64, error) {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return 0, ErrTreeNotFound
+	}
+	return s.syncHeight, nil
+}
+
+// TreeUpdateLastSyncHeight implements the Forest interface.
+func (f *memoryForest) TreeUpdateLastSyncHeight(cid cidSDK.ID, treeID string, height uint64) error {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return ErrTreeNotFound
+	}
+	s.syncHeight = height
+	return nil
+}
+
+// TreeSnapshot implements the Forest interface.
+func (f *memoryForest) TreeSnapshot(cid cidSDK.ID, treeID string, fn func(uint64, []SnapshotNode) error) error {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return ErrTreeNotFound
+	}
+
+	height := s.horizon
+	if len(s.operations) != 0 {
+		if ts := s.operations[len(s.operations)-1].Time + 1; height < ts {
+			height = ts
+		}
+	}
+
+	nodes := make([]SnapshotNode, 0, len(s.infoMap))
+	for id, info := range s.infoMap {
+		nodes = append(nodes, SnapshotNode{
+			ID:        id,
+			Parent:    info.Parent,
+			Timestamp: info.Meta.Time,
+			Meta:      info.Meta,
+		})
+	}
+	return fn(height, nodes)
+}
+
+// TreeRestore implements the Forest interface.
+func (f *memoryForest) TreeRestore(d CIDDescriptor, treeID string, height uint64, next func() ([]SnapshotNode, error)) error {
+	if !d.checkValid() {
+		return ErrInvalidCIDDescriptor
+	}
+
+	fullID := d.CID.String() + "/" + treeID
+
+	s := newState()
+	s.horizon = height
+	for {
+		nodes, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		for i := range nodes {
+			s.infoMap[nodes[i].ID] = nodeInfo{Parent: nodes[i].Parent, Meta: nodes[i].Meta}
+			s.childMap[nodes[i].Parent] = append(s.childMap[nodes[i].Parent], nodes[i].ID)
+		}
+	}
+
+	f.treeMap[fullID] = s
+	return nil
+}
@@ -0,0 +1,226 @@
+package pilorama
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForest_TreeCompactLog(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeCompactLog(t, providers[i].construct(t))
+		})
+	}
+}
+
+func testForestTreeCompactLog(t *testing.T, s Forest) {
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	for i := 1; i <= 10; i++ {
+		require.NoError(t, s.TreeApply(d, treeID, &Move{
+			Meta: Meta{
+				Time:  Timestamp(i),
+				Items: []KeyValue{{Key: AttributeFilename, Value: []byte(strconv.Itoa(i))}},
+			},
+			Child: Node(i),
+		}, false))
+	}
+
+	require.ErrorIs(t, s.TreeCompactLog(cid, treeID+"1", 6), ErrTreeNotFound)
+	require.NoError(t, s.TreeCompactLog(cid, treeID, 6))
+
+	horizon, err := s.TreeLogHorizon(cid, treeID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), horizon)
+
+	t.Run("compaction below the horizon", func(t *testing.T) {
+		require.NoError(t, s.TreeCompactLog(cid, treeID, 3))
+
+		horizon, err := s.TreeLogHorizon(cid, treeID)
+		require.NoError(t, err)
+		require.Equal(t, uint64(6), horizon)
+	})
+	t.Run("get log", func(t *testing.T) {
+		_, err := s.TreeGetOpLog(cid, treeID, 5)
+		require.ErrorIs(t, err, ErrLogCompacted)
+
+		lm, err := s.TreeGetOpLog(cid, treeID, 6)
+		require.NoError(t, err)
+		require.Equal(t, Timestamp(6), lm.Time)
+	})
+	t.Run("state is preserved", func(t *testing.T) {
+		children, err := s.TreeGetChildren(cid, treeID, RootID)
+		require.NoError(t, err)
+		require.Len(t, children, 10)
+	})
+	t.Run("compacted operations are ignored", func(t *testing.T) {
+		require.NoError(t, s.TreeApply(d, treeID, &Move{
+			Parent: TrashID,
+			Meta:   Meta{Time: 3},
+			Child:  2,
+		}, false))
+
+		_, parent, err := s.TreeGetMeta(cid, treeID, 2)
+		require.NoError(t, err)
+		require.Equal(t, Node(RootID), parent)
+	})
+	t.Run("operations above the horizon are applied", func(t *testing.T) {
+		m := &Move{
+			Parent: 1,
+			Meta:   Meta{Time: 7, Items: []KeyValue{{Key: AttributeFilename, Value: []byte("7")}}},
+			Child:  2,
+		}
+		require.NoError(t, s.TreeApply(d, treeID, m, false))
+
+		_, parent, err := s.TreeGetMeta(cid, treeID, 2)
+		require.NoError(t, err)
+		require.Equal(t, Node(1), parent)
+	})
+	t.Run("new operations", func(t *testing.T) {
+		require.NoError(t, s.TreeCompactLog(cid, treeID, 20))
+
+		lm, err := s.TreeMove(d, treeID, &Move{Parent: RootID, Child: RootID})
+		require.NoError(t, err)
+		require.True(t, lm.Time >= 20)
+	})
+}
+
+func TestForest_TreeLastSyncHeight(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeLastSyncHeight(t, providers[i].construct(t))
+		})
+	}
+}
+
+func testForestTreeLastSyncHeight(t *testing.T, s Forest) {
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	_, err := s.TreeLastSyncHeight(cid, treeID)
+	require.ErrorIs(t, err, ErrTreeNotFound)
+	require.ErrorIs(t, s.TreeUpdateLastSyncHeight(cid, treeID, 1), ErrTreeNotFound)
+
+	_, err = s.TreeMove(d, treeID, &Move{Parent: RootID, Child: RootID})
+	require.NoError(t, err)
+
+	height, err := s.TreeLastSyncHeight(cid, treeID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), height)
+
+	require.NoError(t, s.TreeUpdateLastSyncHeight(cid, treeID, 10))
+
+	height, err = s.TreeLastSyncHeight(cid, treeID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), height)
+}
+
+func TestForest_TreeSnapshot(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeSnapshot(t, providers[i].construct)
+		})
+	}
+}
+
+func testForestTreeSnapshot(t *testing.T, constructor func(t testing.TB, _ ...Option) Forest) {
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	src := constructor(t)
+
+	paths := [][]string{{"a", "b"}, {"a", "c"}, {"d"}}
+	for i := range paths {
+		meta := []KeyValue{{Key: AttributeFilename, Value: []byte(paths[i][len(paths[i])-1])}}
+		_, err := src.TreeAddByPath(d, treeID, AttributeFilename, paths[i][:len(paths[i])-1], meta)
+		require.NoError(t, err)
+	}
+
+	lm, err := src.TreeGetOpLog(cid, treeID, 0)
+	require.NoError(t, err)
+
+	// remove the first node
+	_, err = src.TreeMove(d, treeID, &Move{Parent: TrashID, Child: lm.Child})
+	require.NoError(t, err)
+
+	var (
+		height uint64
+		nodes  []SnapshotNode
+	)
+	err = src.TreeSnapshot(cid, treeID, func(h uint64, batch []SnapshotNode) error {
+		height = h
+		nodes = append(nodes, batch...)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, nodes, 4)
+
+	restore := func(s Forest, height uint64, batches ...[]SnapshotNode) error {
+		return s.TreeRestore(d, treeID, height, func() ([]SnapshotNode, error) {
+			if len(batches) == 0 {
+				return nil, io.EOF
+			}
+			b := batches[0]
+			batches = batches[1:]
+			return b, nil
+		})
+	}
+
+	t.Run("restore", func(t *testing.T) {
+		dst := constructor(t)
+		require.NoError(t, restore(dst, height, nodes[:2], nodes[2:]))
+
+		for i := range nodes {
+			meta, parent, err := dst.TreeGetMeta(cid, treeID, nodes[i].ID)
+			require.NoError(t, err)
+			require.Equal(t, nodes[i].Parent, parent)
+			require.Equal(t, nodes[i].Meta, meta)
+		}
+
+		for i := range paths {
+			expected, err := src.TreeGetByPath(cid, treeID, AttributeFilename, paths[i], true)
+			require.NoError(t, err)
+
+			actual, err := dst.TreeGetByPath(cid, treeID, AttributeFilename, paths[i], true)
+			require.NoError(t, err)
+			require.Equal(t, expected, actual)
+		}
+
+		_, err := dst.TreeGetOpLog(cid, treeID, 0)
+		require.ErrorIs(t, err, ErrLogCompacted)
+
+		lm, err := dst.TreeMove(d, treeID, &Move{Parent: RootID, Child: RootID})
+		require.NoError(t, err)
+		require.True(t, lm.Time >= height)
+
+		t.Run("replace existing tree", func(t *testing.T) {
+			require.NoError(t, restore(dst, height, nodes))
+
+			children, err := dst.TreeGetChildren(cid, treeID, RootID)
+			require.NoError(t, err)
+			require.NotContains(t, children, lm.Child)
+		})
+	})
+	t.Run("failed restoration", func(t *testing.T) {
+		dst := constructor(t)
+
+		errTest := errors.New("test error")
+		err := dst.TreeRestore(d, treeID, height, func() ([]SnapshotNode, error) {
+			return nil, errTest
+		})
+		require.ErrorIs(t, err, errTest)
+
+		exists, err := dst.TreeExists(cid, treeID)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+}
@@ -173,6 +173,9 @@ func (f *memoryForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64)
 	if !ok {
 		return Move{}, ErrTreeNotFound
 	}
+	if height < s.horizon {
+		return Move{}, ErrLogCompacted
+	}
 
 	n := sort.Search(len(s.operations), func(i int) bool {
 		return s.operations[i].Time >= height
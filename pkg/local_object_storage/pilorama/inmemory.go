@@ -15,6 +15,10 @@ type move struct {
 // state represents state being replicated.
 type state struct {
 	operations []move
+	// horizon is the height below which the operations are compacted.
+	horizon Timestamp
+	// syncHeight is the height the tree is synchronized up to.
+	syncHeight uint64
 	tree
 }
 
@@ -56,6 +60,10 @@ func (s *state) undo(op *move) {
 // Apply puts op in log at a proper position, re-applies all subsequent operations
 // from log and changes s in-place.
 func (s *state) Apply(op *Move) error {
+	if op.Time < s.horizon {
+		return nil
+	}
+
 	var index int
 	for index = len(s.operations); index > 0; index-- {
 		if s.operations[index-1].Time <= op.Time {
@@ -127,10 +135,11 @@ func (s *state) removeChild(child, parent Node) {
 }
 
 func (s *state) timestamp(pos, size int) Timestamp {
-	if len(s.operations) == 0 {
-		return nextTimestamp(0, uint64(pos), uint64(size))
+	ts := s.horizon
+	if len(s.operations) != 0 && s.operations[len(s.operations)-1].Time > ts {
+		ts = s.operations[len(s.operations)-1].Time
 	}
-	return nextTimestamp(s.operations[len(s.operations)-1].Time, uint64(pos), uint64(size))
+	return nextTimestamp(ts, uint64(pos), uint64(size))
 }
 
 func (s *state) findSpareID() Node {
@@ -31,6 +31,10 @@ type Forest interface {
 	// TreeGetChildren returns children of the node with the specified ID. The order is arbitrary.
 	// Should return ErrTreeNotFound if the tree is not found, and empty result if the node is not in the tree.
 	TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node) ([]uint64, error)
+	// TreeListChildren returns a page of children of the node with the specified ID
+	// and the cursor to continue the listing with, nil if there are no more children.
+	// Should return ErrTreeNotFound if the tree is not found, and empty result if the node is not in the tree.
+	TreeListChildren(cid cidSDK.ID, treeID string, nodeID Node, prm ListChildrenPrm) ([]Node, []byte, error)
 	// TreeGetOpLog returns first log operation stored at or above the height.
 	// In case no such operation is found, empty Move and nil error should be returned.
 	TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (Move, error)
@@ -44,6 +48,30 @@ type Forest interface {
 	// TreeExists checks if a tree exists locally.
 	// If the tree is not found, false and a nil error should be returned.
 	TreeExists(cid cidSDK.ID, treeID string) (bool, error)
+	// TreeCompactLog removes log operations with the timestamp less than height.
+	// Such operations are ignored if they are applied afterwards, TreeGetOpLog
+	// returns ErrLogCompacted for the height less than the compacted one.
+	// If the tree is not found, ErrTreeNotFound should be returned.
+	TreeCompactLog(cid cidSDK.ID, treeID string, height uint64) error
+	// TreeLogHorizon returns the height below which the log operations are compacted.
+	// If the tree is not found, ErrTreeNotFound should be returned.
+	// If the tree restoration is not finished, ErrTreeRestoring should be returned.
+	TreeLogHorizon(cid cidSDK.ID, treeID string) (uint64, error)
+	// TreeLastSyncHeight returns the height the tree is synchronized up to from all
+	// the container nodes. If the tree is not found, ErrTreeNotFound should be returned.
+	TreeLastSyncHeight(cid cidSDK.ID, treeID string) (uint64, error)
+	// TreeUpdateLastSyncHeight updates the height the tree is synchronized up to.
+	// If the tree is not found, ErrTreeNotFound should be returned.
+	TreeUpdateLastSyncHeight(cid cidSDK.ID, treeID string, height uint64) error
+	// TreeSnapshot calls f for the batches of the tree nodes in the current state. The height
+	// passed to f is the height the log synchronization must be continued from after
+	// the snapshot is restored. f is called at least once, nodes must not be used after f returns.
+	// If the tree is not found, ErrTreeNotFound should be returned.
+	TreeSnapshot(cid cidSDK.ID, treeID string, f func(height uint64, nodes []SnapshotNode) error) error
+	// TreeRestore creates the tree from the snapshot taken at the height. Nodes are
+	// fetched with next until it returns io.EOF. Operations below the height are ignored
+	// afterwards. The existing tree is replaced, the tree is removed if the restoration fails.
+	TreeRestore(d CIDDescriptor, treeID string, height uint64, next func() ([]SnapshotNode, error)) error
 }
 
 type ForestStorage interface {
@@ -0,0 +1,417 @@
+package pilorama
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"go.etcd.io/bbolt"
+)
+
+// ListChildrenPrm groups the parameters of TreeListChildren operation.
+type ListChildrenPrm struct {
+	// SortBy is the attribute children are sorted by in ascending order
+	// of the attribute value. Children without the attribute are skipped.
+	// Currently, the only attribute allowed is AttributeFilename.
+	// If empty, children are returned in the order of their IDs.
+	SortBy string
+	// Prefix filters out children with SortBy attribute value not
+	// starting with the prefix. Requires SortBy to be set.
+	Prefix string
+	// StartAfter skips children with SortBy attribute value less or
+	// equal to it. Requires SortBy to be set. Ignored if Cursor is set.
+	StartAfter string
+	// Cursor is the value returned by the previous call, the listing
+	// is continued after the last returned child.
+	Cursor []byte
+	// Limit is the maximum number of returned children. Zero means no limit.
+	Limit int
+}
+
+var (
+	// ErrUnsupportedSortAttribute is returned when the children are requested
+	// to be sorted by the attribute which is not indexed.
+	ErrUnsupportedSortAttribute = logicerr.New("children can't be sorted by the attribute")
+
+	errFilterWithoutSort = logicerr.New("prefix and start-after filters require sorting attribute")
+)
+
+func (p ListChildrenPrm) validate() error {
+	if p.SortBy == "" {
+		if p.Prefix != "" || p.StartAfter != "" {
+			return errFilterWithoutSort
+		}
+		return nil
+	}
+	if !isAttributeInternal(p.SortBy) {
+		return ErrUnsupportedSortAttribute
+	}
+	return nil
+}
+
+// seekPrefix returns the prefix all the listed keys must have.
+func (p ListChildrenPrm) seekPrefix() []byte {
+	if p.SortBy == "" {
+		return nil
+	}
+	return escapeSortValue(nil, []byte(p.Prefix), false)
+}
+
+// after returns the key suffix the listing starts after.
+func (p ListChildrenPrm) after() []byte {
+	if len(p.Cursor) != 0 {
+		return p.Cursor
+	}
+	if p.StartAfter == "" {
+		return nil
+	}
+	// the highest key with the specified value
+	key := escapeSortValue(nil, []byte(p.StartAfter), true)
+	return append(key, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF)
+}
+
+// indexVersionKey marks the trees for which the sorted children index is built.
+var indexVersionKey = []byte{'v'}
+
+// 'l' + parent (id) + attribute name (string) + escaped attribute value + node (id, big-endian) -> nil.
+// Node IDs are stored in big-endian to keep the nodes with the same value sorted.
+func sortedChildKey(key []byte, attr string, value []byte, parent, node Node) []byte {
+	key = sortedChildPrefix(key, attr, parent)
+	key = escapeSortValue(key, value, true)
+
+	var raw [8]byte
+	binary.BigEndian.PutUint64(raw[:], node)
+	return append(key, raw[:]...)
+}
+
+// sortedChildPrefix returns the common prefix of the sortedChildKey keys of the parent children.
+func sortedChildPrefix(key []byte, attr string, parent Node) []byte {
+	key = append(key[:0], 'l')
+
+	var raw [8]byte
+	binary.LittleEndian.PutUint64(raw[:], parent)
+	key = append(key, raw[:]...)
+
+	l := len(attr)
+	key = append(key, byte(l), byte(l>>8))
+	return append(key, attr...)
+}
+
+// escapeSortValue appends the value to the key keeping the lexicographical
+// order of the values: zero bytes are escaped with 0x00 0xFF and the value
+// is terminated with 0x00 0x00 if terminate is true.
+func escapeSortValue(key []byte, value []byte, terminate bool) []byte {
+	for i := range value {
+		key = append(key, value[i])
+		if value[i] == 0 {
+			key = append(key, 0xFF)
+		}
+	}
+	if terminate {
+		key = append(key, 0, 0)
+	}
+	return key
+}
+
+// childEntry is a child node along with the key it is sorted by.
+type childEntry struct {
+	key  []byte
+	node Node
+}
+
+// listEntries returns a page of children from the unordered list of entries.
+// It is used when the index is not available.
+func listEntries(entries []childEntry, prm ListChildrenPrm) ([]Node, []byte) {
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	prefix := prm.seekPrefix()
+	after := prm.after()
+
+	var (
+		res  []Node
+		last []byte
+	)
+
+	for i := range entries {
+		if !bytes.HasPrefix(entries[i].key, prefix) ||
+			after != nil && bytes.Compare(entries[i].key, after) <= 0 {
+			continue
+		}
+		if prm.Limit > 0 && len(res) == prm.Limit {
+			return res, last
+		}
+		res = append(res, entries[i].node)
+		last = entries[i].key
+	}
+	return res, nil
+}
+
+// unsortedEntryKey returns the key the child is listed by if sorting is not requested.
+func unsortedEntryKey(node Node) []byte {
+	key := make([]byte, 8)
+	binary.LittleEndian.PutUint64(key, node)
+	return key
+}
+
+// sortedEntryKey returns the key the child is listed by if it is sorted by the attribute value.
+func sortedEntryKey(value []byte, node Node) []byte {
+	key := escapeSortValue(nil, value, true)
+
+	var raw [8]byte
+	binary.BigEndian.PutUint64(raw[:], node)
+	return append(key, raw[:]...)
+}
+
+// TreeListChildren implements the Forest interface.
+func (t *boltForest) TreeListChildren(cid cidSDK.ID, treeID string, nodeID Node, prm ListChildrenPrm) ([]Node, []byte, error) {
+	if err := prm.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return nil, nil, ErrDegradedMode
+	}
+
+	var (
+		res  []Node
+		next []byte
+	)
+
+	err := t.db.View(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(bucketName(cid, treeID))
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		b := treeRoot.Bucket(dataBucket)
+
+		var prefix []byte
+		if prm.SortBy == "" {
+			prefix = childrenKey(make([]byte, 17), 0, nodeID)[:9]
+		} else if b.Get(indexVersionKey) != nil {
+			prefix = sortedChildPrefix(nil, prm.SortBy, nodeID)
+		} else {
+			// the index is being built in the background
+			res, next = t.listUnindexed(b, nodeID, prm)
+			return nil
+		}
+
+		res, next = listIndexed(b.Cursor(), prefix, prm)
+		return nil
+	})
+
+	return res, next, err
+}
+
+// listIndexed lists the children using the index keys with the specified prefix.
+// Child ID is stored in the last 8 bytes of the key.
+func listIndexed(c *bbolt.Cursor, prefix []byte, prm ListChildrenPrm) ([]Node, []byte) {
+	seek := append(append([]byte(nil), prefix...), prm.seekPrefix()...)
+
+	k, _ := c.Seek(seek)
+	if after := prm.after(); after != nil {
+		afterKey := append(append([]byte(nil), prefix...), after...)
+		if bytes.Compare(afterKey, seek) >= 0 {
+			k, _ = c.Seek(afterKey)
+			if bytes.Equal(k, afterKey) {
+				k, _ = c.Next()
+			}
+		}
+	}
+
+	var (
+		res  []Node
+		last []byte
+	)
+
+	for ; k != nil && bytes.HasPrefix(k, seek); k, _ = c.Next() {
+		if prm.Limit > 0 && len(res) == prm.Limit {
+			return res, append([]byte(nil), last[len(prefix):]...)
+		}
+
+		if prm.SortBy == "" {
+			res = append(res, binary.LittleEndian.Uint64(k[len(k)-8:]))
+		} else {
+			res = append(res, binary.BigEndian.Uint64(k[len(k)-8:]))
+		}
+		last = k
+	}
+	return res, nil
+}
+
+// listUnindexed lists the children sorted by the attribute value reading their meta.
+func (t *boltForest) listUnindexed(b *bbolt.Bucket, nodeID Node, prm ListChildrenPrm) ([]Node, []byte) {
+	var entries []childEntry
+
+	key := childrenKey(make([]byte, 17), 0, nodeID)[:9]
+	c := b.Cursor()
+	for k, _ := c.Seek(key); len(k) == 17 && binary.LittleEndian.Uint64(k[1:]) == nodeID; k, _ = c.Next() {
+		child := binary.LittleEndian.Uint64(k[9:])
+
+		var m Meta
+		_, _, rawMeta, _ := t.getState(b, stateKey(make([]byte, 9), child))
+		if err := m.FromBytes(rawMeta); err != nil {
+			continue
+		}
+
+		if v := m.GetAttr(prm.SortBy); v != nil {
+			entries = append(entries, childEntry{key: sortedEntryKey(v, child), node: child})
+		}
+	}
+
+	return listEntries(entries, prm)
+}
+
+// defaultIndexBatchSize is the default number of keys indexed in a single transaction.
+const defaultIndexBatchSize = 10000
+
+// buildSortedIndex builds the sorted children index for the trees created
+// before the index was introduced. The index is built in the background
+// tree by tree in small transactions, so it doesn't block the writes.
+// Trees are listed without the index until it is built.
+func (t *boltForest) buildSortedIndex(stop <-chan struct{}) {
+	defer t.indexWg.Done()
+
+	var names [][]byte
+
+	err := t.db.View(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(name []byte, treeRoot *bbolt.Bucket) error {
+			b := treeRoot.Bucket(dataBucket)
+			if b != nil && b.Get(indexVersionKey) == nil {
+				names = append(names, append([]byte(nil), name...))
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return
+	}
+
+	for i := range names {
+		if !t.buildTreeSortedIndex(names[i], stop) {
+			return
+		}
+	}
+}
+
+// buildTreeSortedIndex builds the sorted children index for a single tree.
+// Returns false if the building was interrupted or failed.
+func (t *boltForest) buildTreeSortedIndex(name []byte, stop <-chan struct{}) bool {
+	// the index keys are added along with the 'i' keys by the writers,
+	// so only the keys existing before the index is built are processed
+	next := []byte{'i'}
+
+	for next != nil {
+		select {
+		case <-stop:
+			return false
+		default:
+		}
+
+		err := t.db.Update(func(tx *bbolt.Tx) error {
+			treeRoot := tx.Bucket(name)
+			if treeRoot == nil {
+				// the tree has been dropped
+				next = nil
+				return nil
+			}
+
+			b := treeRoot.Bucket(dataBucket)
+			if b == nil || b.Get(indexVersionKey) != nil {
+				next = nil
+				return nil
+			}
+
+			var keys [][]byte
+
+			c := b.Cursor()
+			k, _ := c.Seek(next)
+			for n := 0; len(k) > 0 && k[0] == 'i' && n < t.indexBatchSize; k, _ = c.Next() {
+				attr, value, parent, node, ok := parseInternalKey(k)
+				if ok {
+					keys = append(keys, sortedChildKey(nil, attr, value, parent, node))
+				}
+				n++
+			}
+
+			if len(k) > 0 && k[0] == 'i' {
+				next = append([]byte(nil), k...)
+			} else {
+				next = nil
+			}
+
+			for i := range keys {
+				if err := b.Put(keys[i], nil); err != nil {
+					return err
+				}
+			}
+
+			if next == nil {
+				return b.Put(indexVersionKey, []byte{1})
+			}
+			return nil
+		})
+		if err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseInternalKey parses the key created with internalKey.
+func parseInternalKey(key []byte) (string, []byte, Node, Node, bool) {
+	key = key[1:]
+	if len(key) < 2 {
+		return "", nil, 0, 0, false
+	}
+
+	l := int(binary.LittleEndian.Uint16(key))
+	if len(key) < 2+l+2 {
+		return "", nil, 0, 0, false
+	}
+	attr := string(key[2 : 2+l])
+	key = key[2+l:]
+
+	l = int(binary.LittleEndian.Uint16(key))
+	if len(key) != 2+l+16 {
+		return "", nil, 0, 0, false
+	}
+	value := key[2 : 2+l]
+	key = key[2+l:]
+
+	return attr, value, binary.LittleEndian.Uint64(key), binary.LittleEndian.Uint64(key[8:]), true
+}
+
+// TreeListChildren implements the Forest interface.
+func (f *memoryForest) TreeListChildren(cid cidSDK.ID, treeID string, nodeID Node, prm ListChildrenPrm) ([]Node, []byte, error) {
+	if err := prm.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return nil, nil, ErrTreeNotFound
+	}
+
+	children := s.childMap[nodeID]
+	entries := make([]childEntry, 0, len(children))
+	for _, child := range children {
+		if prm.SortBy == "" {
+			entries = append(entries, childEntry{key: unsortedEntryKey(child), node: child})
+		} else if v := s.getMeta(child).GetAttr(prm.SortBy); v != nil {
+			entries = append(entries, childEntry{key: sortedEntryKey(v, child), node: child})
+		}
+	}
+
+	res, next := listEntries(entries, prm)
+	return res, next, nil
+}
@@ -0,0 +1,213 @@
+package pilorama
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestForest_TreeListChildren(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeListChildren(t, providers[i].construct(t))
+		})
+	}
+}
+
+func testForestTreeListChildren(t *testing.T, s Forest) {
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	treeAdd := func(t *testing.T, child, parent Node, name string) {
+		var meta []KeyValue
+		if name != "" {
+			meta = []KeyValue{{Key: AttributeFilename, Value: []byte(name)}}
+		}
+		_, err := s.TreeMove(d, treeID, &Move{
+			Parent: parent,
+			Meta:   Meta{Items: meta},
+			Child:  child,
+		})
+		require.NoError(t, err)
+	}
+
+	treeAdd(t, 1, 0, "dir/b")
+	treeAdd(t, 2, 0, "a")
+	treeAdd(t, 3, 0, "dir/a")
+	treeAdd(t, 4, 0, "c")
+	treeAdd(t, 5, 0, "a")
+	treeAdd(t, 6, 0, "a\x00")
+	treeAdd(t, 7, 0, "")
+	treeAdd(t, 8, 1, "nested")
+
+	list := func(t *testing.T, prm ListChildrenPrm) ([]Node, []byte) {
+		res, next, err := s.TreeListChildren(cid, treeID, 0, prm)
+		require.NoError(t, err)
+		return res, next
+	}
+
+	sortByName := ListChildrenPrm{SortBy: AttributeFilename}
+
+	t.Run("sorted", func(t *testing.T) {
+		res, next := list(t, sortByName)
+		require.Equal(t, []Node{2, 5, 6, 4, 3, 1}, res)
+		require.Nil(t, next)
+	})
+	t.Run("unsorted", func(t *testing.T) {
+		res, next := list(t, ListChildrenPrm{})
+		require.ElementsMatch(t, []Node{1, 2, 3, 4, 5, 6, 7}, res)
+		require.Nil(t, next)
+	})
+	t.Run("prefix", func(t *testing.T) {
+		prm := sortByName
+		prm.Prefix = "dir/"
+
+		res, _ := list(t, prm)
+		require.Equal(t, []Node{3, 1}, res)
+	})
+	t.Run("start after", func(t *testing.T) {
+		prm := sortByName
+		prm.StartAfter = "a\x00"
+
+		res, _ := list(t, prm)
+		require.Equal(t, []Node{4, 3, 1}, res)
+
+		prm.Prefix = "dir/"
+		prm.StartAfter = "dir/a"
+
+		res, _ = list(t, prm)
+		require.Equal(t, []Node{1}, res)
+	})
+	t.Run("pages", func(t *testing.T) {
+		for _, prm := range []ListChildrenPrm{sortByName, {}} {
+			expected, _ := list(t, prm)
+
+			var actual []Node
+
+			prm.Limit = 2
+			for {
+				res, next := list(t, prm)
+				require.LessOrEqual(t, len(res), 2)
+
+				actual = append(actual, res...)
+				if next == nil {
+					break
+				}
+				prm.Cursor = next
+			}
+			require.Equal(t, expected, actual)
+		}
+	})
+	t.Run("move", func(t *testing.T) {
+		treeAdd(t, 4, 0, "0")
+		treeAdd(t, 5, TrashID, "a")
+
+		res, _ := list(t, sortByName)
+		require.Equal(t, []Node{4, 2, 6, 3, 1}, res)
+	})
+	t.Run("invalid parameters", func(t *testing.T) {
+		_, _, err := s.TreeListChildren(cid, treeID, 0, ListChildrenPrm{SortBy: AttributeVersion})
+		require.ErrorIs(t, err, ErrUnsupportedSortAttribute)
+
+		_, _, err = s.TreeListChildren(cid, treeID, 0, ListChildrenPrm{Prefix: "dir/"})
+		require.Error(t, err)
+	})
+	t.Run("missing tree", func(t *testing.T) {
+		_, _, err := s.TreeListChildren(cid, treeID+"123", 0, sortByName)
+		require.ErrorIs(t, err, ErrTreeNotFound)
+	})
+}
+
+func TestBoltForest_BuildSortedIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+
+	f := NewBoltForest(WithPath(path), WithMaxBatchSize(1))
+	require.NoError(t, f.Open(false))
+	require.NoError(t, f.Init())
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	for _, name := range []string{"c", "a", "b"} {
+		_, err := f.TreeAddByPath(d, treeID, AttributeFilename, nil,
+			[]KeyValue{{Key: AttributeFilename, Value: []byte(name)}})
+		require.NoError(t, err)
+	}
+
+	expected, _, err := f.TreeListChildren(cid, treeID, 0, ListChildrenPrm{SortBy: AttributeFilename})
+	require.NoError(t, err)
+	require.Len(t, expected, 3)
+
+	// emulate the database created before the index was introduced
+	dropSortedIndex(t, f.(*boltForest), cid, treeID)
+	require.NoError(t, f.Close())
+
+	t.Run("read-only", func(t *testing.T) {
+		require.NoError(t, f.Open(true))
+		require.NoError(t, f.Init())
+
+		actual, _, err := f.TreeListChildren(cid, treeID, 0, ListChildrenPrm{SortBy: AttributeFilename})
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+		require.NoError(t, f.Close())
+	})
+
+	// index a single key per transaction
+	f.(*boltForest).indexBatchSize = 1
+
+	require.NoError(t, f.Open(false))
+	require.NoError(t, f.Init())
+	defer func() { require.NoError(t, f.Close()) }()
+
+	actual, _, err := f.TreeListChildren(cid, treeID, 0, ListChildrenPrm{SortBy: AttributeFilename})
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	require.Eventually(t, func() bool {
+		var built bool
+		require.NoError(t, f.(*boltForest).db.View(func(tx *bbolt.Tx) error {
+			built = tx.Bucket(bucketName(cid, treeID)).Bucket(dataBucket).Get(indexVersionKey) != nil
+			return nil
+		}))
+		return built
+	}, time.Second, 10*time.Millisecond)
+
+	actual, _, err = f.TreeListChildren(cid, treeID, 0, ListChildrenPrm{SortBy: AttributeFilename})
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	t.Run("reopen during building", func(t *testing.T) {
+		dropSortedIndex(t, f.(*boltForest), cid, treeID)
+
+		require.NoError(t, f.Close())
+		require.NoError(t, f.Open(false))
+		require.NoError(t, f.Init())
+
+		actual, _, err := f.TreeListChildren(cid, treeID, 0, ListChildrenPrm{SortBy: AttributeFilename})
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+}
+
+func dropSortedIndex(t *testing.T, f *boltForest, cid cidSDK.ID, treeID string) {
+	require.NoError(t, f.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(bucketName(cid, treeID)).Bucket(dataBucket)
+
+		var keys [][]byte
+		c := b.Cursor()
+		for k, _ := c.Seek([]byte{'l'}); len(k) > 0 && k[0] == 'l'; k, _ = c.Next() {
+			keys = append(keys, append([]byte(nil), k...))
+		}
+		for i := range keys {
+			require.NoError(t, b.Delete(keys[i]))
+		}
+		return b.Delete(indexVersionKey)
+	}))
+}
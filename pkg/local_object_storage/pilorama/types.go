@@ -48,6 +48,12 @@ var (
 	// ErrNotPathAttribute is returned when the path is trying to be constructed with a non-internal
 	// attribute. Currently the only attribute allowed is AttributeFilename.
 	ErrNotPathAttribute = logicerr.New("attribute can't be used in path construction")
+	// ErrLogCompacted is returned when the requested log operations have been
+	// removed during the log compaction.
+	ErrLogCompacted = logicerr.New("operation log is compacted")
+	// ErrTreeRestoring is returned when the tree is being restored from a snapshot
+	// and can't be modified.
+	ErrTreeRestoring = logicerr.New("tree is being restored from a snapshot")
 )
 
 // isAttributeInternal returns true iff key can be used in `*ByPath` methods.
@@ -137,8 +137,11 @@ func (s *Shard) Init() error {
 	s.gc = &gc{
 		gcCfg:       &s.gcCfg,
 		remover:     s.removeGarbage,
+		fullPass:    s.runFullGC,
 		stopChannel: make(chan struct{}),
 		eventChan:   make(chan Event),
+		triggerChan: make(chan struct{}, 1),
+		resetChan:   make(chan struct{}, 1),
 		mEventHandler: map[eventType]*eventHandlers{
 			eventNewEpoch: {
 				cancelFunc: func() {},
@@ -153,6 +156,8 @@ func (s *Shard) Init() error {
 
 	s.gc.init()
 
+	s.startTiering()
+
 	return nil
 }
 
@@ -243,6 +248,10 @@ func (s *Shard) refillMetabase() error {
 
 // Close releases all Shard's components.
 func (s *Shard) Close() error {
+	s.stopRebuild()
+	s.StopScrub()
+	s.stopTiering()
+
 	components := []interface{ Close() error }{}
 
 	if s.pilorama != nil {
@@ -284,6 +293,9 @@ func (s *Shard) Reload(opts ...Option) error {
 	s.m.Lock()
 	defer s.m.Unlock()
 
+	s.ioLimits = c.ioLimits
+	s.ioLimiter.setLimits(c.ioLimits)
+
 	ok, err := s.metaBase.Reload(c.metaOpts...)
 	if err != nil {
 		if errors.Is(err, meta.ErrDegradedMode) {
@@ -1,6 +1,7 @@
 package shard
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -113,7 +114,7 @@ func TestRefillMetabaseCorrupted(t *testing.T) {
 
 	var putPrm PutPrm
 	putPrm.SetObject(obj)
-	_, err := sh.Put(putPrm)
+	_, err := sh.Put(context.Background(), putPrm)
 	require.NoError(t, err)
 	require.NoError(t, sh.Close())
 
@@ -131,7 +132,7 @@ func TestRefillMetabaseCorrupted(t *testing.T) {
 
 	var getPrm GetPrm
 	getPrm.SetAddress(addr)
-	_, err = sh.Get(getPrm)
+	_, err = sh.Get(context.Background(), getPrm)
 	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	require.NoError(t, sh.Close())
 }
@@ -218,13 +219,13 @@ func TestRefillMetabase(t *testing.T) {
 	for _, v := range mObjs {
 		putPrm.SetObject(v.obj)
 
-		_, err := sh.Put(putPrm)
+		_, err := sh.Put(context.Background(), putPrm)
 		require.NoError(t, err)
 	}
 
 	putPrm.SetObject(tombObj)
 
-	_, err = sh.Put(putPrm)
+	_, err = sh.Put(context.Background(), putPrm)
 	require.NoError(t, err)
 
 	// LOCK object handling
@@ -236,7 +237,7 @@ func TestRefillMetabase(t *testing.T) {
 	objectSDK.WriteLock(lockObj, lock)
 
 	putPrm.SetObject(lockObj)
-	_, err = sh.Put(putPrm)
+	_, err = sh.Put(context.Background(), putPrm)
 	require.NoError(t, err)
 
 	lockID, _ := lockObj.ID()
@@ -245,7 +246,7 @@ func TestRefillMetabase(t *testing.T) {
 	var inhumePrm InhumePrm
 	inhumePrm.SetTarget(object.AddressOf(tombObj), tombMembers...)
 
-	_, err = sh.Inhume(inhumePrm)
+	_, err = sh.Inhume(context.Background(), inhumePrm)
 	require.NoError(t, err)
 
 	var headPrm HeadPrm
@@ -253,7 +254,7 @@ func TestRefillMetabase(t *testing.T) {
 	checkObj := func(addr oid.Address, expObj *objectSDK.Object) {
 		headPrm.SetAddress(addr)
 
-		res, err := sh.Head(headPrm)
+		res, err := sh.Head(context.Background(), headPrm)
 
 		if expObj == nil {
 			require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
@@ -278,7 +279,7 @@ func TestRefillMetabase(t *testing.T) {
 		for _, member := range tombMembers {
 			headPrm.SetAddress(member)
 
-			_, err := sh.Head(headPrm)
+			_, err := sh.Head(context.Background(), headPrm)
 
 			if exists {
 				require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
@@ -298,7 +299,7 @@ func TestRefillMetabase(t *testing.T) {
 			var prm InhumePrm
 			prm.MarkAsGarbage(addr)
 
-			_, err := sh.Inhume(prm)
+			_, err := sh.Inhume(context.Background(), prm)
 			require.ErrorAs(t, err, new(apistatus.ObjectLocked),
 				"object %s should be locked", locked[i])
 		}
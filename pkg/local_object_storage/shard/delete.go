@@ -1,12 +1,16 @@
 package shard
 
 import (
+	"context"
 	"errors"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -27,7 +31,22 @@ func (p *DeletePrm) SetAddresses(addr ...oid.Address) {
 
 // Delete removes data from the shard's writeCache, metaBase and
 // blobStor.
-func (s *Shard) Delete(prm DeletePrm) (DeleteRes, error) {
+func (s *Shard) Delete(ctx context.Context, prm DeletePrm) (DeleteRes, error) {
+	defer s.observeDuration("Delete")()
+
+	_, span := tracing.StartSpanFromContext(ctx, "Shard.Delete",
+		trace.WithAttributes(
+			attribute.String("shard_id", s.idString()),
+			attribute.Int("address_count", len(prm.addr)),
+		))
+	defer span.End()
+
+	done, err := s.waitIO(ctx, len(prm.addr))
+	if err != nil {
+		return DeleteRes{}, err
+	}
+	defer done()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -41,6 +60,11 @@ func (s *Shard) delete(prm DeletePrm) (DeleteRes, error) {
 		return DeleteRes{}, ErrDegradedMode
 	}
 
+	// the object moved to the cold sub-storage concurrently
+	// would be left there unreferenced
+	s.tierer.moveMtx.RLock()
+	defer s.tierer.moveMtx.RUnlock()
+
 	ln := len(prm.addr)
 
 	smalls := make(map[oid.Address][]byte, ln)
@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
@@ -41,16 +42,16 @@ func testShardDelete(t *testing.T, hasWriteCache bool) {
 		var delPrm shard.DeletePrm
 		delPrm.SetAddresses(object.AddressOf(obj))
 
-		_, err := sh.Put(putPrm)
+		_, err := sh.Put(context.Background(), putPrm)
 		require.NoError(t, err)
 
 		_, err = testGet(t, sh, getPrm, hasWriteCache)
 		require.NoError(t, err)
 
-		_, err = sh.Delete(delPrm)
+		_, err = sh.Delete(context.Background(), delPrm)
 		require.NoError(t, err)
 
-		_, err = sh.Get(getPrm)
+		_, err = sh.Get(context.Background(), getPrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 
@@ -65,16 +66,16 @@ func testShardDelete(t *testing.T, hasWriteCache bool) {
 		var delPrm shard.DeletePrm
 		delPrm.SetAddresses(object.AddressOf(obj))
 
-		_, err := sh.Put(putPrm)
+		_, err := sh.Put(context.Background(), putPrm)
 		require.NoError(t, err)
 
-		_, err = sh.Get(getPrm)
+		_, err = sh.Get(context.Background(), getPrm)
 		require.NoError(t, err)
 
-		_, err = sh.Delete(delPrm)
+		_, err = sh.Delete(context.Background(), delPrm)
 		require.NoError(t, err)
 
-		_, err = sh.Get(getPrm)
+		_, err = sh.Get(context.Background(), getPrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 }
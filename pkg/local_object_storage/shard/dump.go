@@ -1,22 +1,90 @@
 package shard
 
 import (
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/klauspost/compress/zstd"
+	"go.uber.org/zap"
 )
 
+// dumpMagic starts the dumps of the first version which are the plain
+// sequences of the length-prefixed objects. Such dumps are only restored.
 var dumpMagic = []byte("NEOF")
 
+// dumpMagicV2 starts the versioned dumps. The magic is followed by a single
+// byte of the format version and a single byte of the compression type.
+// The rest of the stream (compressed if requested) is a sequence of records,
+// each record is a single byte of the record type, 4-byte little-endian
+// payload length and the payload:
+//   - manifest: JSON-encoded dumpManifest, always the first record;
+//   - object: SHA-256 checksum of the object followed by the marshaled object;
+//   - grave: address of the object followed by the address of its tombstone;
+//   - garbage: address of the object marked as garbage;
+//   - lock: address of the locked object followed by the ID of the LOCK object;
+//   - end: 8-byte little-endian numbers of the object and the metadata records,
+//     always the last record.
+var dumpMagicV2 = []byte("FDMP")
+
+const dumpVersion = 2
+
+// Compression types of the dump.
+const (
+	dumpCompressionNone byte = iota
+	dumpCompressionZstd
+)
+
+// Types of the dump records.
+const (
+	dumpRecordManifest byte = iota + 1
+	dumpRecordObject
+	dumpRecordGrave
+	dumpRecordGarbage
+	dumpRecordLock
+	dumpRecordEnd
+)
+
+// addressSize is the size of the binary encoded object address.
+const addressSize = 2 * sha256.Size
+
+// dumpManifest describes the dump contents.
+type dumpManifest struct {
+	// ShardID is the ID of the dumped shard.
+	ShardID string `json:"shard_id"`
+	// CreatedAt is the Unix timestamp of the dump start.
+	CreatedAt int64 `json:"created_at"`
+	// Checkpoint allows to dump the objects put after this dump,
+	// zero if the dump was made without the metabase.
+	Checkpoint uint64 `json:"checkpoint"`
+	// Since is the checkpoint of the base dump, zero for the full dumps.
+	Since uint64 `json:"since,omitempty"`
+	// Graves is the number of the objects covered with tombstones.
+	Graves int `json:"graves"`
+	// Garbage is the number of the objects marked as garbage.
+	Garbage int `json:"garbage"`
+	// Locks is the number of the object locks.
+	Locks int `json:"locks"`
+}
+
 // DumpPrm groups the parameters of Dump operation.
 type DumpPrm struct {
 	path         string
 	stream       io.Writer
 	ignoreErrors bool
+	compress     bool
+	base         string
 }
 
 // WithPath is an Dump option to set the destination path.
@@ -36,9 +104,22 @@ func (p *DumpPrm) WithIgnoreErrors(ignore bool) {
 	p.ignoreErrors = ignore
 }
 
+// WithCompression is a Dump option to compress the dump with zstd.
+func (p *DumpPrm) WithCompression(compress bool) {
+	p.compress = compress
+}
+
+// WithBase is a Dump option to make an incremental dump which contains
+// only the objects put after the dump at the specified path was made.
+// The base dump must be made from the same shard.
+func (p *DumpPrm) WithBase(path string) {
+	p.base = path
+}
+
 // DumpRes groups the result fields of Dump operation.
 type DumpRes struct {
-	count int
+	count      int
+	checkpoint uint64
 }
 
 // Count return amount of object written.
@@ -46,19 +127,55 @@ func (r DumpRes) Count() int {
 	return r.count
 }
 
+// Checkpoint returns the checkpoint of the dump, zero
+// if the dump can't be used as a base one.
+func (r DumpRes) Checkpoint() uint64 {
+	return r.checkpoint
+}
+
 var ErrMustBeReadOnly = logicerr.New("shard must be in read-only mode")
 
+// ErrInvalidBaseDump is returned when the base dump can't be used
+// for the incremental dump.
+var ErrInvalidBaseDump = logicerr.New("invalid base dump")
+
 // Dump dumps all objects from the shard to a file or stream.
 //
+// If the metabase is available, the dump is made from its consistent
+// snapshot and includes graveyard, garbage marks and locks, so the shard
+// may stay in read-write mode. Objects are not removed by the garbage
+// collector during the dump. Without the metabase the shard must be
+// read-only and only the objects are dumped.
+//
 // Returns any error encountered.
 func (s *Shard) Dump(prm DumpPrm) (DumpRes, error) {
 	s.m.RLock()
 	defer s.m.RUnlock()
 
-	if !s.info.Mode.ReadOnly() {
+	noMeta := s.info.Mode.NoMetabase()
+	if noMeta && !s.info.Mode.ReadOnly() {
 		return DumpRes{}, ErrMustBeReadOnly
 	}
 
+	var since uint64
+	if prm.base != "" {
+		if noMeta {
+			return DumpRes{}, ErrDegradedMode
+		}
+
+		man, err := readDumpManifest(prm.base)
+		if err != nil {
+			return DumpRes{}, fmt.Errorf("%w: %v", ErrInvalidBaseDump, err)
+		}
+		if man.ShardID != s.idString() {
+			return DumpRes{}, fmt.Errorf("%w: dump of shard %s", ErrInvalidBaseDump, man.ShardID)
+		}
+		if man.Checkpoint == 0 {
+			return DumpRes{}, fmt.Errorf("%w: dump has no checkpoint", ErrInvalidBaseDump)
+		}
+		since = man.Checkpoint
+	}
+
 	w := prm.stream
 	if w == nil {
 		f, err := os.OpenFile(prm.path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0640)
@@ -70,31 +187,173 @@ func (s *Shard) Dump(prm DumpPrm) (DumpRes, error) {
 		w = f
 	}
 
-	_, err := w.Write(dumpMagic)
+	compression := dumpCompressionNone
+	if prm.compress {
+		compression = dumpCompressionZstd
+	}
+
+	_, err := w.Write(append(dumpMagicV2, dumpVersion, compression))
 	if err != nil {
 		return DumpRes{}, err
 	}
 
-	var count int
+	var zw *zstd.Encoder
+	if prm.compress {
+		zw, err = zstd.NewWriter(w)
+		if err != nil {
+			return DumpRes{}, err
+		}
+		defer zw.Close()
 
-	if s.hasWriteCache() {
-		var iterPrm writecache.IterationPrm
+		w = zw
+	}
 
-		iterPrm.WithIgnoreErrors(prm.ignoreErrors)
-		iterPrm.WithHandler(func(data []byte) error {
-			var size [4]byte
-			binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-			if _, err := w.Write(size[:]); err != nil {
-				return err
-			}
+	d := &dumpWriter{w: w}
+
+	var res DumpRes
+	if noMeta {
+		res, err = s.dumpStorage(d, prm)
+	} else {
+		res, err = s.dumpSnapshot(d, prm, since)
+	}
+	if err != nil {
+		return DumpRes{}, err
+	}
+
+	if zw != nil {
+		if err := zw.Close(); err != nil {
+			return DumpRes{}, err
+		}
+	}
 
-			if _, err := w.Write(data); err != nil {
-				return err
+	return res, nil
+}
+
+// dumpSnapshot dumps the objects and the metadata from the metabase snapshot.
+func (s *Shard) dumpSnapshot(d *dumpWriter, prm DumpPrm, since uint64) (DumpRes, error) {
+	// objects from the snapshot must not be removed until they are read
+	s.dumpMtx.RLock()
+	defer s.dumpMtx.RUnlock()
+
+	var snapPrm meta.SnapshotPrm
+	snapPrm.SetSince(since)
+
+	snap, err := s.metaBase.Snapshot(snapPrm)
+	if err != nil {
+		return DumpRes{}, fmt.Errorf("could not get metabase snapshot: %w", err)
+	}
+
+	err = d.writeManifest(dumpManifest{
+		ShardID:    s.idString(),
+		CreatedAt:  time.Now().Unix(),
+		Checkpoint: snap.Checkpoint(),
+		Since:      since,
+		Graves:     len(snap.Graveyard()),
+		Garbage:    len(snap.Garbage()),
+		Locks:      len(snap.Locked()),
+	})
+	if err != nil {
+		return DumpRes{}, err
+	}
+
+	for _, obj := range snap.Objects() {
+		data, err := s.readDumpObject(obj)
+		if err != nil {
+			if IsErrNotFound(err) {
+				s.log.Debug("object is removed during the dump",
+					zap.Stringer("addr", obj.Address()))
+				continue
+			}
+			if prm.ignoreErrors {
+				s.log.Warn("could not read object for the dump",
+					zap.Stringer("addr", obj.Address()),
+					zap.Error(err))
+				continue
 			}
+			return DumpRes{}, fmt.Errorf("could not read object %s: %w", obj.Address(), err)
+		}
+
+		if err := d.writeObject(data); err != nil {
+			return DumpRes{}, err
+		}
+	}
+
+	key := make([]byte, 2*addressSize)
+	for _, g := range snap.Graveyard() {
+		encodeAddress(key, g.Address())
+		encodeAddress(key[addressSize:], g.Tombstone())
+		if err := d.writeMeta(dumpRecordGrave, key); err != nil {
+			return DumpRes{}, err
+		}
+	}
+	for _, addr := range snap.Garbage() {
+		encodeAddress(key, addr)
+		if err := d.writeMeta(dumpRecordGarbage, key[:addressSize]); err != nil {
+			return DumpRes{}, err
+		}
+	}
+	for _, l := range snap.Locked() {
+		encodeAddress(key, l.Address())
+		l.Locker().Encode(key[addressSize:])
+		if err := d.writeMeta(dumpRecordLock, key[:addressSize+sha256.Size]); err != nil {
+			return DumpRes{}, err
+		}
+	}
+
+	if err := d.writeEnd(); err != nil {
+		return DumpRes{}, err
+	}
+
+	return DumpRes{count: d.objects, checkpoint: snap.Checkpoint()}, nil
+}
+
+// readDumpObject reads the object from the write-cache or the blobstor.
+func (s *Shard) readDumpObject(obj meta.SnapshotObject) ([]byte, error) {
+	var (
+		res *objectSDK.Object
+		err error
+	)
+
+	if s.hasWriteCache() {
+		res, err = s.writeCache.Get(obj.Address())
+		if err == nil {
+			return res.Marshal()
+		}
+	}
+
+	var getPrm common.GetPrm
+	getPrm.Address = obj.Address()
+	getPrm.StorageID = obj.StorageID()
+
+	getRes, err := s.blobStor.Get(getPrm)
+	if err != nil && getPrm.StorageID != nil && IsErrNotFound(err) {
+		// the object could be moved to another sub-storage after the snapshot
+		getPrm.StorageID = nil
+		getRes, err = s.blobStor.Get(getPrm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return getRes.Object.Marshal()
+}
 
-			count++
-			return nil
-		})
+// dumpStorage dumps all the objects from the write-cache and the blobstor
+// of the read-only shard without the metabase.
+func (s *Shard) dumpStorage(d *dumpWriter, prm DumpPrm) (DumpRes, error) {
+	err := d.writeManifest(dumpManifest{
+		ShardID:   s.idString(),
+		CreatedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		return DumpRes{}, err
+	}
+
+	if s.hasWriteCache() {
+		var iterPrm writecache.IterationPrm
+
+		iterPrm.WithIgnoreErrors(prm.ignoreErrors)
+		iterPrm.WithHandler(d.writeObject)
 
 		err := s.writeCache.Iterate(iterPrm)
 		if err != nil {
@@ -105,25 +364,219 @@ func (s *Shard) Dump(prm DumpPrm) (DumpRes, error) {
 	var pi common.IteratePrm
 	pi.IgnoreErrors = prm.ignoreErrors
 	pi.Handler = func(elem common.IterationElement) error {
-		data := elem.ObjectData
+		return d.writeObject(elem.ObjectData)
+	}
+
+	if _, err := s.blobStor.Iterate(pi); err != nil {
+		return DumpRes{}, err
+	}
+
+	if err := d.writeEnd(); err != nil {
+		return DumpRes{}, err
+	}
+
+	return DumpRes{count: d.objects}, nil
+}
+
+// dumpWriter writes the records of the versioned dump.
+type dumpWriter struct {
+	w io.Writer
+
+	objects int
+	meta    int
+}
 
-		var size [4]byte
-		binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-		if _, err := w.Write(size[:]); err != nil {
+func (d *dumpWriter) writeRecord(typ byte, payload ...[]byte) error {
+	var size int
+	for i := range payload {
+		size += len(payload[i])
+	}
+
+	var hdr [5]byte
+	hdr[0] = typ
+	binary.LittleEndian.PutUint32(hdr[1:], uint32(size))
+	if _, err := d.w.Write(hdr[:]); err != nil {
+		return err
+	}
+
+	for i := range payload {
+		if _, err := d.w.Write(payload[i]); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		if _, err := w.Write(data); err != nil {
-			return err
+func (d *dumpWriter) writeManifest(m dumpManifest) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return d.writeRecord(dumpRecordManifest, data)
+}
+
+func (d *dumpWriter) writeObject(data []byte) error {
+	sum := sha256.Sum256(data)
+	if err := d.writeRecord(dumpRecordObject, sum[:], data); err != nil {
+		return err
+	}
+
+	d.objects++
+	return nil
+}
+
+func (d *dumpWriter) writeMeta(typ byte, payload []byte) error {
+	if err := d.writeRecord(typ, payload); err != nil {
+		return err
+	}
+
+	d.meta++
+	return nil
+}
+
+func (d *dumpWriter) writeEnd() error {
+	var payload [16]byte
+	binary.LittleEndian.PutUint64(payload[:], uint64(d.objects))
+	binary.LittleEndian.PutUint64(payload[8:], uint64(d.meta))
+	return d.writeRecord(dumpRecordEnd, payload[:])
+}
+
+// defaultMaxDumpObjectSize is the maximum object payload size expected in the
+// dump if it is not specified on restore. It is the default maximum object
+// size of the network.
+const defaultMaxDumpObjectSize = 64 << 20
+
+// maxDumpRecordOverhead is the maximum size of the dump record besides the
+// object payload: the checksum and the object header.
+const maxDumpRecordOverhead = 64 << 10
+
+// maxDumpRecordSize returns the maximum size of the dump record
+// with the object of the maximum payload size.
+func maxDumpRecordSize(maxObjectSize uint64) uint64 {
+	if maxObjectSize == 0 {
+		maxObjectSize = defaultMaxDumpObjectSize
+	}
+	return maxObjectSize + maxDumpRecordOverhead
+}
+
+// dumpReader reads the records of the versioned dump.
+type dumpReader struct {
+	r io.Reader
+
+	maxSize uint64
+	data    []byte
+}
+
+// newDumpReader checks the header of the versioned dump which follows
+// the magic and returns the reader of the dump records. Records bigger
+// than maxSize are rejected. The returned function must be called to
+// release the resources.
+func newDumpReader(r io.Reader, maxSize uint64) (*dumpReader, func(), error) {
+	var hdr [2]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, nil, err
+	}
+
+	if hdr[0] != dumpVersion {
+		return nil, nil, fmt.Errorf("%w: %d", ErrUnsupportedDumpVersion, hdr[0])
+	}
+
+	switch hdr[1] {
+	case dumpCompressionNone:
+		return &dumpReader{r: r, maxSize: maxSize}, func() {}, nil
+	case dumpCompressionZstd:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, nil, err
 		}
+		return &dumpReader{r: zr, maxSize: maxSize}, zr.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown dump compression type: %d", hdr[1])
+	}
+}
 
-		count++
-		return nil
+// next reads the next record. The payload is valid until the next call.
+// Returns io.ErrUnexpectedEOF if the dump ends before the end record.
+func (d *dumpReader) next() (byte, []byte, error) {
+	var hdr [5]byte
+	if _, err := io.ReadFull(d.r, hdr[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, nil, io.ErrUnexpectedEOF
+		}
+		return 0, nil, err
 	}
 
-	if _, err := s.blobStor.Iterate(pi); err != nil {
-		return DumpRes{}, err
+	sz := binary.LittleEndian.Uint32(hdr[1:])
+	if uint64(sz) > d.maxSize {
+		return 0, nil, fmt.Errorf("%w: record size %d exceeds the limit %d", ErrInvalidDumpRecord, sz, d.maxSize)
+	}
+	if uint32(cap(d.data)) < sz {
+		d.data = make([]byte, sz)
+	} else {
+		d.data = d.data[:sz]
+	}
+
+	if _, err := io.ReadFull(d.r, d.data); err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, nil, io.ErrUnexpectedEOF
+		}
+		return 0, nil, err
+	}
+
+	return hdr[0], d.data, nil
+}
+
+// readDumpManifest reads the manifest of the versioned dump at the path.
+func readDumpManifest(path string) (dumpManifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return dumpManifest{}, err
+	}
+	defer f.Close()
+
+	m := make([]byte, len(dumpMagicV2))
+	if _, err := io.ReadFull(f, m); err != nil || string(m) != string(dumpMagicV2) {
+		return dumpManifest{}, ErrInvalidMagic
+	}
+
+	d, release, err := newDumpReader(f, maxDumpRecordSize(0))
+	if err != nil {
+		return dumpManifest{}, err
+	}
+	defer release()
+
+	typ, data, err := d.next()
+	if err != nil {
+		return dumpManifest{}, err
+	}
+	if typ != dumpRecordManifest {
+		return dumpManifest{}, fmt.Errorf("%w: manifest is missing", ErrInvalidDumpRecord)
+	}
+
+	var man dumpManifest
+	if err := json.Unmarshal(data, &man); err != nil {
+		return dumpManifest{}, fmt.Errorf("%w: invalid manifest: %v", ErrInvalidDumpRecord, err)
+	}
+	return man, nil
+}
+
+func encodeAddress(dst []byte, addr oid.Address) {
+	addr.Container().Encode(dst)
+	addr.Object().Encode(dst[sha256.Size:])
+}
+
+func decodeAddress(addr *oid.Address, src []byte) error {
+	var obj oid.ID
+	if err := obj.Decode(src[sha256.Size:addressSize]); err != nil {
+		return err
+	}
+
+	cnr := addr.Container()
+	if err := cnr.Decode(src[:sha256.Size]); err != nil {
+		return err
 	}
 
-	return DumpRes{count: count}, nil
+	addr.SetContainer(cnr)
+	addr.SetObject(obj)
+	return nil
 }
@@ -2,6 +2,9 @@ package shard_test
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"io"
 	"math/rand"
 	"os"
@@ -18,11 +21,13 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	objecttest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
 	"github.com/klauspost/compress/zstd"
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 )
@@ -63,12 +68,13 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 	var prm shard.DumpPrm
 	prm.WithPath(out)
 
-	t.Run("must be read-only", func(t *testing.T) {
+	t.Run("degraded mode must be read-only", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(mode.Degraded))
 		_, err := sh.Dump(prm)
 		require.ErrorIs(t, err, shard.ErrMustBeReadOnly)
+		require.NoError(t, sh.SetMode(mode.ReadWrite))
 	})
 
-	require.NoError(t, sh.SetMode(mode.ReadOnly))
 	outEmpty := out + ".empty"
 	var dumpPrm shard.DumpPrm
 	dumpPrm.WithPath(outEmpty)
@@ -76,7 +82,6 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 	res, err := sh.Dump(dumpPrm)
 	require.NoError(t, err)
 	require.Equal(t, 0, res.Count())
-	require.NoError(t, sh.SetMode(mode.ReadWrite))
 
 	// Approximate object header size.
 	const headerSize = 400
@@ -102,12 +107,10 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 
 		var prm shard.PutPrm
 		prm.SetObject(objects[i])
-		_, err := sh.Put(prm)
+		_, err := sh.Put(context.Background(), prm)
 		require.NoError(t, err)
 	}
 
-	require.NoError(t, sh.SetMode(mode.ReadOnly))
-
 	t.Run("invalid path", func(t *testing.T) {
 		var dumpPrm shard.DumpPrm
 		dumpPrm.WithPath("\x00")
@@ -152,10 +155,19 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 			fileData, err := os.ReadFile(out)
 			require.NoError(t, err)
 
-			t.Run("incomplete size", func(t *testing.T) {
+			t.Run("unsupported version", func(t *testing.T) {
+				out := out + ".wrongversion"
+				require.NoError(t, os.WriteFile(out, []byte{'F', 'D', 'M', 'P', 3, 0}, os.ModePerm))
+
+				var restorePrm shard.RestorePrm
+				restorePrm.WithPath(out)
+
+				_, err := sh.Restore(restorePrm)
+				require.ErrorIs(t, err, shard.ErrUnsupportedDumpVersion)
+			})
+			t.Run("incomplete record", func(t *testing.T) {
 				out := out + ".wrongsize"
-				fileData := append(fileData, 1)
-				require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
+				require.NoError(t, os.WriteFile(out, fileData[:len(fileData)-1], os.ModePerm))
 
 				var restorePrm shard.RestorePrm
 				restorePrm.WithPath(out)
@@ -163,44 +175,96 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 				_, err := sh.Restore(restorePrm)
 				require.ErrorIs(t, err, io.ErrUnexpectedEOF)
 			})
-			t.Run("incomplete object data", func(t *testing.T) {
-				out := out + ".wrongsize"
-				fileData := append(fileData, 1, 0, 0, 0)
+			t.Run("missing end record", func(t *testing.T) {
+				out := out + ".noend"
+				require.NoError(t, os.WriteFile(out, fileData[:len(fileData)-21], os.ModePerm))
+
+				var restorePrm shard.RestorePrm
+				restorePrm.WithPath(out)
+
+				_, err := sh.Restore(restorePrm)
+				require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+			})
+			t.Run("record too big", func(t *testing.T) {
+				out := out + ".toobig"
+
+				// magic, version, compression and manifest record
+				off := 6 + 5 + int(binary.LittleEndian.Uint32(fileData[7:]))
+
+				fileData := slice.Copy(fileData)
+				binary.LittleEndian.PutUint32(fileData[off+1:], 1<<30)
 				require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
 
 				var restorePrm shard.RestorePrm
 				restorePrm.WithPath(out)
 
 				_, err := sh.Restore(restorePrm)
-				require.ErrorIs(t, err, io.EOF)
+				require.ErrorIs(t, err, shard.ErrInvalidDumpRecord)
 			})
-			t.Run("invalid object", func(t *testing.T) {
-				out := out + ".wrongobj"
-				fileData := append(fileData, 1, 0, 0, 0, 0xFF, 4, 0, 0, 0, 1, 2, 3, 4)
+			t.Run("checksum mismatch", func(t *testing.T) {
+				out := out + ".wrongsum"
+
+				// magic, version, compression and manifest record
+				off := 6 + 5 + int(binary.LittleEndian.Uint32(fileData[7:]))
+				// first object record header and checksum
+				off += 5 + sha256.Size
+
+				fileData := slice.Copy(fileData)
+				fileData[off] ^= 0xFF
 				require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
 
 				var restorePrm shard.RestorePrm
 				restorePrm.WithPath(out)
 
 				_, err := sh.Restore(restorePrm)
-				require.Error(t, err)
+				require.ErrorIs(t, err, shard.ErrDumpChecksumMismatch)
 
 				t.Run("skip errors", func(t *testing.T) {
 					sh := newCustomShard(t, filepath.Join(t.TempDir(), "ignore"), false, nil, nil)
 					t.Cleanup(func() { require.NoError(t, sh.Close()) })
 
-					var restorePrm shard.RestorePrm
-					restorePrm.WithPath(out)
 					restorePrm.WithIgnoreErrors(true)
 
 					res, err := sh.Restore(restorePrm)
 					require.NoError(t, err)
-					require.Equal(t, objCount, res.Count())
-					require.Equal(t, 2, res.FailCount())
+					require.Equal(t, objCount-1, res.Count())
+					require.Equal(t, 1, res.FailCount())
 				})
 			})
 		})
 
+		t.Run("put error", func(t *testing.T) {
+			// the biggest objects don't fit into the only sub-storage
+			root := filepath.Join(t.TempDir(), "small")
+			sh := newCustomShard(t, root, false, nil, []blobstor.Option{
+				blobstor.WithStorages([]blobstor.SubStorage{{
+					Storage: blobovniczatree.NewBlobovniczaTree(
+						blobovniczatree.WithRootPath(filepath.Join(root, "blobovnicza")),
+						blobovniczatree.WithBlobovniczaShallowDepth(1),
+						blobovniczatree.WithBlobovniczaShallowWidth(1)),
+					Policy: func(_ *objectSDK.Object, data []byte) bool {
+						return len(data) <= 2*bsSmallObjectSize
+					},
+				}}),
+			})
+			t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+			var restorePrm shard.RestorePrm
+			restorePrm.WithPath(out)
+
+			_, err := sh.Restore(restorePrm)
+			require.ErrorIs(t, err, blobstor.ErrNoPlaceFound)
+
+			t.Run("skip errors", func(t *testing.T) {
+				restorePrm.WithIgnoreErrors(true)
+
+				res, err := sh.Restore(restorePrm)
+				require.NoError(t, err)
+				require.Equal(t, objCount-objCount/6, res.Count())
+				require.Equal(t, objCount/6, res.FailCount())
+			})
+		})
+
 		var prm shard.RestorePrm
 		prm.WithPath(out)
 		t.Run("must allow write", func(t *testing.T) {
@@ -232,7 +296,7 @@ func TestStream(t *testing.T) {
 
 		var prm shard.PutPrm
 		prm.SetObject(objects[i])
-		_, err := sh1.Put(prm)
+		_, err := sh1.Put(context.Background(), prm)
 		require.NoError(t, err)
 	}
 
@@ -266,6 +330,206 @@ func TestStream(t *testing.T) {
 	}, time.Second, time.Millisecond)
 }
 
+func TestRestoreLegacy(t *testing.T) {
+	const objCount = 5
+
+	objects := make([]*objectSDK.Object, objCount)
+	fileData := []byte("NEOF")
+	for i := range objects {
+		objects[i] = generateObjectWithCID(t, cidtest.ID())
+
+		data, err := objects[i].Marshal()
+		require.NoError(t, err)
+
+		fileData = binary.LittleEndian.AppendUint32(fileData, uint32(len(data)))
+		fileData = append(fileData, data...)
+	}
+
+	out := filepath.Join(t.TempDir(), "legacy.dump")
+	require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
+
+	sh := newShard(t, false)
+	defer releaseShard(sh, t)
+
+	t.Run("incomplete size", func(t *testing.T) {
+		out := out + ".wrongsize"
+		fileData := append(slice.Copy(fileData), 1)
+		require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
+
+		var restorePrm shard.RestorePrm
+		restorePrm.WithPath(out)
+
+		_, err := sh.Restore(restorePrm)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+	t.Run("incomplete object data", func(t *testing.T) {
+		out := out + ".wrongsize"
+		fileData := append(slice.Copy(fileData), 1, 0, 0, 0)
+		require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
+
+		var restorePrm shard.RestorePrm
+		restorePrm.WithPath(out)
+
+		_, err := sh.Restore(restorePrm)
+		require.ErrorIs(t, err, io.EOF)
+	})
+	t.Run("object too big", func(t *testing.T) {
+		out := out + ".toobig"
+		fileData := append(slice.Copy(fileData), 0xFF, 0xFF, 0xFF, 0xFF)
+		require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
+
+		var restorePrm shard.RestorePrm
+		restorePrm.WithPath(out)
+
+		_, err := sh.Restore(restorePrm)
+		require.ErrorIs(t, err, shard.ErrInvalidDumpRecord)
+	})
+	t.Run("invalid object", func(t *testing.T) {
+		out := out + ".wrongobj"
+		fileData := append(slice.Copy(fileData), 1, 0, 0, 0, 0xFF, 4, 0, 0, 0, 1, 2, 3, 4)
+		require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
+
+		var restorePrm shard.RestorePrm
+		restorePrm.WithPath(out)
+
+		_, err := sh.Restore(restorePrm)
+		require.Error(t, err)
+
+		t.Run("skip errors", func(t *testing.T) {
+			sh := newCustomShard(t, filepath.Join(t.TempDir(), "ignore"), false, nil, nil)
+			t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+			var restorePrm shard.RestorePrm
+			restorePrm.WithPath(out)
+			restorePrm.WithIgnoreErrors(true)
+
+			res, err := sh.Restore(restorePrm)
+			require.NoError(t, err)
+			require.Equal(t, objCount, res.Count())
+			require.Equal(t, 2, res.FailCount())
+		})
+	})
+
+	var restorePrm shard.RestorePrm
+	restorePrm.WithPath(out)
+
+	checkRestore(t, sh, restorePrm, objects)
+}
+
+func TestDumpIncremental(t *testing.T) {
+	t.Run("uncompressed", func(t *testing.T) {
+		testDumpIncremental(t, false)
+	})
+	t.Run("compressed", func(t *testing.T) {
+		testDumpIncremental(t, true)
+	})
+}
+
+func testDumpIncremental(t *testing.T, compress bool) {
+	sh := newShard(t, false)
+	defer releaseShard(sh, t)
+
+	put := func(n int) []*objectSDK.Object {
+		objs := make([]*objectSDK.Object, n)
+		for i := range objs {
+			objs[i] = generateObjectWithCID(t, cidtest.ID())
+
+			var prm shard.PutPrm
+			prm.SetObject(objs[i])
+			_, err := sh.Put(context.Background(), prm)
+			require.NoError(t, err)
+		}
+		return objs
+	}
+
+	dir := t.TempDir()
+	dump := func(path, base string) shard.DumpRes {
+		var prm shard.DumpPrm
+		prm.WithPath(path)
+		prm.WithBase(base)
+		prm.WithCompression(compress)
+
+		res, err := sh.Dump(prm)
+		require.NoError(t, err)
+		return res
+	}
+
+	full := put(3)
+	fullPath := filepath.Join(dir, "full")
+	fullRes := dump(fullPath, "")
+	require.Equal(t, len(full), fullRes.Count())
+	require.NotZero(t, fullRes.Checkpoint())
+
+	incr := put(2)
+
+	removed := object.AddressOf(full[0])
+	var inhumePrm shard.InhumePrm
+	inhumePrm.SetTarget(objecttest.Address(), removed)
+	_, err := sh.Inhume(context.Background(), inhumePrm)
+	require.NoError(t, err)
+
+	locked := object.AddressOf(full[1])
+	require.NoError(t, sh.Lock(locked.Container(), objecttest.ID(), []oid.ID{locked.Object()}))
+
+	incrPath := filepath.Join(dir, "incr")
+	incrRes := dump(incrPath, fullPath)
+	require.Equal(t, len(incr), incrRes.Count())
+	require.Greater(t, incrRes.Checkpoint(), fullRes.Checkpoint())
+
+	t.Run("invalid base dump", func(t *testing.T) {
+		base := filepath.Join(dir, "invalid")
+		require.NoError(t, os.WriteFile(base, []byte("NEOF"), os.ModePerm))
+
+		var prm shard.DumpPrm
+		prm.WithPath(filepath.Join(dir, "other"))
+		prm.WithBase(base)
+
+		_, err := sh.Dump(prm)
+		require.ErrorIs(t, err, shard.ErrInvalidBaseDump)
+	})
+
+	restored := newShard(t, false)
+	defer releaseShard(restored, t)
+
+	var restorePrm shard.RestorePrm
+	restorePrm.WithPath(fullPath)
+	checkRestore(t, restored, restorePrm, full)
+
+	restorePrm.WithPath(incrPath)
+	checkRestore(t, restored, restorePrm, incr)
+
+	var getPrm shard.GetPrm
+	getPrm.SetAddress(removed)
+	_, err = restored.Get(context.Background(), getPrm)
+	require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+
+	isLocked, err := restored.IsLocked(locked)
+	require.NoError(t, err)
+	require.True(t, isLocked)
+
+	t.Run("skip metadata errors", func(t *testing.T) {
+		sh := newCustomShard(t, filepath.Join(t.TempDir(), "ignore"), false, nil, nil)
+		t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+		var restorePrm shard.RestorePrm
+		restorePrm.WithPath(fullPath)
+		checkRestore(t, sh, restorePrm, full)
+
+		// locked object can't be removed
+		require.NoError(t, sh.Lock(removed.Container(), objecttest.ID(), []oid.ID{removed.Object()}))
+
+		restorePrm.WithPath(incrPath)
+		_, err := sh.Restore(restorePrm)
+		require.ErrorAs(t, err, new(apistatus.ObjectLocked))
+
+		restorePrm.WithIgnoreErrors(true)
+		res, err := sh.Restore(restorePrm)
+		require.NoError(t, err)
+		require.Equal(t, len(incr), res.Count())
+		require.Equal(t, 1, res.FailCount())
+	})
+}
+
 func checkRestore(t *testing.T, sh *shard.Shard, prm shard.RestorePrm, objects []*objectSDK.Object) {
 	res, err := sh.Restore(prm)
 	require.NoError(t, err)
@@ -275,7 +539,7 @@ func checkRestore(t *testing.T, sh *shard.Shard, prm shard.RestorePrm, objects [
 
 	for i := range objects {
 		getPrm.SetAddress(object.AddressOf(objects[i]))
-		res, err := sh.Get(getPrm)
+		res, err := sh.Get(context.Background(), getPrm)
 		require.NoError(t, err)
 		require.Equal(t, objects[i], res.Object())
 	}
@@ -331,7 +595,7 @@ func TestDumpIgnoreErrors(t *testing.T) {
 
 		var prm shard.PutPrm
 		prm.SetObject(objects[i])
-		_, err := sh.Put(prm)
+		_, err := sh.Put(context.Background(), prm)
 		require.NoError(t, err)
 	}
 
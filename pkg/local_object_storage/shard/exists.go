@@ -1,9 +1,14 @@
 package shard
 
 import (
+	"context"
+
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ExistsPrm groups the parameters of Exists operation.
@@ -33,7 +38,16 @@ func (p ExistsRes) Exists() bool {
 //
 // Returns an error of type apistatus.ObjectAlreadyRemoved if object has been marked as removed.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Exists(prm ExistsPrm) (ExistsRes, error) {
+func (s *Shard) Exists(ctx context.Context, prm ExistsPrm) (ExistsRes, error) {
+	defer s.observeDuration("Exists")()
+
+	_, span := tracing.StartSpanFromContext(ctx, "Shard.Exists",
+		trace.WithAttributes(
+			attribute.String("shard_id", s.idString()),
+			attribute.String("address", prm.addr.EncodeToString()),
+		))
+	defer span.End()
+
 	var exists bool
 	var err error
 
@@ -37,6 +37,10 @@ const (
 
 type newEpoch struct {
 	epoch uint64
+
+	// handled is closed when all the handlers of the manually
+	// triggered event are finished, nil for the regular events
+	handled chan struct{}
 }
 
 func (e newEpoch) typ() eventType {
@@ -69,10 +73,19 @@ type gc struct {
 
 	workerPool util.WorkerPool
 
-	remover func()
+	remover func() bool
+
+	// fullPass performs the manually triggered garbage collection.
+	fullPass func()
 
 	eventChan     chan Event
 	mEventHandler map[eventType]*eventHandlers
+
+	triggerChan chan struct{}
+	resetChan   chan struct{}
+
+	stateMtx sync.RWMutex
+	state    GCStatus
 }
 
 type gcCfg struct {
@@ -119,8 +132,26 @@ func (gc *gc) listenEvents() {
 			return
 		}
 
+		var handled chan struct{}
+
+		gc.stateMtx.Lock()
+		if e, ok := event.(newEpoch); ok {
+			gc.state.lastEpoch = e.epoch
+			handled = e.handled
+		}
+		paused := gc.state.paused
+		gc.stateMtx.Unlock()
+
+		// manually triggered events are handled even if GC is paused
+		if paused && handled == nil {
+			continue
+		}
+
 		v, ok := gc.mEventHandler[event.typ()]
 		if !ok {
+			if handled != nil {
+				close(handled)
+			}
 			continue
 		}
 
@@ -130,7 +161,10 @@ func (gc *gc) listenEvents() {
 		var ctx context.Context
 		ctx, v.cancelFunc = context.WithCancel(context.Background())
 
+		var eventGroup sync.WaitGroup
+
 		v.prevGroup.Add(len(v.handlers))
+		eventGroup.Add(len(v.handlers))
 
 		for i := range v.handlers {
 			h := v.handlers[i]
@@ -138,6 +172,7 @@ func (gc *gc) listenEvents() {
 			err := gc.workerPool.Submit(func() {
 				h(ctx, event)
 				v.prevGroup.Done()
+				eventGroup.Done()
 			})
 			if err != nil {
 				gc.log.Warn("could not submit GC job to worker pool",
@@ -145,15 +180,23 @@ func (gc *gc) listenEvents() {
 				)
 
 				v.prevGroup.Done()
+				eventGroup.Done()
 			}
 		}
+
+		if handled != nil {
+			go func() {
+				eventGroup.Wait()
+				close(handled)
+			}()
+		}
 	}
 }
 
 func (gc *gc) tickRemover() {
 	defer gc.wg.Done()
 
-	timer := time.NewTimer(gc.removerInterval)
+	timer := time.NewTimer(gc.interval())
 	defer timer.Stop()
 
 	for {
@@ -167,31 +210,127 @@ func (gc *gc) tickRemover() {
 
 			gc.log.Debug("GC is stopped")
 			return
+		case <-gc.triggerChan:
+			gc.fullPass()
+			resetTimer(timer, gc.interval())
+		case <-gc.resetChan:
+			resetTimer(timer, gc.interval())
 		case <-timer.C:
-			gc.remover()
-			timer.Reset(gc.removerInterval)
+			if !gc.isPaused() {
+				gc.remover()
+			}
+			timer.Reset(gc.interval())
 		}
 	}
 }
 
+func resetTimer(t *time.Timer, d time.Duration) {
+	if !t.Stop() {
+		select {
+		case <-t.C:
+		default:
+		}
+	}
+	t.Reset(d)
+}
+
 func (gc *gc) stop() {
 	gc.onceStop.Do(func() {
-		gc.stopChannel <- struct{}{}
+		close(gc.stopChannel)
 	})
 
 	gc.log.Info("waiting for GC workers to stop...")
 	gc.wg.Wait()
 }
 
+func (gc *gc) stopped() bool {
+	select {
+	case <-gc.stopChannel:
+		return true
+	default:
+		return false
+	}
+}
+
+func (gc *gc) interval() time.Duration {
+	gc.stateMtx.RLock()
+	defer gc.stateMtx.RUnlock()
+
+	return gc.removerInterval
+}
+
+func (gc *gc) isPaused() bool {
+	gc.stateMtx.RLock()
+	defer gc.stateMtx.RUnlock()
+
+	return gc.state.paused
+}
+
 // iterates over metabase and deletes objects
 // with GC-marked graves.
 // Does nothing if shard is in "read-only" mode.
-func (s *Shard) removeGarbage() {
+//
+// Returns true if the whole batch was removed, so
+// there can be more objects to remove.
+func (s *Shard) removeGarbage() bool {
+	buf := s.collectGarbage()
+
+	defer s.reportGCRun(len(buf) == 0)
+
+	if len(buf) == 0 {
+		return false
+	}
+
+	// wait outside the lock so that the throttled GC does not block mode change
+	done, err := s.waitIO(ContextWithIOClass(context.Background(), IOClassBackground), len(buf))
+	if err != nil {
+		s.log.Warn("could not delete the objects",
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	}
+	defer done()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
 	if s.info.Mode != mode.ReadWrite {
-		return
+		return false
+	}
+
+	if !s.dumpMtx.TryLock() {
+		s.log.Debug("garbage removal is skipped during the shard dump")
+		return false
+	}
+	defer s.dumpMtx.Unlock()
+
+	var deletePrm DeletePrm
+	deletePrm.SetAddresses(buf...)
+
+	// delete accumulated objects
+	_, err = s.delete(deletePrm)
+	if err != nil {
+		s.log.Warn("could not delete the objects",
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	}
+
+	s.addGCObjects(gcRemoved, len(buf))
+
+	return len(buf) == s.rmBatchSize
+}
+
+// collectGarbage returns the addresses of the objects with GC mark,
+// no more than s.rmBatchSize.
+func (s *Shard) collectGarbage() []oid.Address {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode != mode.ReadWrite {
+		return nil
 	}
 
 	buf := make([]oid.Address, 0, s.rmBatchSize)
@@ -215,23 +354,10 @@ func (s *Shard) removeGarbage() {
 			zap.String("error", err.Error()),
 		)
 
-		return
-	} else if len(buf) == 0 {
-		return
+		return nil
 	}
 
-	var deletePrm DeletePrm
-	deletePrm.SetAddresses(buf...)
-
-	// delete accumulated objects
-	_, err = s.delete(deletePrm)
-	if err != nil {
-		s.log.Warn("could not delete the objects",
-			zap.String("error", err.Error()),
-		)
-
-		return
-	}
+	return buf
 }
 
 func (s *Shard) collectExpiredObjects(ctx context.Context, e Event) {
@@ -275,6 +401,12 @@ func (s *Shard) collectExpiredObjects(ctx context.Context, e Event) {
 		s.addToContainerSize(delInfo.CID.EncodeToString(), -int64(delInfo.Size))
 		i++
 	}
+
+	s.addGCObjects(gcExpiredObjects, len(expired))
+
+	if s.expiredObjectsCallback != nil {
+		s.expiredObjectsCallback(ctx, expired)
+	}
 }
 
 func (s *Shard) collectExpiredTombstones(ctx context.Context, e Event) {
@@ -333,6 +465,7 @@ func (s *Shard) collectExpiredTombstones(ctx context.Context, e Event) {
 
 		log.Debug("handling expired tombstones batch", zap.Int("number", len(tssExp)))
 		s.expiredTombstonesCallback(ctx, tssExp)
+		s.addGCObjects(gcExpiredTombstones, len(tssExp))
 
 		iterPrm.SetOffset(tss[tssLen-1].Address())
 		tss = tss[:0]
@@ -354,6 +487,7 @@ func (s *Shard) collectExpiredLocks(ctx context.Context, e Event) {
 	}
 
 	s.expiredLocksCallback(ctx, expired)
+	s.addGCObjects(gcExpiredLocks, len(expired))
 }
 
 func (s *Shard) getExpiredObjects(ctx context.Context, epoch uint64, typeCond func(object.Type) bool) ([]oid.Address, error) {
@@ -0,0 +1,261 @@
+package shard
+
+import (
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"go.uber.org/zap"
+)
+
+// ErrGCNotInitialized is returned when the garbage collector of the shard
+// is controlled before the shard initialization.
+var ErrGCNotInitialized = logicerr.New("garbage collector is not initialized")
+
+// Kinds of the objects handled by the garbage collector.
+const (
+	gcRemoved           = "removed"
+	gcExpiredObjects    = "expired_objects"
+	gcExpiredLocks      = "expired_locks"
+	gcExpiredTombstones = "expired_tombstones"
+)
+
+// GCStatus represents the state of the shard garbage collector.
+type GCStatus struct {
+	paused            bool
+	interval          time.Duration
+	batchSize         int
+	queueLength       uint64
+	removed           uint64
+	expiredObjects    uint64
+	expiredLocks      uint64
+	expiredTombstones uint64
+	lastRun           time.Time
+	lastEpoch         uint64
+}
+
+// Paused returns true if the periodic garbage collection is paused.
+func (s GCStatus) Paused() bool {
+	return s.paused
+}
+
+// Interval returns the interval between the garbage removal runs.
+func (s GCStatus) Interval() time.Duration {
+	return s.interval
+}
+
+// BatchSize returns the maximum number of objects removed in a single run.
+func (s GCStatus) BatchSize() int {
+	return s.batchSize
+}
+
+// QueueLength returns the number of objects marked as garbage and waiting
+// for the removal, zero if the metabase is unavailable.
+func (s GCStatus) QueueLength() uint64 {
+	return s.queueLength
+}
+
+// Removed returns the number of objects removed since the shard initialization.
+func (s GCStatus) Removed() uint64 {
+	return s.removed
+}
+
+// ExpiredObjects returns the number of expired objects marked as garbage
+// since the shard initialization.
+func (s GCStatus) ExpiredObjects() uint64 {
+	return s.expiredObjects
+}
+
+// ExpiredLocks returns the number of expired lock objects handled
+// since the shard initialization.
+func (s GCStatus) ExpiredLocks() uint64 {
+	return s.expiredLocks
+}
+
+// ExpiredTombstones returns the number of expired tombstones handled
+// since the shard initialization.
+func (s GCStatus) ExpiredTombstones() uint64 {
+	return s.expiredTombstones
+}
+
+// LastRun returns the time of the last garbage removal run, zero if
+// there were no runs.
+func (s GCStatus) LastRun() time.Time {
+	return s.lastRun
+}
+
+// GCStatus returns the state of the shard garbage collector.
+func (s *Shard) GCStatus() GCStatus {
+	var st GCStatus
+	if s.gc != nil {
+		s.gc.stateMtx.RLock()
+		st = s.gc.state
+		st.interval = s.gc.removerInterval
+		s.gc.stateMtx.RUnlock()
+	} else {
+		st.interval = s.gcCfg.removerInterval
+	}
+
+	s.m.RLock()
+	st.batchSize = s.rmBatchSize
+	s.m.RUnlock()
+
+	if n, err := s.metaBase.GarbageCount(); err == nil {
+		st.queueLength = n
+	}
+
+	return st
+}
+
+// TriggerGC starts a full garbage collection pass in background: the objects,
+// locks and tombstones expired at the last seen epoch are handled and all
+// the objects marked as garbage are removed. The pass is performed even
+// if the periodic garbage collection is paused.
+func (s *Shard) TriggerGC() error {
+	if s.gc == nil {
+		return ErrGCNotInitialized
+	}
+
+	m := s.GetMode()
+	if m.NoMetabase() {
+		return ErrDegradedMode
+	} else if m.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	select {
+	case s.gc.triggerChan <- struct{}{}:
+	default:
+		// the pass is already scheduled
+	}
+	return nil
+}
+
+// SetGCPaused pauses or resumes the periodic garbage removal
+// and the handling of the expired objects on new epochs.
+func (s *Shard) SetGCPaused(paused bool) error {
+	if s.gc == nil {
+		return ErrGCNotInitialized
+	}
+
+	s.gc.stateMtx.Lock()
+	s.gc.state.paused = paused
+	s.gc.stateMtx.Unlock()
+
+	s.log.Info("garbage collector state changed", zap.Bool("paused", paused))
+	return nil
+}
+
+// SetGCParams changes the interval between the garbage removal runs
+// and the maximum number of objects removed in a single run.
+// Zero values leave the corresponding parameters unchanged.
+//
+// The parameters are not persisted and are reset on shard initialization.
+func (s *Shard) SetGCParams(interval time.Duration, batchSize int) error {
+	if s.gc == nil {
+		return ErrGCNotInitialized
+	}
+
+	if batchSize > 0 {
+		s.m.Lock()
+		s.rmBatchSize = batchSize
+		s.m.Unlock()
+	}
+
+	if interval > 0 {
+		s.gc.stateMtx.Lock()
+		s.gc.removerInterval = interval
+		s.gc.stateMtx.Unlock()
+
+		select {
+		case s.gc.resetChan <- struct{}{}:
+		default:
+		}
+	}
+
+	s.log.Info("garbage collector parameters changed",
+		zap.Duration("interval", interval),
+		zap.Int("batch size", batchSize))
+	return nil
+}
+
+func (s *Shard) runFullGC() {
+	s.log.Info("started full garbage collection pass")
+
+	s.gc.stateMtx.RLock()
+	epoch := s.gc.state.lastEpoch
+	s.gc.stateMtx.RUnlock()
+
+	if epoch != 0 {
+		// the event goes through the regular handling, so it cancels
+		// and is canceled by the concurrent new epoch events
+		ev := newEpoch{epoch: epoch, handled: make(chan struct{})}
+
+		select {
+		case s.gc.eventChan <- ev:
+		case <-s.gc.stopChannel:
+			return
+		}
+
+		select {
+		case <-ev.handled:
+		case <-s.gc.stopChannel:
+			return
+		}
+	}
+
+	for !s.gc.stopped() && s.removeGarbage() {
+	}
+
+	s.log.Info("finished full garbage collection pass")
+}
+
+// reportGCRun updates the time of the last garbage removal run
+// and the queue length metric. The queue is not counted if there
+// was nothing to remove in the writable shard.
+func (s *Shard) reportGCRun(empty bool) {
+	now := time.Now()
+
+	s.gc.stateMtx.Lock()
+	s.gc.state.lastRun = now
+	s.gc.stateMtx.Unlock()
+
+	if s.metricsWriter == nil {
+		return
+	}
+
+	var queue uint64
+	if !empty || s.GetMode() != mode.ReadWrite {
+		n, err := s.metaBase.GarbageCount()
+		if err != nil {
+			return
+		}
+		queue = n
+	}
+
+	s.metricsWriter.SetGCQueueLength(queue)
+	s.metricsWriter.SetGCLastRun(now)
+}
+
+func (s *Shard) addGCObjects(kind string, n int) {
+	if n == 0 {
+		return
+	}
+
+	s.gc.stateMtx.Lock()
+	switch kind {
+	case gcRemoved:
+		s.gc.state.removed += uint64(n)
+	case gcExpiredObjects:
+		s.gc.state.expiredObjects += uint64(n)
+	case gcExpiredLocks:
+		s.gc.state.expiredLocks += uint64(n)
+	case gcExpiredTombstones:
+		s.gc.state.expiredTombstones += uint64(n)
+	}
+	s.gc.stateMtx.Unlock()
+
+	if s.metricsWriter != nil {
+		s.metricsWriter.AddGCObjects(kind, n)
+	}
+}
@@ -0,0 +1,164 @@
+package shard_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/panjf2000/ants/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_GCControl(t *testing.T) {
+	dir := t.TempDir()
+
+	sh := shard.New(
+		shard.WithBlobStorOptions(
+			blobstor.WithStorages([]blobstor.SubStorage{
+				{Storage: fstree.New(fstree.WithPath(filepath.Join(dir, "blob")))},
+			})),
+		shard.WithPiloramaOptions(pilorama.WithPath(filepath.Join(dir, "pilorama"))),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(dir, "meta")),
+			meta.WithEpochState(epochState{})),
+		shard.WithGCRemoverSleepInterval(time.Hour),
+		shard.WithRemoverBatchSize(2),
+	)
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	t.Cleanup(func() { releaseShard(sh, t) })
+
+	st := sh.GCStatus()
+	require.False(t, st.Paused())
+	require.Equal(t, time.Hour, st.Interval())
+	require.Equal(t, 2, st.BatchSize())
+	require.Zero(t, st.QueueLength())
+	require.True(t, st.LastRun().IsZero())
+
+	cnr := cidtest.ID()
+
+	var addrs []oid.Address
+	for i := 0; i < 5; i++ {
+		obj := generateObjectWithCID(t, cnr)
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(obj)
+
+		_, err := sh.Put(context.Background(), putPrm)
+		require.NoError(t, err)
+
+		addrs = append(addrs, objectcore.AddressOf(obj))
+	}
+
+	var inhPrm shard.InhumePrm
+	inhPrm.MarkAsGarbage(addrs...)
+
+	_, err := sh.Inhume(context.Background(), inhPrm)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(len(addrs)), sh.GCStatus().QueueLength())
+
+	require.NoError(t, sh.SetGCPaused(true))
+	require.NoError(t, sh.SetGCParams(time.Minute, 0))
+
+	st = sh.GCStatus()
+	require.True(t, st.Paused())
+	require.Equal(t, time.Minute, st.Interval())
+	require.Equal(t, 2, st.BatchSize())
+
+	// the full pass removes all the garbage in batches even if GC is paused
+	require.NoError(t, sh.TriggerGC())
+	require.Eventually(t, func() bool {
+		return sh.GCStatus().QueueLength() == 0
+	}, 10*time.Second, 10*time.Millisecond)
+
+	st = sh.GCStatus()
+	require.Equal(t, uint64(len(addrs)), st.Removed())
+	require.False(t, st.LastRun().IsZero())
+
+	for _, addr := range addrs {
+		var existsPrm shard.ExistsPrm
+		existsPrm.SetAddress(addr)
+
+		res, err := sh.Exists(context.Background(), existsPrm)
+		require.NoError(t, err)
+		require.False(t, res.Exists())
+	}
+
+	t.Run("read-only", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(mode.ReadOnly))
+		require.ErrorIs(t, sh.TriggerGC(), shard.ErrReadOnlyMode)
+		require.NoError(t, sh.SetMode(mode.ReadWrite))
+	})
+}
+
+func TestShard_TriggerGCExpired(t *testing.T) {
+	dir := t.TempDir()
+
+	sh := shard.New(
+		shard.WithBlobStorOptions(
+			blobstor.WithStorages([]blobstor.SubStorage{
+				{Storage: fstree.New(fstree.WithPath(filepath.Join(dir, "blob")))},
+			})),
+		shard.WithPiloramaOptions(pilorama.WithPath(filepath.Join(dir, "pilorama"))),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(dir, "meta")),
+			meta.WithEpochState(epochState{})),
+		shard.WithGCRemoverSleepInterval(time.Hour),
+		shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
+			pool, err := ants.NewPool(sz)
+			require.NoError(t, err)
+
+			return pool
+		}),
+	)
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	t.Cleanup(func() { releaseShard(sh, t) })
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+	addAttribute(obj, objectV2.SysAttributeExpEpoch, "1")
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := sh.Put(context.Background(), putPrm)
+	require.NoError(t, err)
+
+	require.NoError(t, sh.SetGCPaused(true))
+
+	// the epoch is only remembered by the paused GC, the second
+	// send returns after the first event is processed
+	sh.NotificationChannel() <- shard.EventNewEpoch(2)
+	sh.NotificationChannel() <- shard.EventNewEpoch(2)
+
+	var existsPrm shard.ExistsPrm
+	existsPrm.SetAddress(objectcore.AddressOf(obj))
+
+	res, err := sh.Exists(context.Background(), existsPrm)
+	require.NoError(t, err)
+	require.True(t, res.Exists())
+
+	// the full pass handles the remembered epoch through the event handlers
+	require.NoError(t, sh.TriggerGC())
+	require.Eventually(t, func() bool {
+		st := sh.GCStatus()
+		return st.ExpiredObjects() == 1 && st.Removed() == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	res, err = sh.Exists(context.Background(), existsPrm)
+	require.NoError(t, err)
+	require.False(t, res.Exists())
+}
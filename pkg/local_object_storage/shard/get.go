@@ -1,6 +1,7 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
@@ -8,9 +9,12 @@ import (
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -61,7 +65,17 @@ func (r GetRes) HasMeta() bool {
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Get(prm GetPrm) (GetRes, error) {
+func (s *Shard) Get(ctx context.Context, prm GetPrm) (GetRes, error) {
+	defer s.observeDuration("Get")()
+
+	_, span := tracing.StartSpanFromContext(ctx, "Shard.Get",
+		trace.WithAttributes(
+			attribute.String("shard_id", s.idString()),
+			attribute.String("address", prm.addr.EncodeToString()),
+			attribute.Bool("skip_meta", prm.skipMeta),
+		))
+	defer span.End()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -83,7 +97,10 @@ func (s *Shard) Get(prm GetPrm) (GetRes, error) {
 	}
 
 	skipMeta := prm.skipMeta || s.info.Mode.NoMetabase()
-	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc)
+	obj, hasMeta, err := s.fetchObjectData(ctx, prm.addr, skipMeta, cb, wc)
+	if err == nil {
+		s.chargeIO(ctx, obj.PayloadSize())
+	}
 
 	return GetRes{
 		obj:     obj,
@@ -96,7 +113,10 @@ func (s *Shard) Get(prm GetPrm) (GetRes, error) {
 var emptyStorageID = make([]byte, 0)
 
 // fetchObjectData looks through writeCache and blobStor to find object.
-func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher, wc func(w writecache.Cache) (*objectSDK.Object, error)) (*objectSDK.Object, bool, error) {
+// The read is throttled only if the metabase reports that the object can be
+// read from the shard, so that the shards probed by the engine for the object
+// are not charged.
+func (s *Shard) fetchObjectData(ctx context.Context, addr oid.Address, skipMeta bool, cb storFetcher, wc func(w writecache.Cache) (*objectSDK.Object, error)) (*objectSDK.Object, bool, error) {
 	var (
 		mErr error
 		mRes meta.ExistsRes
@@ -117,6 +137,12 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher,
 		s.log.Warn("fetching object without meta", zap.Stringer("addr", addr))
 	}
 
+	done, err := s.waitIO(ctx, 1)
+	if err != nil {
+		return nil, false, err
+	}
+	defer done()
+
 	if s.hasWriteCache() {
 		res, err := wc(s.writeCache)
 		if err == nil || IsErrOutOfRange(err) {
@@ -138,12 +164,35 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher,
 		return res, false, err
 	}
 
+	storageID, err := s.fetchStorageID(addr)
+	if err != nil {
+		return nil, true, err
+	}
+
+	res, err := cb(s.blobStor, storageID)
+	if IsErrNotFound(err) && len(storageID) == 0 && s.tieringEnabled() {
+		// the object could be moved to the cold sub-storage concurrently
+		storageID, err = s.fetchStorageID(addr)
+		if err != nil {
+			return nil, true, err
+		}
+
+		res, err = cb(s.blobStor, storageID)
+	}
+	if err == nil && len(storageID) == 0 {
+		s.touchObject(addr)
+	}
+
+	return res, true, err
+}
+
+func (s *Shard) fetchStorageID(addr oid.Address) ([]byte, error) {
 	var mPrm meta.StorageIDPrm
 	mPrm.SetAddress(addr)
 
 	mExRes, err := s.metaBase.StorageID(mPrm)
 	if err != nil {
-		return nil, true, fmt.Errorf("can't fetch blobovnicza id from metabase: %w", err)
+		return nil, fmt.Errorf("can't fetch blobovnicza id from metabase: %w", err)
 	}
 
 	storageID := mExRes.StorageID()
@@ -154,7 +203,5 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher,
 		storageID = emptyStorageID
 	}
 
-	res, err := cb(s.blobStor, storageID)
-
-	return res, true, err
+	return storageID, nil
 }
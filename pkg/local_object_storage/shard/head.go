@@ -1,9 +1,14 @@
 package shard
 
 import (
+	"context"
+
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // HeadPrm groups the parameters of Head operation.
@@ -43,7 +48,17 @@ func (r HeadRes) Object() *objectSDK.Object {
 // Returns an error of type apistatus.ObjectNotFound if object is missing in Shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Head(prm HeadPrm) (HeadRes, error) {
+func (s *Shard) Head(ctx context.Context, prm HeadPrm) (HeadRes, error) {
+	defer s.observeDuration("Head")()
+
+	ctx, span := tracing.StartSpanFromContext(ctx, "Shard.Head",
+		trace.WithAttributes(
+			attribute.String("shard_id", s.idString()),
+			attribute.String("address", prm.addr.EncodeToString()),
+			attribute.Bool("raw", prm.raw),
+		))
+	defer span.End()
+
 	var obj *objectSDK.Object
 	var err error
 	if s.GetMode().NoMetabase() {
@@ -52,9 +67,10 @@ func (s *Shard) Head(prm HeadPrm) (HeadRes, error) {
 		getPrm.SetIgnoreMeta(true)
 
 		var res GetRes
-		res, err = s.Get(getPrm)
+		res, err = s.Get(ctx, getPrm)
 		obj = res.Object()
 	} else {
+		// the header is read from the metabase, the I/O is not throttled
 		var headParams meta.GetPrm
 		headParams.SetAddress(prm.addr)
 		headParams.SetRaw(prm.raw)
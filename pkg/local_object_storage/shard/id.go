@@ -26,6 +26,15 @@ func (s *Shard) ID() *ID {
 	return s.info.ID
 }
 
+// idString returns the string representation of the shard ID,
+// empty if the ID is not set yet.
+func (s *Shard) idString() string {
+	if s.info.ID == nil {
+		return ""
+	}
+	return s.info.ID.String()
+}
+
 // UpdateID reads shard ID saved in the metabase and updates it if it is missing.
 func (s *Shard) UpdateID() (err error) {
 	if err = s.metaBase.Open(false); err != nil {
@@ -25,6 +25,10 @@ type Info struct {
 	// Information about the Write Cache.
 	WriteCacheInfo writecache.Info
 
+	// WriteCacheDisabled is true if the write-cache has been disabled by the flush
+	// and objects are put to the BLOB storage directly.
+	WriteCacheDisabled bool
+
 	// Weight parameters of the shard.
 	WeightValues WeightValues
 
@@ -6,7 +6,10 @@ import (
 	"fmt"
 
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -60,7 +63,17 @@ var ErrLockObjectRemoval = meta.ErrLockObjectRemoval
 // if at least one object is locked.
 //
 // Returns ErrReadOnlyMode error if shard is in "read-only" mode.
-func (s *Shard) Inhume(prm InhumePrm) (InhumeRes, error) {
+func (s *Shard) Inhume(ctx context.Context, prm InhumePrm) (InhumeRes, error) {
+	defer s.observeDuration("Inhume")()
+
+	ctx, span := tracing.StartSpanFromContext(ctx, "Shard.Inhume",
+		trace.WithAttributes(
+			attribute.String("shard_id", s.idString()),
+			attribute.Int("address_count", len(prm.target)),
+			attribute.Bool("force_removal", prm.forceRemoval),
+		))
+	defer span.End()
+
 	s.m.RLock()
 
 	if s.info.Mode.ReadOnly() {
@@ -119,7 +132,7 @@ func (s *Shard) Inhume(prm InhumePrm) (InhumeRes, error) {
 	}
 
 	if deletedLockObjs := res.DeletedLockObjects(); len(deletedLockObjs) != 0 {
-		s.deletedLockCallBack(context.Background(), deletedLockObjs)
+		s.deletedLockCallBack(ctx, deletedLockObjs)
 	}
 
 	return InhumeRes{}, nil
@@ -0,0 +1,274 @@
+package shard
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// IOClass is a priority class of the shard I/O operations.
+// Each class has its own IOPS and bandwidth limits, so that
+// the background work does not starve the client requests.
+// The classes are listed in the order of decreasing priority.
+type IOClass uint8
+
+// maxIOPriorityDelay is the maximum time an operation waits for the operations
+// of the classes with a higher priority to finish.
+const maxIOPriorityDelay = 50 * time.Millisecond
+
+const (
+	// IOClassClient is the class of the operations requested by the clients.
+	// It is used for the operations without a class in the context.
+	IOClassClient IOClass = iota
+	// IOClassReplication is the class of the operations performed
+	// by the policer and the replicator.
+	IOClassReplication
+	// IOClassBackground is the class of the maintenance operations,
+	// e.g. garbage collection and evacuation.
+	IOClassBackground
+
+	ioClassCount
+)
+
+// String implements fmt.Stringer.
+func (c IOClass) String() string {
+	switch c {
+	case IOClassClient:
+		return "client"
+	case IOClassReplication:
+		return "replication"
+	case IOClassBackground:
+		return "background"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(c))
+	}
+}
+
+type ioClassKey struct{}
+
+// ContextWithIOClass returns a copy of the context with the I/O class
+// the shard operations performed within the context belong to.
+func ContextWithIOClass(ctx context.Context, c IOClass) context.Context {
+	return context.WithValue(ctx, ioClassKey{}, c)
+}
+
+// IOClassFromContext returns the I/O class set by ContextWithIOClass,
+// IOClassClient if it is not set.
+func IOClassFromContext(ctx context.Context) IOClass {
+	c, ok := ctx.Value(ioClassKey{}).(IOClass)
+	if !ok || c >= ioClassCount {
+		return IOClassClient
+	}
+	return c
+}
+
+// IOLimits groups the limits of the I/O operations of a single class.
+// Zero value means no limit.
+type IOLimits struct {
+	// IOPS is the maximum number of operations per second.
+	IOPS uint32
+	// Bandwidth is the maximum amount of object data read and written per second, in bytes.
+	Bandwidth uint64
+}
+
+// WithIOLimits returns option to limit the I/O operations of the class.
+// The limits are applied on Reload too, classes without limits
+// in the reloaded options become unlimited.
+func WithIOLimits(c IOClass, l IOLimits) Option {
+	return func(cfg *cfg) {
+		if c < ioClassCount {
+			cfg.ioLimits[c] = l
+		}
+	}
+}
+
+// ioLimiter throttles the shard I/O operations according to their class.
+//
+// Operations are limited before they are started. The amount of data is known
+// only after the object is read, so it is charged afterwards: the following
+// operations of the class wait until the used bandwidth is paid off.
+//
+// An operation is started only when there are no operations of the classes
+// with a higher priority in progress, or after maxIOPriorityDelay, so that
+// the lower classes are slowed down but not starved.
+type ioLimiter struct {
+	ops   [ioClassCount]*rate.Limiter
+	bytes [ioClassCount]*rate.Limiter
+
+	mtx sync.Mutex
+	// active is the number of the operations of the class in progress.
+	active [ioClassCount]int
+	// idle is closed when there are no operations of the class in progress.
+	idle [ioClassCount]chan struct{}
+}
+
+func newIOLimiter(limits [ioClassCount]IOLimits) *ioLimiter {
+	l := new(ioLimiter)
+	for i := range l.ops {
+		l.ops[i] = rate.NewLimiter(rate.Inf, 0)
+		l.bytes[i] = rate.NewLimiter(rate.Inf, 0)
+		l.idle[i] = make(chan struct{})
+		close(l.idle[i])
+	}
+	l.setLimits(limits)
+	return l
+}
+
+// setLimits updates the limits of all classes in place.
+func (l *ioLimiter) setLimits(limits [ioClassCount]IOLimits) {
+	for i := range limits {
+		setRate(l.ops[i], uint64(limits[i].IOPS))
+		setRate(l.bytes[i], limits[i].Bandwidth)
+	}
+}
+
+func setRate(l *rate.Limiter, v uint64) {
+	if v == 0 {
+		l.SetLimit(rate.Inf)
+		return
+	}
+
+	burst := int(v)
+	if uint64(burst) != v || burst < 0 {
+		burst = int(^uint(0) >> 1)
+	}
+
+	// burst is updated first so that there are no requests
+	// exceeding the burst with a finite limit
+	l.SetBurst(burst)
+	l.SetLimit(rate.Limit(v))
+}
+
+// wait blocks until the operation of the class can be started.
+// The returned function must be called when the operation is finished.
+func (l *ioLimiter) wait(ctx context.Context, c IOClass, ops int) (func(), error) {
+	if err := l.waitLimits(ctx, c, ops); err != nil {
+		return nil, err
+	}
+	if err := l.waitPriority(ctx, c); err != nil {
+		return nil, err
+	}
+	return l.start(c), nil
+}
+
+func (l *ioLimiter) waitLimits(ctx context.Context, c IOClass, ops int) error {
+	for ops > 0 {
+		n := ops
+		if b := l.ops[c].Burst(); l.ops[c].Limit() != rate.Inf && n > b {
+			n = b
+		}
+		if err := l.ops[c].WaitN(ctx, n); err != nil {
+			return err
+		}
+		ops -= n
+	}
+
+	// wait until the bandwidth used by the previous operations is paid off
+	return l.bytes[c].WaitN(ctx, 0)
+}
+
+// waitPriority blocks until there are no operations of the classes with
+// a higher priority in progress, but no longer than maxIOPriorityDelay.
+func (l *ioLimiter) waitPriority(ctx context.Context, c IOClass) error {
+	var t *time.Timer
+	for h := IOClass(0); h < c; h++ {
+		l.mtx.Lock()
+		idle := l.idle[h]
+		l.mtx.Unlock()
+
+		select {
+		case <-idle:
+			continue
+		default:
+		}
+
+		if t == nil {
+			t = time.NewTimer(maxIOPriorityDelay)
+			defer t.Stop()
+		}
+
+		select {
+		case <-idle:
+		case <-t.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// start marks the operation of the class as being in progress.
+// The returned function marks it as finished.
+func (l *ioLimiter) start(c IOClass) func() {
+	l.mtx.Lock()
+	if l.active[c] == 0 {
+		l.idle[c] = make(chan struct{})
+	}
+	l.active[c]++
+	l.mtx.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mtx.Lock()
+			l.active[c]--
+			if l.active[c] == 0 {
+				close(l.idle[c])
+			}
+			l.mtx.Unlock()
+		})
+	}
+}
+
+// charge accounts the data read or written by the operation of the class.
+func (l *ioLimiter) charge(c IOClass, size uint64) {
+	lim := l.bytes[c]
+	if lim.Limit() == rate.Inf {
+		return
+	}
+
+	now := time.Now()
+	for size > 0 {
+		n := uint64(lim.Burst())
+		if n == 0 {
+			return
+		}
+		if n > size {
+			n = size
+		}
+		lim.ReserveN(now, int(n))
+		size -= n
+	}
+}
+
+// waitIO throttles the operation of the class taken from the context.
+// ops is the number of the objects affected by the operation.
+// The returned function must be called when the operation is finished.
+func (s *Shard) waitIO(ctx context.Context, ops int) (func(), error) {
+	c := IOClassFromContext(ctx)
+
+	start := time.Now()
+	done, err := s.ioLimiter.wait(ctx, c, ops)
+	if s.metricsWriter != nil {
+		s.metricsWriter.AddIOOperations(c.String(), ops, time.Since(start))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s I/O throttling: %w", c, err)
+	}
+	return done, nil
+}
+
+// chargeIO accounts the data read or written by the operation
+// of the class taken from the context.
+func (s *Shard) chargeIO(ctx context.Context, size uint64) {
+	c := IOClassFromContext(ctx)
+
+	s.ioLimiter.charge(c, size)
+	if s.metricsWriter != nil {
+		s.metricsWriter.AddIOBytes(c.String(), size)
+	}
+}
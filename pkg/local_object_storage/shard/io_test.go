@@ -0,0 +1,189 @@
+package shard
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestIOClassFromContext(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, IOClassClient, IOClassFromContext(ctx))
+
+	ctx = ContextWithIOClass(ctx, IOClassBackground)
+	require.Equal(t, IOClassBackground, IOClassFromContext(ctx))
+
+	ctx = ContextWithIOClass(ctx, ioClassCount)
+	require.Equal(t, IOClassClient, IOClassFromContext(ctx))
+}
+
+func TestIOLimiter(t *testing.T) {
+	var limits [ioClassCount]IOLimits
+	limits[IOClassReplication] = IOLimits{IOPS: 20}
+	limits[IOClassBackground] = IOLimits{Bandwidth: 1000}
+
+	l := newIOLimiter(limits)
+
+	measure := func(f func()) time.Duration {
+		start := time.Now()
+		f()
+		return time.Since(start)
+	}
+
+	ctx := context.Background()
+
+	wait := func(ctx context.Context, c IOClass, ops int) error {
+		done, err := l.wait(ctx, c, ops)
+		if err == nil {
+			done()
+		}
+		return err
+	}
+
+	t.Run("no limits", func(t *testing.T) {
+		d := measure(func() {
+			for i := 0; i < 1000; i++ {
+				require.NoError(t, wait(ctx, IOClassClient, 1))
+				l.charge(IOClassClient, 1<<20)
+			}
+		})
+		require.Less(t, d, 100*time.Millisecond)
+	})
+	t.Run("iops", func(t *testing.T) {
+		// the burst is spent immediately, the rest is limited
+		d := measure(func() {
+			require.NoError(t, wait(ctx, IOClassReplication, 30))
+		})
+		require.GreaterOrEqual(t, d, 400*time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		require.Error(t, wait(ctx, IOClassReplication, 20))
+	})
+	t.Run("bandwidth", func(t *testing.T) {
+		require.NoError(t, wait(ctx, IOClassBackground, 1))
+
+		// the debt exceeding the burst is paid off by the next operation
+		l.charge(IOClassBackground, 1500)
+
+		d := measure(func() {
+			require.NoError(t, wait(ctx, IOClassBackground, 1))
+		})
+		require.GreaterOrEqual(t, d, 400*time.Millisecond)
+	})
+	t.Run("reload", func(t *testing.T) {
+		l.setLimits([ioClassCount]IOLimits{})
+
+		d := measure(func() {
+			require.NoError(t, wait(ctx, IOClassReplication, 1000))
+			l.charge(IOClassBackground, 1<<20)
+			require.NoError(t, wait(ctx, IOClassBackground, 1))
+		})
+		require.Less(t, d, 100*time.Millisecond)
+	})
+}
+
+func TestIOLimiterPriority(t *testing.T) {
+	l := newIOLimiter([ioClassCount]IOLimits{})
+	ctx := context.Background()
+
+	measure := func(c IOClass) time.Duration {
+		start := time.Now()
+		done, err := l.wait(ctx, c, 1)
+		require.NoError(t, err)
+		done()
+		return time.Since(start)
+	}
+
+	clientDone, err := l.wait(ctx, IOClassClient, 1)
+	require.NoError(t, err)
+
+	// the higher classes are not delayed
+	require.Less(t, measure(IOClassClient), maxIOPriorityDelay)
+
+	// the lower classes are delayed while the client operation is in progress
+	require.GreaterOrEqual(t, measure(IOClassReplication), maxIOPriorityDelay)
+
+	bgDone, err := l.wait(ctx, IOClassBackground, 1)
+	require.NoError(t, err)
+	require.Less(t, measure(IOClassClient), maxIOPriorityDelay)
+
+	// the waiting operation starts as soon as the client operation is finished
+	time.AfterFunc(maxIOPriorityDelay/5, clientDone)
+	require.Less(t, measure(IOClassReplication), maxIOPriorityDelay)
+
+	// the replication is not delayed by the background operations
+	require.Less(t, measure(IOClassReplication), maxIOPriorityDelay)
+	bgDone()
+	require.Less(t, measure(IOClassBackground), maxIOPriorityDelay)
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	clientDone, err = l.wait(context.Background(), IOClassClient, 1)
+	require.NoError(t, err)
+	defer clientDone()
+
+	_, err = l.wait(ctx, IOClassBackground, 1)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestShardIOLimitsMissingObject(t *testing.T) {
+	dir := t.TempDir()
+
+	l := &logger.Logger{Logger: zaptest.NewLogger(t)}
+	sh := New(
+		WithLogger(l),
+		WithBlobStorOptions(
+			blobstor.WithLogger(l),
+			blobstor.WithStorages([]blobstor.SubStorage{
+				{Storage: fstree.New(fstree.WithPath(filepath.Join(dir, "blob")))},
+			})),
+		WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(dir, "meta")),
+			meta.WithEpochState(epochState{})),
+		WithPiloramaOptions(pilorama.WithPath(filepath.Join(dir, "pilorama"))),
+		WithIOLimits(IOClassClient, IOLimits{IOPS: 1}))
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+	obj := newObject()
+
+	var putPrm PutPrm
+	putPrm.SetObject(obj)
+	_, err := sh.Put(ContextWithIOClass(context.Background(), IOClassReplication), putPrm)
+	require.NoError(t, err)
+
+	get := func(t *testing.T, prm GetPrm) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		_, err := sh.Get(ctx, prm)
+		return err
+	}
+
+	// the shards without the object are not charged
+	for i := 0; i < 10; i++ {
+		var prm GetPrm
+		prm.SetAddress(oidtest.Address())
+		require.ErrorAs(t, get(t, prm), new(apistatus.ObjectNotFound))
+	}
+
+	var prm GetPrm
+	prm.SetAddress(objectCore.AddressOf(obj))
+	require.NoError(t, get(t, prm))
+	require.ErrorContains(t, get(t, prm), "throttling")
+}
@@ -99,6 +99,18 @@ func (s *Shard) List() (res SelectRes, err error) {
 	return res, nil
 }
 
+// ObjectCounters returns object counters tracked by the shard metabase.
+func (s *Shard) ObjectCounters() (meta.ObjectCounters, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() {
+		return meta.ObjectCounters{}, ErrDegradedMode
+	}
+
+	return s.metaBase.ObjectCounters()
+}
+
 func (s *Shard) ListContainers(_ ListContainersPrm) (ListContainersRes, error) {
 	if s.GetMode().NoMetabase() {
 		return ListContainersRes{}, ErrDegradedMode
@@ -15,6 +15,8 @@ import (
 //
 // Locked list should be unique. Panics if it is empty.
 func (s *Shard) Lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error {
+	defer s.observeDuration("Lock")()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -74,7 +74,7 @@ func TestShard_Lock(t *testing.T) {
 	var putPrm shard.PutPrm
 	putPrm.SetObject(obj)
 
-	_, err := sh.Put(putPrm)
+	_, err := sh.Put(context.Background(), putPrm)
 	require.NoError(t, err)
 
 	// lock the object
@@ -83,7 +83,7 @@ func TestShard_Lock(t *testing.T) {
 	require.NoError(t, err)
 
 	putPrm.SetObject(lock)
-	_, err = sh.Put(putPrm)
+	_, err = sh.Put(context.Background(), putPrm)
 	require.NoError(t, err)
 
 	t.Run("inhuming locked objects", func(t *testing.T) {
@@ -92,11 +92,11 @@ func TestShard_Lock(t *testing.T) {
 		var inhumePrm shard.InhumePrm
 		inhumePrm.SetTarget(objectcore.AddressOf(ts), objectcore.AddressOf(obj))
 
-		_, err = sh.Inhume(inhumePrm)
+		_, err = sh.Inhume(context.Background(), inhumePrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectLocked))
 
 		inhumePrm.MarkAsGarbage(objectcore.AddressOf(obj))
-		_, err = sh.Inhume(inhumePrm)
+		_, err = sh.Inhume(context.Background(), inhumePrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectLocked))
 	})
 
@@ -106,11 +106,11 @@ func TestShard_Lock(t *testing.T) {
 		var inhumePrm shard.InhumePrm
 		inhumePrm.SetTarget(objectcore.AddressOf(ts), objectcore.AddressOf(lock))
 
-		_, err = sh.Inhume(inhumePrm)
+		_, err = sh.Inhume(context.Background(), inhumePrm)
 		require.Error(t, err)
 
 		inhumePrm.MarkAsGarbage(objectcore.AddressOf(lock))
-		_, err = sh.Inhume(inhumePrm)
+		_, err = sh.Inhume(context.Background(), inhumePrm)
 		require.Error(t, err)
 	})
 
@@ -119,7 +119,7 @@ func TestShard_Lock(t *testing.T) {
 		inhumePrm.MarkAsGarbage(objectcore.AddressOf(lock))
 		inhumePrm.ForceRemoval()
 
-		_, err = sh.Inhume(inhumePrm)
+		_, err = sh.Inhume(context.Background(), inhumePrm)
 		require.NoError(t, err)
 
 		// it should be possible to remove
@@ -128,7 +128,7 @@ func TestShard_Lock(t *testing.T) {
 		inhumePrm = shard.InhumePrm{}
 		inhumePrm.MarkAsGarbage(objectcore.AddressOf(obj))
 
-		_, err = sh.Inhume(inhumePrm)
+		_, err = sh.Inhume(context.Background(), inhumePrm)
 		require.NoError(t, err)
 
 		// check that object has been removed
@@ -136,7 +136,7 @@ func TestShard_Lock(t *testing.T) {
 		var getPrm shard.GetPrm
 		getPrm.SetAddress(objectcore.AddressOf(obj))
 
-		_, err = sh.Get(getPrm)
+		_, err = sh.Get(context.Background(), getPrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 }
@@ -156,7 +156,7 @@ func TestShard_IsLocked(t *testing.T) {
 	var putPrm shard.PutPrm
 	putPrm.SetObject(obj)
 
-	_, err := sh.Put(putPrm)
+	_, err := sh.Put(context.Background(), putPrm)
 	require.NoError(t, err)
 
 	// not locked object is not locked
@@ -1,8 +1,10 @@
 package shard_test
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
+	"time"
 
 	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
@@ -17,10 +19,14 @@ import (
 )
 
 type metricsStore struct {
-	objCounters map[string]uint64
-	cnrSize     map[string]int64
-	pldSize     int64
-	readOnly    bool
+	objCounters  map[string]uint64
+	cnrSize      map[string]int64
+	pldSize      int64
+	readOnly     bool
+	methodCalls  map[string]int
+	storageCalls map[string]int
+	scrubbed     int
+	corrupted    int
 }
 
 func (m metricsStore) SetShardID(_ string) {}
@@ -66,6 +72,36 @@ func (m *metricsStore) AddToPayloadSize(size int64) {
 	m.pldSize += size
 }
 
+func (m metricsStore) AddMethodDuration(method string, _ time.Duration) {
+	m.methodCalls[method]++
+}
+
+func (m metricsStore) AddStorageMethodDuration(storage, method string, _ time.Duration) {
+	m.storageCalls[storage+"/"+method]++
+}
+
+func (m *metricsStore) IncScrubbedObjects() {
+	m.scrubbed++
+}
+
+func (m *metricsStore) IncCorruptedObjects() {
+	m.corrupted++
+}
+
+func (m *metricsStore) SetWriteCacheFillRatio(float64) {}
+
+func (m *metricsStore) SetWriteCacheFlushLag(time.Duration) {}
+
+func (m *metricsStore) AddIOOperations(string, int, time.Duration) {}
+
+func (m *metricsStore) AddIOBytes(string, uint64) {}
+
+func (m *metricsStore) AddGCObjects(string, int) {}
+
+func (m *metricsStore) SetGCQueueLength(uint64) {}
+
+func (m *metricsStore) SetGCLastRun(time.Time) {}
+
 const physical = "phy"
 const logical = "logic"
 const readonly = "readonly"
@@ -108,7 +144,7 @@ func TestCounters(t *testing.T) {
 		for i := 0; i < objNumber; i++ {
 			prm.SetObject(oo[i])
 
-			_, err := sh.Put(prm)
+			_, err := sh.Put(context.Background(), prm)
 			require.NoError(t, err)
 		}
 
@@ -116,6 +152,8 @@ func TestCounters(t *testing.T) {
 		require.Equal(t, uint64(objNumber), mm.objCounters[logical])
 		require.Equal(t, expectedSizes, mm.cnrSize)
 		require.Equal(t, totalPayload, mm.pldSize)
+		require.Equal(t, objNumber, mm.methodCalls["Put"])
+		require.Equal(t, objNumber, mm.storageCalls["fstree/Put"])
 	})
 
 	t.Run("inhume_GC", func(t *testing.T) {
@@ -125,7 +163,7 @@ func TestCounters(t *testing.T) {
 		for i := 0; i < inhumedNumber; i++ {
 			prm.MarkAsGarbage(objectcore.AddressOf(oo[i]))
 
-			_, err := sh.Inhume(prm)
+			_, err := sh.Inhume(context.Background(), prm)
 			require.NoError(t, err)
 		}
 
@@ -147,7 +185,7 @@ func TestCounters(t *testing.T) {
 		inhumedNumber := int(phy / 4)
 		prm.SetTarget(ts, addrFromObjs(oo[:inhumedNumber])...)
 
-		_, err := sh.Inhume(prm)
+		_, err := sh.Inhume(context.Background(), prm)
 		require.NoError(t, err)
 
 		require.Equal(t, phy, mm.objCounters[physical])
@@ -167,7 +205,7 @@ func TestCounters(t *testing.T) {
 		deletedNumber := int(phy / 4)
 		prm.SetAddresses(addrFromObjs(oo[:deletedNumber])...)
 
-		_, err := sh.Delete(prm)
+		_, err := sh.Delete(context.Background(), prm)
 		require.NoError(t, err)
 
 		require.Equal(t, phy-uint64(deletedNumber), mm.objCounters[physical])
@@ -202,7 +240,9 @@ func shardWithMetrics(t *testing.T, path string) (*shard.Shard, *metricsStore) {
 			"phy":   0,
 			"logic": 0,
 		},
-		cnrSize: make(map[string]int64),
+		cnrSize:      make(map[string]int64),
+		methodCalls:  make(map[string]int),
+		storageCalls: make(map[string]int),
 	}
 
 	sh := shard.New(
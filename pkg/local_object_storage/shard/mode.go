@@ -29,6 +29,11 @@ func (s *Shard) setMode(m mode.Mode) error {
 		zap.Stringer("old_mode", s.info.Mode),
 		zap.Stringer("new_mode", m))
 
+	// rebuild works with blobstor sub-storages directly
+	s.stopRebuild()
+	s.StopScrub()
+	s.interruptMigration()
+
 	components := []interface{ SetMode(mode.Mode) error }{
 		s.metaBase, s.blobStor,
 	}
@@ -62,6 +67,9 @@ func (s *Shard) setMode(m mode.Mode) error {
 	}
 
 	s.info.Mode = m
+	if m == mode.ReadWrite {
+		s.info.WriteCacheDisabled = false
+	}
 	if s.metricsWriter != nil {
 		s.metricsWriter.SetReadonly(s.info.Mode != mode.ReadWrite)
 	}
@@ -1,12 +1,16 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 
 	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -29,7 +33,22 @@ func (p *PutPrm) SetObject(obj *object.Object) {
 // did not allow to completely save the object.
 //
 // Returns ErrReadOnlyMode error if shard is in "read-only" mode.
-func (s *Shard) Put(prm PutPrm) (PutRes, error) {
+func (s *Shard) Put(ctx context.Context, prm PutPrm) (PutRes, error) {
+	defer s.observeDuration("Put")()
+
+	_, span := tracing.StartSpanFromContext(ctx, "Shard.Put",
+		trace.WithAttributes(
+			attribute.String("shard_id", s.idString()),
+			attribute.String("address", objectCore.AddressOf(prm.obj).EncodeToString()),
+		))
+	defer span.End()
+
+	done, err := s.waitIO(ctx, 1)
+	if err != nil {
+		return PutRes{}, err
+	}
+	defer done()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -52,7 +71,7 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 
 	// exist check are not performed there, these checks should be executed
 	// ahead of `Put` by storage engine
-	tryCache := s.hasWriteCache() && !m.NoMetabase()
+	tryCache := s.hasWriteCache() && !m.NoMetabase() && !s.info.WriteCacheDisabled
 	if tryCache {
 		res, err = s.writeCache.Put(putPrm)
 	}
@@ -68,6 +87,8 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 		}
 	}
 
+	s.chargeIO(ctx, uint64(len(data)))
+
 	if !m.NoMetabase() {
 		var pPrm meta.PutPrm
 		pPrm.SetObject(prm.obj)
@@ -1,13 +1,19 @@
 package shard
 
 import (
+	"context"
+	"strconv"
+
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // RngPrm groups the parameters of GetRange operation.
@@ -66,7 +72,19 @@ func (r RngRes) HasMeta() bool {
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
+func (s *Shard) GetRange(ctx context.Context, prm RngPrm) (RngRes, error) {
+	defer s.observeDuration("GetRange")()
+
+	_, span := tracing.StartSpanFromContext(ctx, "Shard.GetRange",
+		trace.WithAttributes(
+			attribute.String("shard_id", s.idString()),
+			attribute.String("address", prm.addr.EncodeToString()),
+			attribute.Bool("skip_meta", prm.skipMeta),
+			attribute.String("offset", strconv.FormatUint(prm.off, 10)),
+			attribute.String("length", strconv.FormatUint(prm.ln, 10)),
+		))
+	defer span.End()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -107,7 +125,10 @@ func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
 	}
 
 	skipMeta := prm.skipMeta || s.info.Mode.NoMetabase()
-	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc)
+	obj, hasMeta, err := s.fetchObjectData(ctx, prm.addr, skipMeta, cb, wc)
+	if err == nil {
+		s.chargeIO(ctx, uint64(len(obj.Payload())))
+	}
 
 	return RngRes{
 		obj:     obj,
@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"math"
 	"path/filepath"
 	"testing"
@@ -97,14 +98,14 @@ func testShardGetRange(t *testing.T, hasWriteCache bool) {
 			var putPrm shard.PutPrm
 			putPrm.SetObject(obj)
 
-			_, err := sh.Put(putPrm)
+			_, err := sh.Put(context.Background(), putPrm)
 			require.NoError(t, err)
 
 			var rngPrm shard.RngPrm
 			rngPrm.SetAddress(addr)
 			rngPrm.SetRange(tc.rng.GetOffset(), tc.rng.GetLength())
 
-			res, err := sh.GetRange(rngPrm)
+			res, err := sh.GetRange(context.Background(), rngPrm)
 			if tc.hasErr {
 				require.ErrorAs(t, err, &apistatus.ObjectOutOfRange{})
 			} else {
@@ -0,0 +1,176 @@
+package shard
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ErrRebuildInProgress is returned when rebuild is started while the previous one is running.
+var ErrRebuildInProgress = logicerr.New("rebuild is already in progress")
+
+// RebuildPrm groups the parameters of StartRebuild operation.
+type RebuildPrm struct {
+	fillPercent int
+}
+
+// SetFillPercent sets the minimal percentage of live data in a blobovnicza.
+// Blobovniczas with less live data are rebuilt. If not set, only blobovniczas
+// which don't match the current configuration are rebuilt.
+func (p *RebuildPrm) SetFillPercent(v int) {
+	p.fillPercent = v
+}
+
+// RebuildState represents the state of the last started blobstor rebuild.
+type RebuildState struct {
+	running      bool
+	startedAt    time.Time
+	finishedAt   time.Time
+	objectsMoved uint64
+	filesRemoved uint64
+	errMessage   string
+}
+
+// Running returns true if the rebuild is in progress.
+func (s RebuildState) Running() bool {
+	return s.running
+}
+
+// StartedAt returns the time rebuild was started at, zero if it was never started.
+func (s RebuildState) StartedAt() time.Time {
+	return s.startedAt
+}
+
+// FinishedAt returns the time rebuild was finished at, zero if it is not finished.
+func (s RebuildState) FinishedAt() time.Time {
+	return s.finishedAt
+}
+
+// ObjectsMoved returns the amount of moved objects. It is known only after the rebuild is finished.
+func (s RebuildState) ObjectsMoved() uint64 {
+	return s.objectsMoved
+}
+
+// FilesRemoved returns the amount of removed blobovniczas. It is known only after the rebuild is finished.
+func (s RebuildState) FilesRemoved() uint64 {
+	return s.filesRemoved
+}
+
+// ErrorMessage returns the error rebuild has finished with, if any.
+func (s RebuildState) ErrorMessage() string {
+	return s.errMessage
+}
+
+type rebuilder struct {
+	mtx    sync.Mutex
+	state  RebuildState
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// StartRebuild starts moving objects out of the sparse blobovniczas and the ones
+// which don't match the current configuration. Rebuild is performed in background,
+// its state can be obtained with RebuildState. Rebuild is stopped if shard mode
+// is changed or the shard is closed.
+//
+// Returns ErrRebuildInProgress if the rebuild is already running.
+func (s *Shard) StartRebuild(prm RebuildPrm) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	if s.info.Mode.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	s.rebuilder.mtx.Lock()
+	defer s.rebuilder.mtx.Unlock()
+
+	if s.rebuilder.state.running {
+		return ErrRebuildInProgress
+	}
+
+	var ctx context.Context
+	ctx, s.rebuilder.cancel = context.WithCancel(context.Background())
+	s.rebuilder.done = make(chan struct{})
+	s.rebuilder.state = RebuildState{
+		running:   true,
+		startedAt: time.Now().UTC(),
+	}
+
+	go s.rebuild(ctx, prm, s.rebuilder.done)
+	return nil
+}
+
+func (s *Shard) rebuild(ctx context.Context, prm RebuildPrm, done chan struct{}) {
+	defer close(done)
+
+	s.log.Info("started blobstor rebuild", zap.Int("fill_percent", prm.fillPercent))
+
+	res, err := s.blobStor.Rebuild(ctx, common.RebuildPrm{
+		MetaStorage: (*metaStorage)(s),
+		FillPercent: prm.fillPercent,
+	})
+
+	s.rebuilder.mtx.Lock()
+	s.rebuilder.state.running = false
+	s.rebuilder.state.finishedAt = time.Now().UTC()
+	s.rebuilder.state.objectsMoved = res.ObjectsMoved
+	s.rebuilder.state.filesRemoved = res.FilesRemoved
+	if err != nil {
+		s.rebuilder.state.errMessage = err.Error()
+	}
+	s.rebuilder.mtx.Unlock()
+
+	if err != nil {
+		s.log.Error("blobstor rebuild failed",
+			zap.Uint64("moved_objects", res.ObjectsMoved),
+			zap.Uint64("removed_files", res.FilesRemoved),
+			zap.Error(err))
+		return
+	}
+
+	s.log.Info("blobstor rebuild completed",
+		zap.Uint64("moved_objects", res.ObjectsMoved),
+		zap.Uint64("removed_files", res.FilesRemoved))
+}
+
+// RebuildState returns the state of the last started rebuild.
+func (s *Shard) RebuildState() RebuildState {
+	s.rebuilder.mtx.Lock()
+	defer s.rebuilder.mtx.Unlock()
+
+	return s.rebuilder.state
+}
+
+// stopRebuild cancels running rebuild and waits until it is finished.
+func (s *Shard) stopRebuild() {
+	s.rebuilder.mtx.Lock()
+	cancel, done := s.rebuilder.cancel, s.rebuilder.done
+	s.rebuilder.mtx.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// metaStorage updates storage IDs of the objects moved during the rebuild.
+type metaStorage Shard
+
+func (s *metaStorage) UpdateStorageID(addr oid.Address, storageID []byte) error {
+	var prm meta.UpdateStorageIDPrm
+	prm.SetAddress(addr)
+	prm.SetStorageID(storageID)
+
+	_, err := s.metaBase.UpdateStorageID(prm)
+	return err
+}
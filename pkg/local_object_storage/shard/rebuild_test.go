@@ -0,0 +1,84 @@
+package shard_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestShard_Rebuild(t *testing.T) {
+	dir := t.TempDir()
+
+	sh := newCustomShard(t, dir, false, nil, []blobstor.Option{
+		blobstor.WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+		blobstor.WithStorages([]blobstor.SubStorage{{
+			Storage: blobovniczatree.NewBlobovniczaTree(
+				blobovniczatree.WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+				blobovniczatree.WithRootPath(filepath.Join(dir, "blob")),
+				blobovniczatree.WithBlobovniczaShallowDepth(1),
+				blobovniczatree.WithBlobovniczaShallowWidth(4),
+				blobovniczatree.WithBlobovniczaSize(16*1024)),
+		}}),
+	})
+	defer releaseShard(sh, t)
+
+	cnr := cidtest.ID()
+
+	var addrs []oid.Address
+	for i := 0; i < 60; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		addPayload(obj, 1024)
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(obj)
+
+		_, err := sh.Put(context.Background(), putPrm)
+		require.NoError(t, err)
+
+		addrs = append(addrs, object.AddressOf(obj))
+	}
+
+	var delPrm shard.DeletePrm
+	delPrm.SetAddresses(addrs[10:]...)
+
+	_, err := sh.Delete(context.Background(), delPrm)
+	require.NoError(t, err)
+
+	var prm shard.RebuildPrm
+	prm.SetFillPercent(50)
+	require.NoError(t, sh.StartRebuild(prm))
+
+	require.Eventually(t, func() bool {
+		return !sh.RebuildState().Running()
+	}, 10*time.Second, 10*time.Millisecond)
+
+	st := sh.RebuildState()
+	require.Empty(t, st.ErrorMessage())
+	require.NotZero(t, st.FilesRemoved())
+	require.False(t, st.FinishedAt().Before(st.StartedAt()))
+
+	for _, addr := range addrs[:10] {
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(addr)
+
+		_, err := sh.Get(context.Background(), getPrm)
+		require.NoError(t, err)
+	}
+
+	t.Run("read-only", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(mode.ReadOnly))
+		require.ErrorIs(t, sh.StartRebuild(prm), shard.ErrReadOnlyMode)
+	})
+}
@@ -1,6 +1,7 @@
 package shard
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -65,7 +66,7 @@ func TestShardReload(t *testing.T) {
 			var prm ExistsPrm
 			prm.SetAddress(objects[i].addr)
 
-			res, err := sh.Exists(prm)
+			res, err := sh.Exists(context.Background(), prm)
 			require.NoError(t, err)
 			require.Equal(t, exists, res.Exists(), "object #%d is missing", i)
 		}
@@ -131,7 +132,7 @@ func putObject(sh *Shard, obj *objectSDK.Object) error {
 	var prm PutPrm
 	prm.SetObject(obj)
 
-	_, err := sh.Put(prm)
+	_, err := sh.Put(context.Background(), prm)
 	return err
 }
 
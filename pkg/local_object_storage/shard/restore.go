@@ -2,23 +2,39 @@ package shard
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 )
 
 // ErrInvalidMagic is returned when dump format is invalid.
 var ErrInvalidMagic = logicerr.New("invalid magic")
 
+// ErrUnsupportedDumpVersion is returned when the dump format version is unknown.
+var ErrUnsupportedDumpVersion = logicerr.New("unsupported dump version")
+
+// ErrInvalidDumpRecord is returned when the dump contains a malformed record.
+var ErrInvalidDumpRecord = logicerr.New("invalid dump record")
+
+// ErrDumpChecksumMismatch is returned when the object checksum from the dump
+// doesn't match the object data.
+var ErrDumpChecksumMismatch = logicerr.New("dump object checksum mismatch")
+
 // RestorePrm groups the parameters of Restore operation.
 type RestorePrm struct {
-	path         string
-	stream       io.Reader
-	ignoreErrors bool
+	path          string
+	stream        io.Reader
+	ignoreErrors  bool
+	maxObjectSize uint64
 }
 
 // WithPath is a Restore option to set the destination path.
@@ -33,11 +49,20 @@ func (p *RestorePrm) WithStream(r io.Reader) {
 }
 
 // WithIgnoreErrors is a Restore option which allows to ignore errors encountered during restore.
-// Corrupted objects will not be processed.
+// Corrupted objects will not be processed, objects, tombstones, garbage marks
+// and locks of the versioned dump that can't be applied are skipped and counted
+// as failed.
 func (p *RestorePrm) WithIgnoreErrors(ignore bool) {
 	p.ignoreErrors = ignore
 }
 
+// WithMaxObjectSize is a Restore option to set the maximum payload size of
+// the objects in the dump. Bigger records are considered corrupted and are
+// not read. Zero means the default maximum object size of the network.
+func (p *RestorePrm) WithMaxObjectSize(sz uint64) {
+	p.maxObjectSize = sz
+}
+
 // RestoreRes groups the result fields of Restore operation.
 type RestoreRes struct {
 	count  int
@@ -54,7 +79,9 @@ func (r RestoreRes) FailCount() int {
 	return r.failed
 }
 
-// Restore restores objects from the dump prepared by Dump.
+// Restore restores objects from the dump prepared by Dump. Both the legacy
+// and the versioned dumps are supported. Graveyard, garbage marks and locks
+// from the versioned dump are applied after all the objects are put.
 //
 // Returns any error encountered.
 func (s *Shard) Restore(prm RestorePrm) (RestoreRes, error) {
@@ -79,10 +106,18 @@ func (s *Shard) Restore(prm RestorePrm) (RestoreRes, error) {
 
 	var m [4]byte
 	_, _ = io.ReadFull(r, m[:])
-	if !bytes.Equal(m[:], dumpMagic) {
+	switch {
+	case bytes.Equal(m[:], dumpMagic):
+		return s.restoreLegacy(r, prm)
+	case bytes.Equal(m[:], dumpMagicV2):
+		return s.restore(r, prm)
+	default:
 		return RestoreRes{}, ErrInvalidMagic
 	}
+}
 
+// restoreLegacy restores objects from the dump of the first version.
+func (s *Shard) restoreLegacy(r io.Reader, prm RestorePrm) (RestoreRes, error) {
 	var putPrm PutPrm
 
 	var count, failCount int
@@ -100,6 +135,9 @@ func (s *Shard) Restore(prm RestorePrm) (RestoreRes, error) {
 		}
 
 		sz := binary.LittleEndian.Uint32(size[:])
+		if maxSize := maxDumpRecordSize(prm.maxObjectSize); uint64(sz) > maxSize {
+			return RestoreRes{}, fmt.Errorf("%w: object size %d exceeds the limit %d", ErrInvalidDumpRecord, sz, maxSize)
+		}
 		if uint32(cap(data)) < sz {
 			data = make([]byte, sz)
 		} else {
@@ -122,7 +160,7 @@ func (s *Shard) Restore(prm RestorePrm) (RestoreRes, error) {
 		}
 
 		putPrm.SetObject(obj)
-		_, err = s.Put(putPrm)
+		_, err = s.Put(context.Background(), putPrm)
 		if err != nil && !IsErrObjectExpired(err) && !IsErrRemoved(err) {
 			return RestoreRes{}, err
 		}
@@ -132,3 +170,199 @@ func (s *Shard) Restore(prm RestorePrm) (RestoreRes, error) {
 
 	return RestoreRes{count: count, failed: failCount}, nil
 }
+
+// restore restores objects and their metadata from the versioned dump.
+func (s *Shard) restore(r io.Reader, prm RestorePrm) (RestoreRes, error) {
+	d, release, err := newDumpReader(r, maxDumpRecordSize(prm.maxObjectSize))
+	if err != nil {
+		return RestoreRes{}, err
+	}
+	defer release()
+
+	typ, data, err := d.next()
+	if err != nil {
+		return RestoreRes{}, err
+	}
+	if typ != dumpRecordManifest {
+		return RestoreRes{}, fmt.Errorf("%w: manifest is missing", ErrInvalidDumpRecord)
+	}
+
+	var man dumpManifest
+	if err := json.Unmarshal(data, &man); err != nil {
+		return RestoreRes{}, fmt.Errorf("%w: invalid manifest: %v", ErrInvalidDumpRecord, err)
+	}
+
+	var (
+		res     RestoreRes
+		putPrm  PutPrm
+		objects uint64
+		meta    uint64
+
+		graves  []dumpGrave
+		garbage []oid.Address
+		locks   []dumpLock
+	)
+
+	for {
+		typ, data, err := d.next()
+		if err != nil {
+			return RestoreRes{}, err
+		}
+
+		if typ == dumpRecordEnd {
+			if len(data) != 16 {
+				return RestoreRes{}, fmt.Errorf("%w: invalid end record size %d", ErrInvalidDumpRecord, len(data))
+			}
+			if binary.LittleEndian.Uint64(data) != objects || binary.LittleEndian.Uint64(data[8:]) != meta {
+				return RestoreRes{}, fmt.Errorf("%w: record count mismatch", ErrInvalidDumpRecord)
+			}
+			break
+		}
+
+		switch typ {
+		case dumpRecordObject:
+			objects++
+
+			obj, err := decodeDumpObject(data)
+			if err != nil {
+				if prm.ignoreErrors {
+					res.failed++
+					continue
+				}
+				return RestoreRes{}, err
+			}
+
+			putPrm.SetObject(obj)
+			_, err = s.Put(context.Background(), putPrm)
+			if err != nil && !IsErrObjectExpired(err) && !IsErrRemoved(err) {
+				if prm.ignoreErrors {
+					res.failed++
+					continue
+				}
+				return RestoreRes{}, err
+			}
+
+			res.count++
+		case dumpRecordGrave:
+			meta++
+
+			var g dumpGrave
+			if len(data) != 2*addressSize ||
+				decodeAddress(&g.addr, data) != nil ||
+				decodeAddress(&g.tomb, data[addressSize:]) != nil {
+				return RestoreRes{}, fmt.Errorf("%w: invalid grave", ErrInvalidDumpRecord)
+			}
+			graves = append(graves, g)
+		case dumpRecordGarbage:
+			meta++
+
+			var addr oid.Address
+			if len(data) != addressSize || decodeAddress(&addr, data) != nil {
+				return RestoreRes{}, fmt.Errorf("%w: invalid garbage mark", ErrInvalidDumpRecord)
+			}
+			garbage = append(garbage, addr)
+		case dumpRecordLock:
+			meta++
+
+			var l dumpLock
+			if len(data) != addressSize+sha256.Size ||
+				decodeAddress(&l.addr, data) != nil ||
+				l.locker.Decode(data[addressSize:]) != nil {
+				return RestoreRes{}, fmt.Errorf("%w: invalid lock", ErrInvalidDumpRecord)
+			}
+			locks = append(locks, l)
+		default:
+			return RestoreRes{}, fmt.Errorf("%w: unknown record type %d", ErrInvalidDumpRecord, typ)
+		}
+	}
+
+	failed, err := s.restoreMeta(graves, garbage, locks, prm.ignoreErrors)
+	if err != nil {
+		return RestoreRes{}, err
+	}
+
+	res.failed += failed
+	return res, nil
+}
+
+type dumpGrave struct {
+	addr oid.Address
+	tomb oid.Address
+}
+
+type dumpLock struct {
+	addr   oid.Address
+	locker oid.ID
+}
+
+// decodeDumpObject verifies the checksum of the object record and decodes the object.
+func decodeDumpObject(data []byte) (*object.Object, error) {
+	if len(data) < sha256.Size {
+		return nil, fmt.Errorf("%w: object record is too short", ErrInvalidDumpRecord)
+	}
+
+	sum := sha256.Sum256(data[sha256.Size:])
+	if !bytes.Equal(sum[:], data[:sha256.Size]) {
+		return nil, ErrDumpChecksumMismatch
+	}
+
+	obj := object.New()
+	if err := obj.Unmarshal(data[sha256.Size:]); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// restoreMeta applies the graveyard, garbage marks and locks from the dump.
+// If ignoreErrors is set, the records which can't be applied are skipped,
+// their number is returned.
+func (s *Shard) restoreMeta(graves []dumpGrave, garbage []oid.Address, locks []dumpLock, ignoreErrors bool) (int, error) {
+	var failed int
+
+	var inhumePrm InhumePrm
+	for i := range graves {
+		inhumePrm.SetTarget(graves[i].tomb, graves[i].addr)
+		if _, err := s.Inhume(context.Background(), inhumePrm); err != nil {
+			if ignoreErrors {
+				failed++
+				continue
+			}
+			return 0, fmt.Errorf("could not restore tombstone of %s: %w", graves[i].addr, err)
+		}
+	}
+
+	if len(garbage) != 0 {
+		var inhumePrm InhumePrm
+		inhumePrm.MarkAsGarbage(garbage...)
+		inhumePrm.ForceRemoval()
+
+		if _, err := s.Inhume(context.Background(), inhumePrm); err != nil {
+			if !ignoreErrors {
+				return 0, fmt.Errorf("could not restore garbage marks: %w", err)
+			}
+
+			// find the marks which can't be applied
+			for i := range garbage {
+				var inhumePrm InhumePrm
+				inhumePrm.MarkAsGarbage(garbage[i])
+				inhumePrm.ForceRemoval()
+
+				if _, err := s.Inhume(context.Background(), inhumePrm); err != nil {
+					failed++
+				}
+			}
+		}
+	}
+
+	for i := range locks {
+		err := s.Lock(locks[i].addr.Container(), locks[i].locker, []oid.ID{locks[i].addr.Object()})
+		if err != nil {
+			if ignoreErrors {
+				failed++
+				continue
+			}
+			return 0, fmt.Errorf("could not restore lock of %s: %w", locks[i].addr, err)
+		}
+	}
+	return failed, nil
+}
@@ -0,0 +1,314 @@
+package shard
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// ErrScrubInProgress is returned when scrub is started while the previous one is running.
+var ErrScrubInProgress = logicerr.New("scrub is already in progress")
+
+// maxReportedCorrupted is the maximum number of corrupted object addresses kept in the scrub state.
+const maxReportedCorrupted = 1000
+
+var errScrubStopped = errors.New("scrub was stopped")
+
+// ScrubPrm groups the parameters of StartScrub operation.
+type ScrubPrm struct {
+	rateLimit uint32
+}
+
+// SetRateLimit sets the maximum number of objects checked per second.
+// Zero means no limit.
+func (p *ScrubPrm) SetRateLimit(v uint32) {
+	p.rateLimit = v
+}
+
+// ScrubState represents the state of the last started payload integrity check.
+type ScrubState struct {
+	running          bool
+	startedAt        time.Time
+	finishedAt       time.Time
+	objectsChecked   uint64
+	objectsCorrupted uint64
+	corrupted        []oid.Address
+	errMessage       string
+}
+
+// Running returns true if the scrub is in progress.
+func (s ScrubState) Running() bool {
+	return s.running
+}
+
+// StartedAt returns the time scrub was started at, zero if it was never started.
+func (s ScrubState) StartedAt() time.Time {
+	return s.startedAt
+}
+
+// FinishedAt returns the time scrub was finished at, zero if it is not finished.
+func (s ScrubState) FinishedAt() time.Time {
+	return s.finishedAt
+}
+
+// ObjectsChecked returns the amount of checked objects.
+func (s ScrubState) ObjectsChecked() uint64 {
+	return s.objectsChecked
+}
+
+// ObjectsCorrupted returns the amount of detected corrupted objects.
+func (s ScrubState) ObjectsCorrupted() uint64 {
+	return s.objectsCorrupted
+}
+
+// CorruptedObjects returns the addresses of the detected corrupted objects.
+// Only the first 1000 addresses are kept.
+func (s ScrubState) CorruptedObjects() []oid.Address {
+	return s.corrupted
+}
+
+// ErrorMessage returns the error scrub has finished with, if any.
+func (s ScrubState) ErrorMessage() string {
+	return s.errMessage
+}
+
+type scrubber struct {
+	mtx    sync.Mutex
+	state  ScrubState
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// StartScrub starts checking the integrity of the objects stored in blobstor.
+// Each object is read and its identifier, signature and payload checksum are
+// verified. The object header is compared with the one stored in metabase.
+// Corrupted objects are marked with GC mark so that they are removed and
+// replicated again from the healthy nodes by the policer. Scrub is performed
+// in background, its state can be obtained with ScrubState. Scrub is stopped
+// if shard mode is changed or the shard is closed.
+//
+// Returns ErrScrubInProgress if the scrub is already running.
+func (s *Shard) StartScrub(prm ScrubPrm) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	if s.info.Mode.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	s.scrubber.mtx.Lock()
+	defer s.scrubber.mtx.Unlock()
+
+	if s.scrubber.state.running {
+		return ErrScrubInProgress
+	}
+
+	var ctx context.Context
+	ctx, s.scrubber.cancel = context.WithCancel(context.Background())
+	s.scrubber.done = make(chan struct{})
+	s.scrubber.state = ScrubState{
+		running:   true,
+		startedAt: time.Now().UTC(),
+	}
+
+	go s.scrub(ctx, prm, s.scrubber.done)
+	return nil
+}
+
+func (s *Shard) scrub(ctx context.Context, prm ScrubPrm, done chan struct{}) {
+	defer close(done)
+
+	s.log.Info("started payload integrity check", zap.Uint32("rate_limit", prm.rateLimit))
+
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	if prm.rateLimit > 0 {
+		limiter = rate.NewLimiter(rate.Limit(prm.rateLimit), 1)
+	}
+
+	var iterPrm common.IteratePrm
+	iterPrm.IgnoreErrors = true
+	iterPrm.Handler = func(elem common.IterationElement) error {
+		if err := limiter.Wait(ctx); err != nil {
+			return errScrubStopped
+		}
+
+		s.checkObject(elem.Address, elem.ObjectData, nil)
+		return nil
+	}
+	iterPrm.ErrorHandler = func(addr oid.Address, err error) error {
+		if ctx.Err() != nil {
+			return errScrubStopped
+		}
+
+		s.checkObject(addr, nil, err)
+		return nil
+	}
+
+	_, err := s.blobStor.Iterate(iterPrm)
+	if err == nil && ctx.Err() != nil {
+		// blobstor ignores handler errors if IgnoreErrors is set
+		err = errScrubStopped
+	}
+
+	s.scrubber.mtx.Lock()
+	s.scrubber.state.running = false
+	s.scrubber.state.finishedAt = time.Now().UTC()
+	if err != nil {
+		s.scrubber.state.errMessage = err.Error()
+	}
+	state := s.scrubber.state
+	s.scrubber.mtx.Unlock()
+
+	if err != nil {
+		s.log.Error("payload integrity check failed",
+			zap.Uint64("checked_objects", state.objectsChecked),
+			zap.Uint64("corrupted_objects", state.objectsCorrupted),
+			zap.Error(err))
+		return
+	}
+
+	s.log.Info("payload integrity check completed",
+		zap.Uint64("checked_objects", state.objectsChecked),
+		zap.Uint64("corrupted_objects", state.objectsCorrupted))
+}
+
+// checkObject verifies the object read from blobstor. readErr is the
+// error occurred during the object reading, if any.
+func (s *Shard) checkObject(addr oid.Address, data []byte, readErr error) {
+	var zeroAddr oid.Address
+	if addr == zeroAddr {
+		// nothing to check or mark, blobstor logs such errors itself
+		return
+	}
+
+	var getPrm meta.GetPrm
+	getPrm.SetAddress(addr)
+	getPrm.SetRaw(true)
+
+	res, err := s.metaBase.Get(getPrm)
+	if err != nil {
+		// objects missing in metabase or already removed
+		// are handled by GC, not a subject of the check
+		return
+	}
+
+	s.scrubber.mtx.Lock()
+	s.scrubber.state.objectsChecked++
+	s.scrubber.mtx.Unlock()
+
+	if s.metricsWriter != nil {
+		s.metricsWriter.IncScrubbedObjects()
+	}
+
+	if readErr == nil {
+		readErr = verifyObject(addr, data, res.Header())
+	}
+
+	if readErr != nil {
+		s.reportCorrupted(addr, readErr)
+	}
+}
+
+func verifyObject(addr oid.Address, data []byte, hdr *objectSDK.Object) error {
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return fmt.Errorf("could not unmarshal object: %w", err)
+	}
+
+	if objectCore.AddressOf(obj) != addr {
+		return fmt.Errorf("address mismatch: object has %s", objectCore.AddressOf(obj))
+	}
+
+	if err := objectSDK.CheckVerificationFields(obj); err != nil {
+		return err
+	}
+
+	if obj.PayloadSize() != hdr.PayloadSize() {
+		return fmt.Errorf("payload size mismatch: %d in blobstor, %d in metabase",
+			obj.PayloadSize(), hdr.PayloadSize())
+	}
+
+	cs, _ := obj.PayloadChecksum()
+	metaCS, _ := hdr.PayloadChecksum()
+	if cs.Type() != metaCS.Type() || !bytes.Equal(cs.Value(), metaCS.Value()) {
+		return errors.New("payload checksum differs from the one in metabase")
+	}
+
+	return nil
+}
+
+func (s *Shard) reportCorrupted(addr oid.Address, reason error) {
+	s.log.Error("corrupted object detected",
+		zap.Stringer("address", addr),
+		zap.String("reason", reason.Error()))
+
+	s.scrubber.mtx.Lock()
+	s.scrubber.state.objectsCorrupted++
+	if len(s.scrubber.state.corrupted) < maxReportedCorrupted {
+		s.scrubber.state.corrupted = append(s.scrubber.state.corrupted, addr)
+	}
+	s.scrubber.mtx.Unlock()
+
+	if s.metricsWriter != nil {
+		s.metricsWriter.IncCorruptedObjects()
+	}
+
+	// Shard mutex is not taken here since mode change waits for
+	// the scrub to finish.
+	var prm meta.InhumePrm
+	prm.SetAddresses(addr)
+	prm.SetGCMark()
+
+	res, err := s.metaBase.Inhume(prm)
+	if err != nil {
+		s.log.Error("could not mark corrupted object as garbage",
+			zap.Stringer("address", addr),
+			zap.Error(err))
+		return
+	}
+
+	s.decObjectCounterBy(logical, res.AvailableInhumed())
+
+	for i := 0; i < res.GetDeletionInfoLength(); i++ {
+		delInfo := res.GetDeletionInfoByIndex(i)
+		s.addToContainerSize(delInfo.CID.EncodeToString(), -int64(delInfo.Size))
+	}
+}
+
+// ScrubState returns the state of the last started scrub.
+func (s *Shard) ScrubState() ScrubState {
+	s.scrubber.mtx.Lock()
+	defer s.scrubber.mtx.Unlock()
+
+	st := s.scrubber.state
+	st.corrupted = append([]oid.Address(nil), st.corrupted...)
+	return st
+}
+
+// StopScrub stops the running scrub and waits until it is finished.
+// Does nothing if scrub is not running.
+func (s *Shard) StopScrub() {
+	s.scrubber.mtx.Lock()
+	cancel, done := s.scrubber.cancel, s.scrubber.done
+	s.scrubber.mtx.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
@@ -0,0 +1,124 @@
+package shard_test
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_Scrub(t *testing.T) {
+	dir := t.TempDir()
+	sh, mm := shardWithMetrics(t, dir)
+
+	cnr := cidtest.ID()
+
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var addrs []oid.Address
+	for i := 0; i < 10; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		addPayload(obj, 1024)
+		object.CalculateAndSetPayloadChecksum(obj)
+		require.NoError(t, object.SetIDWithSignature(key.PrivateKey, obj))
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(obj)
+
+		_, err := sh.Put(context.Background(), putPrm)
+		require.NoError(t, err)
+
+		addrs = append(addrs, objectcore.AddressOf(obj))
+	}
+
+	corrupted := addrs[3]
+	corruptObjectFile(t, filepath.Join(dir, "blob"), corrupted)
+
+	var prm shard.ScrubPrm
+	require.NoError(t, sh.StartScrub(prm))
+
+	require.Eventually(t, func() bool {
+		return !sh.ScrubState().Running()
+	}, 10*time.Second, 10*time.Millisecond)
+
+	st := sh.ScrubState()
+	require.Empty(t, st.ErrorMessage())
+	require.Equal(t, uint64(len(addrs)), st.ObjectsChecked())
+	require.Equal(t, uint64(1), st.ObjectsCorrupted())
+	require.Equal(t, []oid.Address{corrupted}, st.CorruptedObjects())
+	require.False(t, st.FinishedAt().Before(st.StartedAt()))
+
+	require.Equal(t, len(addrs), mm.scrubbed)
+	require.Equal(t, 1, mm.corrupted)
+
+	for _, addr := range addrs {
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(addr)
+
+		_, err := sh.Get(context.Background(), getPrm)
+		if addr == corrupted {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("stop", func(t *testing.T) {
+		prm.SetRateLimit(1)
+		require.NoError(t, sh.StartScrub(prm))
+		require.ErrorIs(t, sh.StartScrub(prm), shard.ErrScrubInProgress)
+
+		sh.StopScrub()
+
+		st := sh.ScrubState()
+		require.False(t, st.Running())
+		require.NotEmpty(t, st.ErrorMessage())
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(mode.ReadOnly))
+		require.ErrorIs(t, sh.StartScrub(prm), shard.ErrReadOnlyMode)
+	})
+}
+
+// corruptObjectFile flips the last byte of the object file stored in FSTree.
+func corruptObjectFile(t *testing.T, root string, addr oid.Address) {
+	var found bool
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		// object identifier prefix is used as the directory names
+		rel, _ := filepath.Rel(root, path)
+		if !strings.HasPrefix(strings.ReplaceAll(rel, string(filepath.Separator), ""), addr.Object().EncodeToString()) {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		data[len(data)-1] ^= 0xFF
+		found = true
+
+		return os.WriteFile(path, data, 0600)
+	})
+	require.NoError(t, err)
+	require.True(t, found)
+}
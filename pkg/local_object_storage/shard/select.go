@@ -1,18 +1,25 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // SelectPrm groups the parameters of Select operation.
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+
+	limit      int
+	startAfter *oid.ID
 }
 
 // SelectRes groups the resulting values of Select operation.
@@ -30,6 +37,18 @@ func (p *SelectPrm) SetFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// SetLimit is a Select option to set the maximum number of the selected objects.
+// If the limit is set, the objects are selected in the ascending order of their IDs.
+func (p *SelectPrm) SetLimit(limit int) {
+	p.limit = limit
+}
+
+// SetStartAfter is a Select option to select the objects with IDs greater
+// than id. It is applied only if the limit is set.
+func (p *SelectPrm) SetStartAfter(id oid.ID) {
+	p.startAfter = &id
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
@@ -39,7 +58,16 @@ func (r SelectRes) AddressList() []oid.Address {
 //
 // Returns any error encountered that
 // did not allow to completely select the objects.
-func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
+func (s *Shard) Select(ctx context.Context, prm SelectPrm) (SelectRes, error) {
+	defer s.observeDuration("Select")()
+
+	_, span := tracing.StartSpanFromContext(ctx, "Shard.Select",
+		trace.WithAttributes(
+			attribute.String("shard_id", s.idString()),
+			attribute.String("container_id", prm.cnr.EncodeToString()),
+		))
+	defer span.End()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -50,6 +78,10 @@ func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
 	var selectPrm meta.SelectPrm
 	selectPrm.SetFilters(prm.filters)
 	selectPrm.SetContainerID(prm.cnr)
+	selectPrm.SetLimit(prm.limit)
+	if prm.startAfter != nil {
+		selectPrm.SetStartAfter(*prm.startAfter)
+	}
 
 	mRes, err := s.metaBase.Select(selectPrm)
 	if err != nil {
@@ -60,3 +92,47 @@ func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
 		addrList: mRes.AddressList(),
 	}, nil
 }
+
+// SelectIndexedPrm groups the parameters of SelectIndexed operation.
+type SelectIndexedPrm struct {
+	meta.SelectIndexedPrm
+}
+
+// SelectIndexedRes groups the resulting values of SelectIndexed operation.
+type SelectIndexedRes struct {
+	objects []meta.IndexedObject
+}
+
+// Objects returns the selected objects in the requested order.
+func (r SelectIndexedRes) Objects() []meta.IndexedObject {
+	return r.objects
+}
+
+// SelectIndexed selects the objects from shard using the sorted attribute index.
+//
+// Returns meta.ErrAttributeNotIndexed if the container has no index on the attribute.
+func (s *Shard) SelectIndexed(ctx context.Context, prm SelectIndexedPrm) (SelectIndexedRes, error) {
+	defer s.observeDuration("SelectIndexed")()
+
+	_, span := tracing.StartSpanFromContext(ctx, "Shard.SelectIndexed",
+		trace.WithAttributes(
+			attribute.String("shard_id", s.idString()),
+		))
+	defer span.End()
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() {
+		return SelectIndexedRes{}, ErrDegradedMode
+	}
+
+	mRes, err := s.metaBase.SelectIndexed(prm.SelectIndexedPrm)
+	if err != nil {
+		return SelectIndexedRes{}, fmt.Errorf("could not select objects from metabase: %w", err)
+	}
+
+	return SelectIndexedRes{
+		objects: mRes.Objects(),
+	}, nil
+}
@@ -31,6 +31,14 @@ type Shard struct {
 	metaBase *meta.DB
 
 	tsSource TombstoneSource
+
+	rebuilder *rebuilder
+
+	scrubber *scrubber
+
+	tierer *tierer
+
+	ioLimiter *ioLimiter
 }
 
 // Option represents Shard's constructor option.
@@ -70,11 +78,41 @@ type MetricsWriter interface {
 	SetShardID(id string)
 	// SetReadonly must set shard readonly state.
 	SetReadonly(readonly bool)
+	// AddMethodDuration must add the duration of the shard operation.
+	AddMethodDuration(method string, d time.Duration)
+	// AddStorageMethodDuration must add the duration of the operation
+	// of the blobstor sub-storage or the write-cache.
+	AddStorageMethodDuration(storage, method string, d time.Duration)
+	// IncScrubbedObjects must increment the counter of objects checked by the scrubber.
+	IncScrubbedObjects()
+	// IncCorruptedObjects must increment the counter of corrupted objects found by the scrubber.
+	IncCorruptedObjects()
+	// SetWriteCacheFillRatio must set the estimated fraction of the write-cache capacity in use.
+	SetWriteCacheFillRatio(v float64)
+	// SetWriteCacheFlushLag must set the time the oldest write-cache object waits to be flushed.
+	SetWriteCacheFlushLag(d time.Duration)
+	// AddIOOperations must add the number of the objects affected by the operations
+	// of the I/O class and the time the operations were throttled for.
+	AddIOOperations(class string, ops int, throttled time.Duration)
+	// AddIOBytes must add the amount of object data read or written by
+	// the operations of the I/O class.
+	AddIOBytes(class string, size uint64)
+	// AddGCObjects must add the number of objects of the kind handled
+	// by the garbage collector.
+	AddGCObjects(kind string, n int)
+	// SetGCQueueLength must set the number of objects waiting for the removal.
+	SetGCQueueLength(v uint64)
+	// SetGCLastRun must set the time of the last garbage removal run.
+	SetGCLastRun(t time.Time)
 }
 
 type cfg struct {
 	m sync.RWMutex
 
+	// dumpMtx is read-locked during the dump to prevent
+	// the garbage removal of the dumped objects.
+	dumpMtx sync.RWMutex
+
 	refillMetabase bool
 
 	rmBatchSize int
@@ -95,10 +133,16 @@ type cfg struct {
 
 	gcCfg gcCfg
 
+	tieringCfg tieringCfg
+
+	ioLimits [ioClassCount]IOLimits
+
 	expiredTombstonesCallback ExpiredTombstonesCallback
 
 	expiredLocksCallback ExpiredObjectsCallback
 
+	expiredObjectsCallback ExpiredObjectsCallback
+
 	deletedLockCallBack DeletedLockCallback
 
 	tsSource TombstoneSource
@@ -125,14 +169,27 @@ func New(opts ...Option) *Shard {
 		opts[i](c)
 	}
 
-	bs := blobstor.New(c.blobOpts...)
+	blobOpts := c.blobOpts
+	writeCacheOpts := c.writeCacheOpts
+	if c.metricsWriter != nil {
+		blobOpts = append(blobOpts[:len(blobOpts):len(blobOpts)],
+			blobstor.WithMetrics(blobstorMetrics{w: c.metricsWriter}))
+		writeCacheOpts = append(writeCacheOpts[:len(writeCacheOpts):len(writeCacheOpts)],
+			writecache.WithMetrics(writeCacheMetrics{w: c.metricsWriter}))
+	}
+
+	bs := blobstor.New(blobOpts...)
 	mb := meta.New(c.metaOpts...)
 
 	s := &Shard{
-		cfg:      c,
-		blobStor: bs,
-		metaBase: mb,
-		tsSource: c.tsSource,
+		cfg:       c,
+		blobStor:  bs,
+		metaBase:  mb,
+		tsSource:  c.tsSource,
+		rebuilder: new(rebuilder),
+		scrubber:  new(scrubber),
+		tierer:    newTierer(),
+		ioLimiter: newIOLimiter(c.ioLimits),
 	}
 
 	reportFunc := func(msg string, err error) {
@@ -143,7 +200,7 @@ func New(opts ...Option) *Shard {
 
 	if c.useWriteCache {
 		s.writeCache = writecache.New(
-			append(c.writeCacheOpts,
+			append(writeCacheOpts,
 				writecache.WithReportErrorFunc(reportFunc),
 				writecache.WithBlobstor(bs),
 				writecache.WithMetabase(mb))...)
@@ -258,6 +315,14 @@ func WithExpiredLocksCallback(cb ExpiredObjectsCallback) Option {
 	}
 }
 
+// WithExpiredObjectsCallback returns option to specify callback
+// of the expired objects marked as garbage by the GC.
+func WithExpiredObjectsCallback(cb ExpiredObjectsCallback) Option {
+	return func(c *cfg) {
+		c.expiredObjectsCallback = cb
+	}
+}
+
 // WithRefillMetabase returns option to set flag to refill the Metabase on Shard's initialization step.
 func WithRefillMetabase(v bool) Option {
 	return func(c *cfg) {
@@ -388,6 +453,45 @@ func (s *Shard) addToContainerSize(cnr string, size int64) {
 	}
 }
 
+// observeDuration returns the function reporting the duration
+// of the shard operation since the call.
+func (s *Shard) observeDuration(method string) func() {
+	if s.cfg.metricsWriter == nil {
+		return func() {}
+	}
+
+	t := time.Now()
+	return func() {
+		s.cfg.metricsWriter.AddMethodDuration(method, time.Since(t))
+	}
+}
+
+// blobstorMetrics reports blobstor sub-storage metrics to the shard metrics writer.
+type blobstorMetrics struct {
+	w MetricsWriter
+}
+
+func (m blobstorMetrics) AddMethodDuration(storage, method string, d time.Duration) {
+	m.w.AddStorageMethodDuration(storage, method, d)
+}
+
+// writeCacheMetrics reports write-cache metrics to the shard metrics writer.
+type writeCacheMetrics struct {
+	w MetricsWriter
+}
+
+func (m writeCacheMetrics) AddMethodDuration(method string, d time.Duration) {
+	m.w.AddStorageMethodDuration("writecache", method, d)
+}
+
+func (m writeCacheMetrics) SetFillRatio(v float64) {
+	m.w.SetWriteCacheFillRatio(v)
+}
+
+func (m writeCacheMetrics) SetFlushLag(d time.Duration) {
+	m.w.SetWriteCacheFlushLag(d)
+}
+
 func (s *Shard) addToPayloadSize(size int64) {
 	if s.cfg.metricsWriter != nil {
 		s.cfg.metricsWriter.AddToPayloadSize(size)
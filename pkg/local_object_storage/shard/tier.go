@@ -0,0 +1,228 @@
+package shard
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+type tieringCfg struct {
+	coldAfter     time.Duration
+	interval      time.Duration
+	maxObjectSize uint64
+}
+
+// tierer moves the objects which are not accessed for a while from the hot
+// blobstor sub-storage to the cold one.
+//
+// To keep access tracking cheap, only the reads of the objects stored in the hot
+// sub-storage are remembered and only for the cold period: an object is cold if
+// it was neither written nor read during this period. Since the reads are kept in
+// memory, the period is also counted from the start of the tracking.
+type tierer struct {
+	mtx sync.Mutex
+	// accessed maps the addresses of the objects in the hot sub-storage
+	// to the time of their last read.
+	accessed map[oid.Address]time.Time
+	// since is the time the access tracking was started at.
+	since time.Time
+
+	// moveMtx is locked exclusively while an object is moved between
+	// the sub-storages and shared by the object removal.
+	moveMtx sync.RWMutex
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newTierer() *tierer {
+	return &tierer{accessed: make(map[oid.Address]time.Time)}
+}
+
+// WithTiering returns option to move the objects neither written nor read
+// during coldAfter from the hot blobstor sub-storage to the cold one. Cold
+// objects are looked for every interval. Objects bigger than maxObjectSize
+// are never moved, zero means no limit. Zero coldAfter disables tiering.
+// See blobstor.BlobStor.Migrate for the sub-storage roles.
+func WithTiering(coldAfter, interval time.Duration, maxObjectSize uint64) Option {
+	return func(c *cfg) {
+		c.tieringCfg = tieringCfg{
+			coldAfter:     coldAfter,
+			interval:      interval,
+			maxObjectSize: maxObjectSize,
+		}
+	}
+}
+
+func (s *Shard) tieringEnabled() bool {
+	return s.tieringCfg.coldAfter > 0 && s.tieringCfg.interval > 0
+}
+
+// touchObject remembers the read of the object stored in the hot sub-storage.
+func (s *Shard) touchObject(addr oid.Address) {
+	if !s.tieringEnabled() {
+		return
+	}
+
+	s.tierer.mtx.Lock()
+	s.tierer.accessed[addr] = time.Now()
+	s.tierer.mtx.Unlock()
+}
+
+// isCold returns true if the object was neither written nor read after the threshold.
+func (t *tierer) isCold(addr oid.Address, modTime, threshold time.Time) bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	last := t.since
+	if modTime.After(last) {
+		last = modTime
+	}
+	if accessed := t.accessed[addr]; accessed.After(last) {
+		last = accessed
+	}
+	return last.Before(threshold)
+}
+
+// prune forgets the reads happened before the threshold, they don't affect
+// the object temperature anymore.
+func (t *tierer) prune(threshold time.Time) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	for addr, accessed := range t.accessed {
+		if accessed.Before(threshold) {
+			delete(t.accessed, addr)
+		}
+	}
+}
+
+// startTiering starts the background migration of the cold objects if it is enabled.
+func (s *Shard) startTiering() {
+	if !s.tieringEnabled() {
+		return
+	}
+
+	s.tierer.mtx.Lock()
+	defer s.tierer.mtx.Unlock()
+
+	if s.tierer.stopCh != nil {
+		return
+	}
+
+	s.tierer.since = time.Now()
+	s.tierer.stopCh = make(chan struct{})
+	s.tierer.wg.Add(1)
+
+	go s.tieringLoop(s.tierer.stopCh)
+}
+
+func (s *Shard) tieringLoop(stopCh chan struct{}) {
+	defer s.tierer.wg.Done()
+
+	t := time.NewTicker(s.tieringCfg.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-t.C:
+			s.migrateCold()
+		}
+	}
+}
+
+// stopTiering stops the background migration and waits until it is finished.
+func (s *Shard) stopTiering() {
+	s.tierer.mtx.Lock()
+	stopCh := s.tierer.stopCh
+	s.tierer.stopCh = nil
+	if stopCh != nil {
+		close(stopCh)
+	}
+	s.tierer.mtx.Unlock()
+
+	s.interruptMigration()
+	s.tierer.wg.Wait()
+}
+
+// interruptMigration cancels running migration and waits until it is finished.
+// The next migration is started according to the schedule.
+func (s *Shard) interruptMigration() {
+	s.tierer.mtx.Lock()
+	cancel, done := s.tierer.cancel, s.tierer.done
+	s.tierer.mtx.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// startMigration prepares the migration context. Returns false if the
+// migration must not be performed in the current shard mode or tiering is stopped.
+func (s *Shard) startMigration() (context.Context, chan struct{}, bool) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode != mode.ReadWrite {
+		return nil, nil, false
+	}
+
+	s.tierer.mtx.Lock()
+	defer s.tierer.mtx.Unlock()
+
+	if s.tierer.stopCh == nil {
+		return nil, nil, false
+	}
+
+	var ctx context.Context
+	ctx, s.tierer.cancel = context.WithCancel(context.Background())
+	s.tierer.done = make(chan struct{})
+	return ctx, s.tierer.done, true
+}
+
+// migrateCold moves the cold objects from the hot sub-storage to the cold one.
+func (s *Shard) migrateCold() {
+	ctx, done, ok := s.startMigration()
+	if !ok {
+		return
+	}
+	defer close(done)
+
+	threshold := time.Now().Add(-s.tieringCfg.coldAfter)
+	s.tierer.prune(threshold)
+
+	s.log.Debug("started cold objects migration")
+
+	res, err := s.blobStor.Migrate(ctx, blobstor.MigratePrm{
+		MetaStorage:   (*metaStorage)(s),
+		MaxObjectSize: s.tieringCfg.maxObjectSize,
+		MoveLock:      &s.tierer.moveMtx,
+		IsCold: func(addr oid.Address, modTime time.Time) bool {
+			return s.tierer.isCold(addr, modTime, threshold)
+		},
+	})
+	if err != nil && ctx.Err() != nil {
+		s.log.Debug("cold objects migration interrupted",
+			zap.Uint64("moved_objects", res.ObjectsMoved))
+		return
+	} else if err != nil {
+		s.log.Error("cold objects migration failed",
+			zap.Uint64("moved_objects", res.ObjectsMoved),
+			zap.Error(err))
+		return
+	}
+
+	s.log.Info("cold objects migration completed",
+		zap.Uint64("moved_objects", res.ObjectsMoved))
+}
@@ -0,0 +1,120 @@
+package shard_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestShard_Tiering(t *testing.T) {
+	dir := t.TempDir()
+
+	hot := fstree.New(
+		fstree.WithPath(filepath.Join(dir, "fstree")),
+		fstree.WithDepth(1))
+
+	sh := shard.New(
+		shard.WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+		shard.WithBlobStorOptions(
+			blobstor.WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+			blobstor.WithStorages([]blobstor.SubStorage{
+				{
+					Storage: blobovniczatree.NewBlobovniczaTree(
+						blobovniczatree.WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+						blobovniczatree.WithRootPath(filepath.Join(dir, "blobovnicza")),
+						blobovniczatree.WithBlobovniczaShallowDepth(1),
+						blobovniczatree.WithBlobovniczaShallowWidth(1)),
+					Policy: func(*object.Object, []byte) bool {
+						// all objects are written to the hot sub-storage
+						return false
+					},
+				},
+				{Storage: hot},
+			})),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(dir, "meta")),
+			meta.WithEpochState(epochState{})),
+		shard.WithTiering(300*time.Millisecond, 50*time.Millisecond, 2048))
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	defer releaseShard(sh, t)
+
+	cnr := cidtest.ID()
+
+	put := func(size int) oid.Address {
+		obj := generateObjectWithCID(t, cnr)
+		addPayload(obj, size)
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(obj)
+
+		_, err := sh.Put(context.Background(), putPrm)
+		require.NoError(t, err)
+		return objectCore.AddressOf(obj)
+	}
+
+	get := func(addr oid.Address) {
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(addr)
+
+		_, err := sh.Get(context.Background(), getPrm)
+		require.NoError(t, err)
+	}
+
+	getRange := func(addr oid.Address) {
+		var rngPrm shard.RngPrm
+		rngPrm.SetAddress(addr)
+		rngPrm.SetRange(10, 20)
+
+		_, err := sh.GetRange(context.Background(), rngPrm)
+		require.NoError(t, err)
+	}
+
+	inHot := func(addr oid.Address) bool {
+		res, err := hot.Exists(common.ExistsPrm{Address: addr})
+		require.NoError(t, err)
+		return res.Exists
+	}
+
+	cold := put(100)
+	read := put(100)
+	ranged := put(100)
+	big := put(4096)
+
+	require.Eventually(t, func() bool {
+		// keep the objects hot
+		get(read)
+		getRange(ranged)
+		return !inHot(cold)
+	}, 5*time.Second, 20*time.Millisecond)
+
+	require.True(t, inHot(read))
+	require.True(t, inHot(ranged))
+	require.True(t, inHot(big))
+
+	get(cold)
+
+	t.Run("reopen", func(t *testing.T) {
+		require.NoError(t, sh.Close())
+		require.NoError(t, sh.Open())
+		require.NoError(t, sh.Init())
+
+		get(cold)
+		get(read)
+	})
+}
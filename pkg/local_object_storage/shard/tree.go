@@ -80,6 +80,14 @@ func (s *Shard) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID pilorama.No
 	return s.pilorama.TreeGetChildren(cid, treeID, nodeID)
 }
 
+// TreeListChildren implements the pilorama.Forest interface.
+func (s *Shard) TreeListChildren(cid cidSDK.ID, treeID string, nodeID pilorama.Node, prm pilorama.ListChildrenPrm) ([]pilorama.Node, []byte, error) {
+	if s.pilorama == nil {
+		return nil, nil, ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeListChildren(cid, treeID, nodeID, prm)
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (s *Shard) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (pilorama.Move, error) {
 	if s.pilorama == nil {
@@ -111,3 +119,72 @@ func (s *Shard) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
 	}
 	return s.pilorama.TreeExists(cid, treeID)
 }
+
+// TreeCompactLog implements the pilorama.Forest interface.
+func (s *Shard) TreeCompactLog(cid cidSDK.ID, treeID string, height uint64) error {
+	if s.pilorama == nil {
+		return ErrPiloramaDisabled
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	return s.pilorama.TreeCompactLog(cid, treeID, height)
+}
+
+// TreeLogHorizon implements the pilorama.Forest interface.
+func (s *Shard) TreeLogHorizon(cid cidSDK.ID, treeID string) (uint64, error) {
+	if s.pilorama == nil {
+		return 0, ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeLogHorizon(cid, treeID)
+}
+
+// TreeLastSyncHeight implements the pilorama.Forest interface.
+func (s *Shard) TreeLastSyncHeight(cid cidSDK.ID, treeID string) (uint64, error) {
+	if s.pilorama == nil {
+		return 0, ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeLastSyncHeight(cid, treeID)
+}
+
+// TreeUpdateLastSyncHeight implements the pilorama.Forest interface.
+func (s *Shard) TreeUpdateLastSyncHeight(cid cidSDK.ID, treeID string, height uint64) error {
+	if s.pilorama == nil {
+		return ErrPiloramaDisabled
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	return s.pilorama.TreeUpdateLastSyncHeight(cid, treeID, height)
+}
+
+// TreeSnapshot implements the pilorama.Forest interface.
+func (s *Shard) TreeSnapshot(cid cidSDK.ID, treeID string, f func(uint64, []pilorama.SnapshotNode) error) error {
+	if s.pilorama == nil {
+		return ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeSnapshot(cid, treeID, f)
+}
+
+// TreeRestore implements the pilorama.Forest interface.
+func (s *Shard) TreeRestore(d pilorama.CIDDescriptor, treeID string, height uint64, next func() ([]pilorama.SnapshotNode, error)) error {
+	if s.pilorama == nil {
+		return ErrPiloramaDisabled
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	return s.pilorama.TreeRestore(d, treeID, height, next)
+}
@@ -2,11 +2,15 @@ package shard
 
 import (
 	"errors"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 )
 
 // FlushWriteCachePrm represents parameters of a `FlushWriteCache` operation.
 type FlushWriteCachePrm struct {
 	ignoreErrors bool
+	disable      bool
 }
 
 // SetIgnoreErrors sets the flag to ignore read-errors during flush.
@@ -14,6 +18,13 @@ func (p *FlushWriteCachePrm) SetIgnoreErrors(ignore bool) {
 	p.ignoreErrors = ignore
 }
 
+// SetDisable sets the flag to leave the write-cache in read-only mode after
+// the flush, so that new objects are put to the blobstor directly. Write-cache
+// is enabled back when the shard mode is set to read-write again.
+func (p *FlushWriteCachePrm) SetDisable(disable bool) {
+	p.disable = disable
+}
+
 // errWriteCacheDisabled is returned when an operation on write-cache is performed,
 // but write-cache is disabled.
 var errWriteCacheDisabled = errors.New("write-cache is disabled")
@@ -24,9 +35,43 @@ func (s *Shard) FlushWriteCache(p FlushWriteCachePrm) error {
 		return errWriteCacheDisabled
 	}
 
+	if p.disable {
+		// Stop accepting new objects before the flush, so that
+		// nothing is left in the write-cache after it.
+		if err := s.disableWriteCache(); err != nil {
+			return err
+		}
+	}
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
+	if err := s.checkFlushMode(); err != nil {
+		return err
+	}
+
+	return s.writeCache.Flush(p.ignoreErrors)
+}
+
+// disableWriteCache switches the write-cache to read-only mode until the shard
+// mode is set to read-write again.
+func (s *Shard) disableWriteCache() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if err := s.checkFlushMode(); err != nil {
+		return err
+	}
+
+	if err := s.writeCache.SetMode(mode.ReadOnly); err != nil {
+		return fmt.Errorf("could not disable write-cache: %w", err)
+	}
+
+	s.info.WriteCacheDisabled = true
+	return nil
+}
+
+func (s *Shard) checkFlushMode() error {
 	// To write data to the blobstor we need to write to the blobstor and the metabase.
 	if s.info.Mode.ReadOnly() {
 		return ErrReadOnlyMode
@@ -34,6 +79,5 @@ func (s *Shard) FlushWriteCache(p FlushWriteCachePrm) error {
 	if s.info.Mode.NoMetabase() {
 		return ErrDegradedMode
 	}
-
-	return s.writeCache.Flush(p.ignoreErrors)
+	return nil
 }
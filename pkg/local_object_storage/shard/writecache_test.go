@@ -0,0 +1,39 @@
+package shard_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_FlushWriteCacheDisable(t *testing.T) {
+	sh := newShard(t, true)
+	defer releaseShard(sh, t)
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(generateObject(t))
+
+	_, err := sh.Put(context.Background(), putPrm)
+	require.NoError(t, err)
+
+	var flushPrm shard.FlushWriteCachePrm
+	flushPrm.SetDisable(true)
+	require.NoError(t, sh.FlushWriteCache(flushPrm))
+
+	require.True(t, sh.DumpInfo().WriteCacheDisabled)
+	require.Equal(t, mode.ReadWrite, sh.GetMode())
+
+	// objects are put to the blobstor directly
+	putPrm.SetObject(generateObject(t))
+	_, err = sh.Put(context.Background(), putPrm)
+	require.NoError(t, err)
+
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+	require.True(t, sh.DumpInfo().WriteCacheDisabled)
+
+	require.NoError(t, sh.SetMode(mode.ReadWrite))
+	require.False(t, sh.DumpInfo().WriteCacheDisabled)
+}
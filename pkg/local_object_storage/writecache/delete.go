@@ -11,6 +11,8 @@ import (
 //
 // Returns an error of type apistatus.ObjectNotFound if object is missing in write-cache.
 func (c *cache) Delete(addr oid.Address) error {
+	defer c.observe("Delete")()
+
 	c.modeMtx.RLock()
 	defer c.modeMtx.RUnlock()
 	if c.readOnly() {
@@ -42,6 +44,7 @@ func (c *cache) Delete(addr oid.Address) error {
 			storagelog.OpField("db DELETE"),
 		)
 		c.objCounters.DecDB()
+		c.scheduler.removePending(saddr)
 		return nil
 	}
 
@@ -53,6 +56,7 @@ func (c *cache) Delete(addr oid.Address) error {
 			storagelog.OpField("fstree DELETE"),
 		)
 		c.objCounters.DecFS()
+		c.scheduler.removePending(saddr)
 	}
 
 	return err
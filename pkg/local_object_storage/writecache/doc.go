@@ -8,4 +8,8 @@
 // To make it possible to serve Read requests after the object was flushed,
 // we maintain an LRU cache containing addresses of all the objects that
 // could be safely deleted. The actual deletion is done during eviction from this cache.
+//
+// Alternatively, write-cache of TypeLog stores all objects in segmented append-only
+// log files with the in-memory index of the objects restored on open. Objects are
+// removed from this write-cache right after the flush.
 package writecache
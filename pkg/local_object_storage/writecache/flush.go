@@ -29,6 +29,7 @@ const (
 )
 
 // runFlushLoop starts background workers which periodically flush objects to the blobstor.
+// The flush rate is controlled by the flushScheduler.
 func (c *cache) runFlushLoop() {
 	for i := 0; i < c.workersCount; i++ {
 		c.wg.Add(1)
@@ -48,6 +49,7 @@ func (c *cache) runFlushLoop() {
 		for {
 			select {
 			case <-tt.C:
+				c.reportFlushState(c.fillRatio(), c.scheduler)
 				c.flushDB()
 				tt.Reset(defaultFlushInterval)
 			case <-c.closeCh:
@@ -162,11 +164,11 @@ func (c *cache) flushBigObjects() {
 	}
 }
 
-func (c *cache) reportFlushError(msg string, addr string, err error) {
-	if c.reportError != nil {
-		c.reportError(msg, err)
+func (o *options) reportFlushError(msg string, addr string, err error) {
+	if o.reportError != nil {
+		o.reportError(msg, err)
 	} else {
-		c.log.Error(msg,
+		o.log.Error(msg,
 			zap.String("address", addr),
 			zap.Error(err))
 	}
@@ -236,22 +238,34 @@ func (c *cache) flushWorker(_ int) {
 		if err == nil {
 			c.flushed.Add(objectCore.AddressOf(obj).EncodeToString(), true)
 		}
+
+		if !c.scheduler.throttle(c.fillRatio(), c.closeCh) {
+			return
+		}
 	}
 }
 
 // flushObject is used to write object directly to the main storage.
 func (c *cache) flushObject(obj *object.Object, data []byte) error {
+	return c.options.flushObject(c.scheduler, obj, data)
+}
+
+// flushObject writes the object to the main storage and updates its storage ID
+// in the metabase. The main storage latency is reported to the scheduler.
+func (o *options) flushObject(s *flushScheduler, obj *object.Object, data []byte) error {
 	addr := objectCore.AddressOf(obj)
 
 	var prm common.PutPrm
 	prm.Object = obj
 	prm.RawData = data
 
-	res, err := c.blobstor.Put(prm)
+	start := time.Now()
+	res, err := o.blobstor.Put(prm)
+	s.observeLatency(time.Since(start))
 	if err != nil {
 		if !errors.Is(err, common.ErrNoSpace) && !errors.Is(err, common.ErrReadOnly) &&
 			!errors.Is(err, blobstor.ErrNoPlaceFound) {
-			c.reportFlushError("can't flush an object to blobstor",
+			o.reportFlushError("can't flush an object to blobstor",
 				addr.EncodeToString(), err)
 		}
 		return err
@@ -261,18 +275,23 @@ func (c *cache) flushObject(obj *object.Object, data []byte) error {
 	updPrm.SetAddress(addr)
 	updPrm.SetStorageID(res.StorageID)
 
-	_, err = c.metabase.UpdateStorageID(updPrm)
+	_, err = o.metabase.UpdateStorageID(updPrm)
 	if err != nil {
-		c.reportFlushError("can't update object storage ID",
+		o.reportFlushError("can't update object storage ID",
 			addr.EncodeToString(), err)
+		return err
 	}
-	return err
+
+	s.removePending(addr.EncodeToString())
+	return nil
 }
 
 // Flush flushes all objects from the write-cache to the main storage.
 // Write-cache must be in readonly mode to ensure correctness of an operation and
 // to prevent interference with background flush workers.
 func (c *cache) Flush(ignoreErrors bool) error {
+	defer c.observe("Flush")()
+
 	c.modeMtx.RLock()
 	defer c.modeMtx.RUnlock()
 
@@ -281,6 +281,21 @@ func TestFlush(t *testing.T) {
 			}
 		}
 	})
+	t.Run("pending objects after restart", func(t *testing.T) {
+		wc, _, _ := newCache(t)
+		putObjects(t, wc)
+		require.NoError(t, wc.Close())
+
+		c := wc.(*cache)
+		c.scheduler = newFlushScheduler()
+
+		require.NoError(t, wc.Open(false))
+		require.NoError(t, wc.Init())
+
+		c.scheduler.mtx.Lock()
+		require.Len(t, c.scheduler.pending, objCount)
+		c.scheduler.mtx.Unlock()
+	})
 }
 
 func putObject(t *testing.T, c Cache, size int) objectPair {
@@ -14,6 +14,12 @@ import (
 //
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in write-cache.
 func (c *cache) Get(addr oid.Address) (*objectSDK.Object, error) {
+	defer c.observe("Get")()
+
+	return c.get(addr)
+}
+
+func (c *cache) get(addr oid.Address) (*objectSDK.Object, error) {
 	saddr := addr.EncodeToString()
 
 	value, err := Get(c.db, []byte(saddr))
@@ -36,7 +42,9 @@ func (c *cache) Get(addr oid.Address) (*objectSDK.Object, error) {
 //
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in write-cache.
 func (c *cache) Head(addr oid.Address) (*objectSDK.Object, error) {
-	obj, err := c.Get(addr)
+	defer c.observe("Head")()
+
+	obj, err := c.get(addr)
 	if err != nil {
 		return nil, err
 	}
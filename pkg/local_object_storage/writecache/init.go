@@ -33,6 +33,8 @@ func (c *cache) initFlushMarks() {
 					)
 				}
 			}
+		} else {
+			c.scheduler.addPending(addr.EncodeToString())
 		}
 		return nil
 	}
@@ -70,6 +72,8 @@ func (c *cache) initFlushMarks() {
 				if needRemove {
 					indices = append(indices, i)
 				}
+			} else {
+				c.scheduler.addPending(m[i])
 			}
 		}
 
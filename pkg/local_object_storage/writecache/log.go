@@ -0,0 +1,625 @@
+package writecache
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	storagelog "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/internal/log"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// logCache is the write-cache storing objects in segmented append-only log files.
+//
+// Every PUT appends a record with the object to the active segment, every flush
+// or DELETE appends a record marking the object as removed. The index of the objects
+// in the cache is kept in memory and is restored from the segments on open.
+// Segments are removed once they contain no objects and the segments with
+// the records they override are removed. Concurrent appends are synchronized
+// to disk with a single fsync.
+type logCache struct {
+	options
+
+	mode    mode.Mode
+	modeMtx sync.RWMutex
+
+	// mtx protects index and segments.
+	mtx   sync.RWMutex
+	index map[oid.Address]logRecord
+	// segments are sorted by ID.
+	segments []*logSegment
+
+	// writeMtx protects appending to the active segment.
+	// active is modified with both writeMtx and mtx taken.
+	writeMtx sync.Mutex
+	active   *logSegment
+	// sealed is true if no records can be appended, in read-only mode.
+	sealed bool
+	// lastID is the ID of the last created segment.
+	lastID uint64
+	// segmentSize is the size after which a new segment is started.
+	segmentSize int64
+
+	// diskSize is the total size of the segments.
+	diskSize atomic.Uint64
+
+	flushCh   chan oid.Address
+	closeCh   chan struct{}
+	wg        sync.WaitGroup
+	scheduler *flushScheduler
+}
+
+func newLogCache(o options) *logCache {
+	return &logCache{
+		options:     o,
+		mode:        mode.ReadWrite,
+		flushCh:     make(chan oid.Address),
+		scheduler:   newFlushScheduler(),
+		index:       make(map[oid.Address]logRecord),
+		segmentSize: logSegmentSize,
+	}
+}
+
+// SetLogger sets logger. It is used after the shard ID was generated to use it in logs.
+func (c *logCache) SetLogger(l *logger.Logger) {
+	c.log = l
+}
+
+func (c *logCache) DumpInfo() Info {
+	return Info{
+		Path: c.path,
+	}
+}
+
+// Open reads the log segments and restores the index of the objects.
+func (c *logCache) Open(readOnly bool) error {
+	if err := util.MkdirAllX(c.path, os.ModePerm); err != nil {
+		return err
+	}
+
+	segments, index, err := loadLog(c.path, readOnly, c.log)
+	if err != nil {
+		return err
+	}
+
+	c.mtx.Lock()
+	c.segments = segments
+	c.index = index
+	c.mtx.Unlock()
+
+	c.writeMtx.Lock()
+	c.active = nil
+	c.sealed = readOnly
+	c.writeMtx.Unlock()
+
+	var size uint64
+	for i := range segments {
+		size += uint64(segments[i].size)
+		c.lastID = segments[i].id
+	}
+	c.diskSize.Store(size)
+
+	for addr := range index {
+		c.scheduler.addPending(addr.EncodeToString())
+	}
+
+	// Opening after Close is done during maintenance mode,
+	// thus we need to create a channel here.
+	c.closeCh = make(chan struct{})
+	return nil
+}
+
+// Init runs the flush workers.
+func (c *logCache) Init() error {
+	for i := 0; i < c.workersCount; i++ {
+		c.wg.Add(1)
+		go c.flushWorker()
+	}
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		tt := time.NewTimer(defaultFlushInterval)
+		defer tt.Stop()
+
+		for {
+			select {
+			case <-tt.C:
+				c.reportFlushState(c.fillRatio(), c.scheduler)
+				c.flushLog()
+				tt.Reset(defaultFlushInterval)
+			case <-c.closeCh:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Close stops the flush workers and closes the segment files.
+func (c *logCache) Close() error {
+	// Finish all in-progress operations.
+	if err := c.SetMode(mode.ReadOnly); err != nil {
+		return err
+	}
+
+	if c.closeCh != nil {
+		close(c.closeCh)
+	}
+	c.wg.Wait()
+	c.closeCh = nil
+
+	c.writeMtx.Lock()
+	c.mtx.Lock()
+	for i := range c.segments {
+		_ = c.segments[i].f.Close()
+	}
+	c.segments = nil
+	c.active = nil
+	c.index = make(map[oid.Address]logRecord)
+	c.mtx.Unlock()
+	c.writeMtx.Unlock()
+	return nil
+}
+
+// SetMode sets write-cache mode of operation.
+// When write-cache is put in read-only mode, no records are appended to the log.
+func (c *logCache) SetMode(m mode.Mode) error {
+	c.modeMtx.Lock()
+	defer c.modeMtx.Unlock()
+
+	if m.NoMetabase() && !c.mode.NoMetabase() {
+		err := c.flush(true)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.writeMtx.Lock()
+	c.sealed = m.ReadOnly()
+	if c.sealed && c.active != nil {
+		c.mtx.Lock()
+		c.active = nil
+		c.mtx.Unlock()
+	}
+	c.writeMtx.Unlock()
+
+	c.mode = m
+	return nil
+}
+
+// readOnly returns true if current mode is read-only.
+// `c.modeMtx` must be taken.
+func (c *logCache) readOnly() bool {
+	return c.mode.ReadOnly()
+}
+
+func (c *logCache) fillRatio() float64 {
+	return c.options.fillRatio(c.diskSize.Load())
+}
+
+// Put appends the object to the log.
+func (c *logCache) Put(prm common.PutPrm) (common.PutRes, error) {
+	defer c.observe("Put")()
+
+	// Sleep before taking the mode lock, so that the delayed PUTs
+	// do not block the mode change.
+	c.backpressure(c.fillRatio())
+
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+	if c.readOnly() {
+		return common.PutRes{}, ErrReadOnly
+	}
+
+	sz := uint64(len(prm.RawData))
+	if sz > c.maxObjectSize {
+		return common.PutRes{}, ErrBigObject
+	}
+
+	if c.maxCacheSize < c.diskSize.Load()+logHeaderSize+sz {
+		return common.PutRes{}, ErrOutOfSpace
+	}
+
+	rec, err := c.append(logRecordPut, prm.Address, prm.RawData)
+	if err != nil {
+		return common.PutRes{}, err
+	}
+
+	c.mtx.Lock()
+	if old, ok := c.index[prm.Address]; ok {
+		c.segment(old.segment).live--
+		c.segment(rec.segment).addDep(old.segment)
+	}
+	c.index[prm.Address] = rec
+	c.removeSegments()
+	c.mtx.Unlock()
+
+	saddr := prm.Address.EncodeToString()
+	c.scheduler.addPending(saddr)
+	storagelog.Write(c.log,
+		storagelog.AddressField(saddr),
+		storagelog.StorageTypeField(wcStorageType),
+		storagelog.OpField("log PUT"),
+	)
+	return common.PutRes{}, nil
+}
+
+// Get returns object from write-cache.
+//
+// Returns an error of type apistatus.ObjectNotFound if the requested object is missing in write-cache.
+func (c *logCache) Get(addr oid.Address) (*object.Object, error) {
+	defer c.observe("Get")()
+
+	return c.get(addr)
+}
+
+func (c *logCache) get(addr oid.Address) (*object.Object, error) {
+	data, err := c.read(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := object.New()
+	return obj, obj.Unmarshal(data)
+}
+
+// Head returns object header from write-cache.
+//
+// Returns an error of type apistatus.ObjectNotFound if the requested object is missing in write-cache.
+func (c *logCache) Head(addr oid.Address) (*object.Object, error) {
+	defer c.observe("Head")()
+
+	obj, err := c.get(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.CutPayload(), nil
+}
+
+// Delete removes object from write-cache.
+//
+// Returns an error of type apistatus.ObjectNotFound if object is missing in write-cache.
+func (c *logCache) Delete(addr oid.Address) error {
+	defer c.observe("Delete")()
+
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+	if c.readOnly() {
+		return ErrReadOnly
+	}
+
+	c.mtx.RLock()
+	_, ok := c.index[addr]
+	c.mtx.RUnlock()
+	if !ok {
+		return logicerr.Wrap(apistatus.ObjectNotFound{})
+	}
+
+	if err := c.remove(addr); err != nil {
+		return err
+	}
+
+	storagelog.Write(c.log,
+		storagelog.AddressField(addr.EncodeToString()),
+		storagelog.StorageTypeField(wcStorageType),
+		storagelog.OpField("log DELETE"),
+	)
+	return nil
+}
+
+// Iterate iterates over all objects present in write cache.
+// This is very difficult to do correctly unless write-cache is put in read-only mode.
+// Thus we silently fail if shard is not in read-only mode to avoid reporting misleading results.
+func (c *logCache) Iterate(prm IterationPrm) error {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+	if !c.readOnly() {
+		return nil
+	}
+
+	for _, e := range c.sortedIndex() {
+		data, err := c.read(e.addr)
+		if err != nil {
+			if prm.ignoreErrors || errors.As(err, new(apistatus.ObjectNotFound)) {
+				continue
+			}
+			return err
+		}
+
+		if err := prm.handler(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush flushes all objects from the write-cache to the main storage.
+func (c *logCache) Flush(ignoreErrors bool) error {
+	defer c.observe("Flush")()
+
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+
+	return c.flush(ignoreErrors)
+}
+
+func (c *logCache) flush(ignoreErrors bool) error {
+	for _, e := range c.sortedIndex() {
+		err := c.flushAddress(e.addr)
+		if err != nil && !ignoreErrors {
+			return err
+		}
+	}
+	return nil
+}
+
+// flushLog passes all objects in the write-cache to the flush workers.
+func (c *logCache) flushLog() {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+
+	if c.readOnly() {
+		return
+	}
+
+	entries := c.sortedIndex()
+	for i := range entries {
+		select {
+		case c.flushCh <- entries[i].addr:
+		case <-c.closeCh:
+			return
+		}
+	}
+
+	if len(entries) != 0 {
+		c.log.Debug("tried to flush items from write-cache",
+			zap.Int("count", len(entries)))
+	}
+}
+
+// flushWorker writes objects to the main storage.
+func (c *logCache) flushWorker() {
+	defer c.wg.Done()
+
+	var addr oid.Address
+	for {
+		select {
+		case addr = <-c.flushCh:
+		case <-c.closeCh:
+			return
+		}
+
+		_ = c.flushAddress(addr)
+
+		if !c.scheduler.throttle(c.fillRatio(), c.closeCh) {
+			return
+		}
+	}
+}
+
+// flushAddress writes the object to the main storage and removes it from the write-cache.
+func (c *logCache) flushAddress(addr oid.Address) error {
+	data, err := c.read(addr)
+	if err != nil {
+		if errors.As(err, new(apistatus.ObjectNotFound)) {
+			// already flushed or deleted
+			return nil
+		}
+		c.reportFlushError("can't read an object from the write-cache log", addr.EncodeToString(), err)
+		return err
+	}
+
+	var obj object.Object
+	if err := obj.Unmarshal(data); err != nil {
+		c.reportFlushError("can't unmarshal an object from the write-cache log", addr.EncodeToString(), err)
+		return err
+	}
+
+	if err := c.flushObject(c.scheduler, &obj, data); err != nil {
+		return err
+	}
+
+	return c.remove(addr)
+}
+
+// sortedIndex returns the objects in the write-cache in the order they were put.
+func (c *logCache) sortedIndex() []logIndexEntry {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return sortLogIndex(c.index)
+}
+
+// read returns the object data from the log.
+func (c *logCache) read(addr oid.Address) ([]byte, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	rec, ok := c.index[addr]
+	if !ok {
+		return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
+	}
+
+	data := make([]byte, rec.size)
+	if _, err := c.segment(rec.segment).f.ReadAt(data, rec.offset); err != nil {
+		return nil, fmt.Errorf("could not read write-cache log segment: %w", err)
+	}
+	return data, nil
+}
+
+// remove appends the removal record to the log and removes the object from the index.
+// In read-only mode the object is removed from the index only and is restored on
+// the next open.
+func (c *logCache) remove(addr oid.Address) error {
+	del, err := c.append(logRecordDelete, addr, nil)
+	if err != nil && !errors.Is(err, ErrReadOnly) {
+		return err
+	}
+
+	c.mtx.Lock()
+	if rec, ok := c.index[addr]; ok {
+		delete(c.index, addr)
+		c.segment(rec.segment).live--
+		if err == nil {
+			c.segment(del.segment).addDep(rec.segment)
+		}
+	}
+	if err == nil {
+		// The removal record is not needed anymore.
+		c.segment(del.segment).live--
+		c.removeSegments()
+	}
+	c.mtx.Unlock()
+
+	c.scheduler.removePending(addr.EncodeToString())
+	return nil
+}
+
+// append appends the record to the active segment creating it if needed.
+// The record is counted as live in the segment, so that the segment is not
+// removed before the record is added to the index or, for the removal record,
+// before the removed object is dropped from the index.
+//
+// The record is synchronized to disk outside of the write lock, so that
+// the records appended concurrently are committed by a single fsync.
+func (c *logCache) append(kind byte, addr oid.Address, data []byte) (logRecord, error) {
+	c.writeMtx.Lock()
+
+	if c.sealed {
+		c.writeMtx.Unlock()
+		return logRecord{}, ErrReadOnly
+	}
+
+	if c.active == nil || c.active.size >= c.segmentSize {
+		if err := c.rotate(); err != nil {
+			c.writeMtx.Unlock()
+			return logRecord{}, err
+		}
+	}
+
+	buf := make([]byte, logHeaderSize+len(data))
+	encodeLogHeader(buf, kind, addr, data)
+	copy(buf[logHeaderSize:], data)
+
+	s := c.active
+	if _, err := s.f.Write(buf); err != nil {
+		// The segment may contain a partially written record,
+		// the next records are appended to a new one.
+		c.mtx.Lock()
+		c.active = nil
+		c.mtx.Unlock()
+		c.writeMtx.Unlock()
+		return logRecord{}, fmt.Errorf("could not write to write-cache log segment: %w", err)
+	}
+
+	rec := logRecord{
+		segment: s.id,
+		offset:  s.size + logHeaderSize,
+		size:    uint32(len(data)),
+	}
+
+	s.size += int64(len(buf))
+	s.written.Store(s.size)
+	c.diskSize.Add(uint64(len(buf)))
+
+	// The segment is active, so it can't be removed before
+	// the record is counted.
+	c.mtx.Lock()
+	s.live++
+	c.mtx.Unlock()
+	c.writeMtx.Unlock()
+
+	if !c.noSync {
+		if err := s.sync(rec.offset + int64(rec.size)); err != nil {
+			c.writeMtx.Lock()
+			c.mtx.Lock()
+			if c.active == s {
+				c.active = nil
+			}
+			s.live--
+			c.removeSegments()
+			c.mtx.Unlock()
+			c.writeMtx.Unlock()
+			return logRecord{}, fmt.Errorf("could not sync write-cache log segment: %w", err)
+		}
+	}
+	return rec, nil
+}
+
+// rotate creates a new active segment.
+// `c.writeMtx` must be taken.
+func (c *logCache) rotate() error {
+	id := c.lastID + 1
+	s := &logSegment{id: id, path: logSegmentPath(c.path, id)}
+
+	f, err := os.OpenFile(s.path, os.O_RDWR|os.O_CREATE|os.O_EXCL|os.O_APPEND, 0640)
+	if err != nil {
+		return fmt.Errorf("could not create write-cache log segment: %w", err)
+	}
+	s.f = f
+	c.lastID = id
+
+	c.mtx.Lock()
+	c.segments = append(c.segments, s)
+	c.active = s
+	c.removeSegments()
+	c.mtx.Unlock()
+	return nil
+}
+
+// segment returns the segment with the specified ID.
+// `c.mtx` must be taken.
+func (c *logCache) segment(id uint64) *logSegment {
+	i := sort.Search(len(c.segments), func(i int) bool { return c.segments[i].id >= id })
+	return c.segments[i]
+}
+
+// removeSegments removes the segments without live records.
+// The segment is kept until all the older segments with the records
+// it overrides are removed.
+// `c.mtx` must be taken exclusively.
+func (c *logCache) removeSegments() {
+	kept := c.segments[:0]
+	for _, s := range c.segments {
+		// The segments are sorted by ID and the dependencies are older,
+		// so they are already either removed or kept.
+		for id := range s.deps {
+			i := sort.Search(len(kept), func(i int) bool { return kept[i].id >= id })
+			if i == len(kept) || kept[i].id != id {
+				delete(s.deps, id)
+			}
+		}
+
+		if s.live != 0 || s == c.active || len(s.deps) != 0 {
+			kept = append(kept, s)
+			continue
+		}
+
+		_ = s.f.Close()
+		if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			c.log.Error("can't remove write-cache log segment",
+				zap.String("path", s.path),
+				zap.Error(err))
+		}
+		c.diskSize.Sub(uint64(s.size))
+	}
+	for i := len(kept); i < len(c.segments); i++ {
+		c.segments[i] = nil
+	}
+	c.segments = kept
+}
@@ -0,0 +1,358 @@
+package writecache
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// Log record layout:
+// checksum (4, CRC32 of the rest of the record) | kind (1) |
+// address (64, container ID + object ID) | length (4) | data (length).
+// All integers are big-endian.
+const (
+	logRecordPut byte = iota + 1
+	// logRecordDelete marks the object as removed from the write-cache,
+	// either flushed or deleted.
+	logRecordDelete
+)
+
+const (
+	logAddressSize = 2 * 32
+	logHeaderSize  = 4 + 1 + logAddressSize + 4
+
+	// logSegmentSize is the size after which a new segment is started.
+	logSegmentSize = 64 << 20
+	// logSegmentExt is the extension of the segment files.
+	logSegmentExt = ".log"
+)
+
+// errLogCorrupted is returned when the segment contains an invalid record.
+var errLogCorrupted = errors.New("corrupted write-cache log record")
+
+// logSegment is a single append-only log file.
+type logSegment struct {
+	id   uint64
+	path string
+	f    *os.File
+	// size is the size of the valid part of the segment.
+	size int64
+	// live is the number of the records pointing to the objects still in the write-cache.
+	live int
+	// deps are the IDs of the older segments containing the records overridden
+	// by the records of this segment. The segment can't be removed before them,
+	// otherwise the overridden records are restored on the next open.
+	deps map[uint64]struct{}
+
+	// written is the size of the data written to the segment file.
+	written atomic.Int64
+	// syncMtx serializes the segment file synchronization.
+	syncMtx sync.Mutex
+	// synced is the size of the data persisted on disk, protected by syncMtx.
+	synced int64
+}
+
+// addDep marks the segment as dependent on the older segment with the specified ID.
+func (s *logSegment) addDep(id uint64) {
+	if id == s.id {
+		return
+	}
+	if s.deps == nil {
+		s.deps = make(map[uint64]struct{})
+	}
+	s.deps[id] = struct{}{}
+}
+
+// sync persists the segment data up to the specified offset on disk.
+// The callers waiting for the previous fsync to finish are committed together
+// with a single fsync, if their data was written before it started.
+func (s *logSegment) sync(offset int64) error {
+	s.syncMtx.Lock()
+	defer s.syncMtx.Unlock()
+
+	if s.synced >= offset {
+		return nil
+	}
+
+	size := s.written.Load()
+	if err := s.f.Sync(); err != nil {
+		return err
+	}
+	s.synced = size
+	return nil
+}
+
+// logRecord is the location of the object data.
+type logRecord struct {
+	segment uint64
+	// offset is the offset of the object data in the segment.
+	offset int64
+	size   uint32
+}
+
+func logSegmentPath(dir string, id uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%016x%s", id, logSegmentExt))
+}
+
+// listLogSegments returns IDs of the segments in the directory in ascending order.
+func listLogSegments(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var ids []uint64
+	for i := range entries {
+		name := entries[i].Name()
+		if entries[i].IsDir() || !strings.HasSuffix(name, logSegmentExt) {
+			continue
+		}
+
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, logSegmentExt), 16, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// IsLog returns true if the directory contains the write-cache of TypeLog.
+func IsLog(dir string) bool {
+	ids, err := listLogSegments(dir)
+	return err == nil && len(ids) != 0
+}
+
+func encodeLogAddress(dst []byte, addr oid.Address) {
+	addr.Container().Encode(dst)
+	addr.Object().Encode(dst[32:])
+}
+
+func decodeLogAddress(src []byte) (oid.Address, error) {
+	var (
+		addr  oid.Address
+		cnr   cid.ID
+		objID oid.ID
+	)
+
+	if err := cnr.Decode(src[:32]); err != nil {
+		return addr, err
+	}
+	if err := objID.Decode(src[32:logAddressSize]); err != nil {
+		return addr, err
+	}
+
+	addr.SetContainer(cnr)
+	addr.SetObject(objID)
+	return addr, nil
+}
+
+// encodeLogHeader fills the record header including the record checksum.
+func encodeLogHeader(hdr []byte, kind byte, addr oid.Address, data []byte) {
+	hdr[4] = kind
+	encodeLogAddress(hdr[5:], addr)
+	binary.BigEndian.PutUint32(hdr[5+logAddressSize:], uint32(len(data)))
+
+	h := crc32.NewIEEE()
+	_, _ = h.Write(hdr[4:logHeaderSize])
+	_, _ = h.Write(data)
+	binary.BigEndian.PutUint32(hdr, h.Sum32())
+}
+
+// readLogSegment calls f for every record of the segment of the specified size in order.
+// Returns the size of the valid part of the segment and errLogCorrupted
+// if the segment contains an invalid or incomplete record.
+func readLogSegment(r io.Reader, size int64, f func(kind byte, addr oid.Address, rec logRecord) error) (int64, error) {
+	br := bufio.NewReader(r)
+	hdr := make([]byte, logHeaderSize)
+
+	var (
+		offset int64
+		data   []byte
+	)
+
+	for {
+		_, err := io.ReadFull(br, hdr)
+		if errors.Is(err, io.EOF) {
+			return offset, nil
+		} else if err != nil {
+			return offset, errLogCorrupted
+		}
+
+		dataSize := binary.BigEndian.Uint32(hdr[5+logAddressSize:])
+		if offset+logHeaderSize+int64(dataSize) > size {
+			return offset, errLogCorrupted
+		}
+		if cap(data) < int(dataSize) {
+			data = make([]byte, dataSize)
+		}
+		data = data[:dataSize]
+		if _, err := io.ReadFull(br, data); err != nil {
+			return offset, errLogCorrupted
+		}
+
+		h := crc32.NewIEEE()
+		_, _ = h.Write(hdr[4:])
+		_, _ = h.Write(data)
+		if h.Sum32() != binary.BigEndian.Uint32(hdr) {
+			return offset, errLogCorrupted
+		}
+
+		kind := hdr[4]
+		addr, err := decodeLogAddress(hdr[5:])
+		if err != nil || kind != logRecordPut && kind != logRecordDelete {
+			return offset, errLogCorrupted
+		}
+
+		err = f(kind, addr, logRecord{offset: offset + logHeaderSize, size: dataSize})
+		if err != nil {
+			return offset, err
+		}
+
+		offset += logHeaderSize + int64(dataSize)
+	}
+}
+
+// loadLog opens all segments in the directory and restores the index of the
+// objects in the write-cache. The incomplete tail of the last segment is
+// truncated if readOnly is false.
+func loadLog(dir string, readOnly bool, log *logger.Logger) ([]*logSegment, map[oid.Address]logRecord, error) {
+	ids, err := listLogSegments(dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not list write-cache log segments: %w", err)
+	}
+
+	segments := make([]*logSegment, 0, len(ids))
+	index := make(map[oid.Address]logRecord)
+
+	closeAll := func() {
+		for i := range segments {
+			_ = segments[i].f.Close()
+		}
+	}
+
+	for i, id := range ids {
+		s := &logSegment{id: id, path: logSegmentPath(dir, id)}
+
+		s.f, err = os.Open(s.path)
+		if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("could not open write-cache log segment: %w", err)
+		}
+		segments = append(segments, s)
+
+		fi, err := s.f.Stat()
+		if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("could not stat write-cache log segment: %w", err)
+		}
+
+		s.size, err = readLogSegment(s.f, fi.Size(), func(kind byte, addr oid.Address, rec logRecord) error {
+			if old, ok := index[addr]; ok {
+				s.addDep(old.segment)
+			}
+			if kind == logRecordDelete {
+				delete(index, addr)
+			} else {
+				rec.segment = id
+				index[addr] = rec
+			}
+			return nil
+		})
+		if errors.Is(err, errLogCorrupted) {
+			log.Warn("write-cache log segment is corrupted, the rest of it is ignored",
+				zap.String("path", s.path),
+				zap.Int64("offset", s.size))
+
+			if !readOnly && i == len(ids)-1 {
+				if err := os.Truncate(s.path, s.size); err != nil {
+					closeAll()
+					return nil, nil, fmt.Errorf("could not truncate write-cache log segment: %w", err)
+				}
+			}
+		} else if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("could not read write-cache log segment: %w", err)
+		}
+		s.written.Store(s.size)
+		s.synced = s.size
+	}
+
+	for _, rec := range index {
+		i := sort.Search(len(segments), func(i int) bool { return segments[i].id >= rec.segment })
+		segments[i].live++
+	}
+
+	return segments, index, nil
+}
+
+// IterateLog iterates over all objects stored in the write-cache of TypeLog
+// located in the directory and passes them to f until error return.
+// The write-cache must not be opened in the read-write mode.
+func IterateLog(dir string, f func(oid.Address, []byte) error) error {
+	segments, index, err := loadLog(dir, true, &logger.Logger{Logger: zap.NewNop()})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for i := range segments {
+			_ = segments[i].f.Close()
+		}
+	}()
+
+	for _, e := range sortLogIndex(index) {
+		i := sort.Search(len(segments), func(i int) bool { return segments[i].id >= e.rec.segment })
+
+		data := make([]byte, e.rec.size)
+		if _, err := segments[i].f.ReadAt(data, e.rec.offset); err != nil {
+			return fmt.Errorf("could not read object data: %w", err)
+		}
+
+		if err := f(e.addr, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logIndexEntry is the object address along with its location.
+type logIndexEntry struct {
+	addr oid.Address
+	rec  logRecord
+}
+
+// sortLogIndex returns the index entries in the order of the records in the log.
+func sortLogIndex(index map[oid.Address]logRecord) []logIndexEntry {
+	entries := make([]logIndexEntry, 0, len(index))
+	for addr, rec := range index {
+		entries = append(entries, logIndexEntry{addr: addr, rec: rec})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].rec.segment != entries[j].rec.segment {
+			return entries[i].rec.segment < entries[j].rec.segment
+		}
+		return entries[i].rec.offset < entries[j].rec.offset
+	})
+	return entries
+}
@@ -0,0 +1,300 @@
+package writecache
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/internal/storagetest"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestGenericLog(t *testing.T) {
+	defer func() { _ = os.RemoveAll(t.Name()) }()
+
+	var n int
+	newCache := func(t *testing.T) storagetest.Component {
+		n++
+		dir := filepath.Join(t.Name(), strconv.Itoa(n))
+		require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+		return New(
+			WithType(TypeLog),
+			WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+			WithFlushWorkersCount(2),
+			WithPath(dir))
+	}
+
+	storagetest.TestAll(t, newCache)
+}
+
+func TestLogCache(t *testing.T) {
+	dir := t.TempDir()
+
+	newCache := func(t *testing.T, opts ...Option) Cache {
+		c := New(append([]Option{
+			WithType(TypeLog),
+			WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+			WithPath(filepath.Join(dir, "writecache")),
+			WithNoSync(true),
+		}, opts...)...)
+		require.NoError(t, c.Open(false))
+		return c
+	}
+
+	c := newCache(t)
+
+	objects := make([]objectPair, 4)
+	for i := range objects {
+		objects[i] = putObject(t, c, 1+i*100)
+	}
+
+	checkObjects := func(t *testing.T, c Cache, objects []objectPair) {
+		for i := range objects {
+			obj, err := c.Get(objects[i].addr)
+			require.NoError(t, err)
+			require.Equal(t, objects[i].obj, obj)
+		}
+	}
+
+	checkObjects(t, c, objects)
+
+	require.NoError(t, c.Delete(objects[0].addr))
+	_, err := c.Get(objects[0].addr)
+	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+	require.ErrorAs(t, c.Delete(objects[0].addr), new(apistatus.ObjectNotFound))
+
+	t.Run("reopen", func(t *testing.T) {
+		require.NoError(t, c.Close())
+
+		c = newCache(t)
+		checkObjects(t, c, objects[1:])
+
+		_, err := c.Get(objects[0].addr)
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+	})
+	t.Run("iterate", func(t *testing.T) {
+		require.True(t, IsLog(filepath.Join(dir, "writecache")))
+
+		var addrs []oid.Address
+		require.NoError(t, IterateLog(filepath.Join(dir, "writecache"), func(addr oid.Address, _ []byte) error {
+			addrs = append(addrs, addr)
+			return nil
+		}))
+		require.Equal(t, []oid.Address{objects[1].addr, objects[2].addr, objects[3].addr}, addrs)
+	})
+	t.Run("corrupted tail", func(t *testing.T) {
+		require.NoError(t, c.Close())
+
+		ids, err := listLogSegments(filepath.Join(dir, "writecache"))
+		require.NoError(t, err)
+
+		last := logSegmentPath(filepath.Join(dir, "writecache"), ids[len(ids)-1])
+		fi, err := os.Stat(last)
+		require.NoError(t, err)
+
+		// imitate a partially written record
+		f, err := os.OpenFile(last, os.O_WRONLY|os.O_APPEND, 0)
+		require.NoError(t, err)
+		_, err = f.Write([]byte{1, 2, 3})
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		c = newCache(t)
+		checkObjects(t, c, objects[1:])
+
+		fi2, err := os.Stat(last)
+		require.NoError(t, err)
+		require.Equal(t, fi.Size(), fi2.Size())
+	})
+	t.Run("out of space", func(t *testing.T) {
+		require.NoError(t, c.Close())
+
+		c = newCache(t, WithMaxCacheSize(1000))
+
+		obj, data := newObject(t, 100)
+
+		var prm common.PutPrm
+		prm.Address = objectCore.AddressOf(obj)
+		prm.Object = obj
+		prm.RawData = data
+		_, err := c.Put(prm)
+		require.ErrorIs(t, err, ErrOutOfSpace)
+	})
+	require.NoError(t, c.Close())
+}
+
+func TestLogCacheSegments(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "writecache")
+
+	newCache := func(t *testing.T) *logCache {
+		c := New(
+			WithType(TypeLog),
+			WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+			WithPath(dir)).(*logCache)
+		require.NoError(t, c.Open(false))
+		return c
+	}
+
+	segments := func(t *testing.T) []uint64 {
+		ids, err := listLogSegments(dir)
+		require.NoError(t, err)
+		return ids
+	}
+
+	checkObjects := func(t *testing.T, c Cache, present, missing []objectPair) {
+		for i := range present {
+			_, err := c.Get(present[i].addr)
+			require.NoError(t, err, i)
+		}
+		for i := range missing {
+			_, err := c.Get(missing[i].addr)
+			require.ErrorAs(t, err, new(apistatus.ObjectNotFound), i)
+		}
+	}
+
+	c := newCache(t)
+
+	a := putObject(t, c, 10)
+	b := putObject(t, c, 10)
+
+	// Every record is appended to a new segment.
+	c.segmentSize = 1
+
+	// The segment with the removal record must outlive the segment with A and B.
+	require.NoError(t, c.Delete(b.addr))
+	cc := putObject(t, c, 10)
+	require.Equal(t, []uint64{1, 2, 3}, segments(t))
+
+	// The segments after the segment with a live object can be removed.
+	d := putObject(t, c, 10)
+	require.NoError(t, c.Delete(d.addr))
+	e := putObject(t, c, 10)
+	require.Equal(t, []uint64{1, 2, 3, 6}, segments(t))
+
+	checkObjects(t, c, []objectPair{a, cc, e}, []objectPair{b, d})
+
+	require.NoError(t, c.Close())
+
+	c = newCache(t)
+	c.segmentSize = 1
+	checkObjects(t, c, []objectPair{a, cc, e}, []objectPair{b, d})
+
+	// The dependencies are restored on open.
+	require.NoError(t, c.Delete(a.addr))
+	require.Equal(t, []uint64{3, 6, 7}, segments(t))
+	require.NoError(t, c.Close())
+
+	c = newCache(t)
+	checkObjects(t, c, []objectPair{cc, e}, []objectPair{a, b, d})
+	require.NoError(t, c.Close())
+}
+
+func TestLogCacheConcurrentPut(t *testing.T) {
+	dir := t.TempDir()
+
+	newCache := func(t *testing.T) Cache {
+		c := New(
+			WithType(TypeLog),
+			WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+			WithPath(dir))
+		require.NoError(t, c.Open(false))
+		return c
+	}
+
+	c := newCache(t)
+
+	const workers, count = 8, 10
+
+	var wg sync.WaitGroup
+	objects := make([]objectPair, workers*count)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < count; j++ {
+				objects[i*count+j] = putObject(t, c, 100)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.NoError(t, c.Close())
+
+	c = newCache(t)
+	for i := range objects {
+		obj, err := c.Get(objects[i].addr)
+		require.NoError(t, err)
+		require.Equal(t, objects[i].obj, obj)
+	}
+	require.NoError(t, c.Close())
+}
+
+func TestLogCacheFlush(t *testing.T) {
+	dir := t.TempDir()
+
+	mb := meta.New(
+		meta.WithPath(filepath.Join(dir, "meta")),
+		meta.WithEpochState(dummyEpoch{}))
+	require.NoError(t, mb.Open(false))
+	require.NoError(t, mb.Init())
+
+	bs := blobstor.New(blobstor.WithStorages([]blobstor.SubStorage{
+		{Storage: fstree.New(
+			fstree.WithPath(filepath.Join(dir, "blob")),
+			fstree.WithDepth(0),
+			fstree.WithDirNameLen(1))},
+	}))
+	require.NoError(t, bs.Open(false))
+	require.NoError(t, bs.Init())
+
+	c := New(
+		WithType(TypeLog),
+		WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+		WithPath(filepath.Join(dir, "writecache")),
+		WithMetabase(mb),
+		WithBlobstor(bs))
+	require.NoError(t, c.Open(false))
+
+	objects := make([]objectPair, 4)
+	for i := range objects {
+		objects[i] = putObject(t, c, 1+i*100)
+
+		var prm meta.PutPrm
+		prm.SetObject(objects[i].obj)
+		_, err := mb.Put(prm)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, c.Flush(false))
+
+	for i := range objects {
+		_, err := c.Get(objects[i].addr)
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+		res, err := bs.Get(common.GetPrm{Address: objects[i].addr})
+		require.NoError(t, err)
+		require.Equal(t, objects[i].obj, res.Object)
+	}
+
+	// all the segments have no objects and are removed except the active one
+	ids, err := listLogSegments(filepath.Join(dir, "writecache"))
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+
+	require.NoError(t, c.SetMode(mode.ReadOnly))
+	require.NoError(t, c.Close())
+	require.NoError(t, bs.Close())
+	require.NoError(t, mb.Close())
+}
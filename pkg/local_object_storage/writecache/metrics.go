@@ -0,0 +1,27 @@
+package writecache
+
+import "time"
+
+// Metrics is an interface that must store write-cache metrics.
+type Metrics interface {
+	// AddMethodDuration must add the duration of the write-cache operation.
+	AddMethodDuration(method string, d time.Duration)
+	// SetFillRatio must set the estimated fraction of the write-cache capacity in use.
+	SetFillRatio(v float64)
+	// SetFlushLag must set the time the oldest object waits to be flushed.
+	SetFlushLag(d time.Duration)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) AddMethodDuration(string, time.Duration) {}
+func (noopMetrics) SetFillRatio(float64)                    {}
+func (noopMetrics) SetFlushLag(time.Duration)               {}
+
+// observe returns the function reporting the duration of the operation since the call.
+func (o *options) observe(method string) func() {
+	t := time.Now()
+	return func() {
+		o.metrics.AddMethodDuration(method, time.Since(t))
+	}
+}
@@ -28,8 +28,21 @@ type blob interface {
 	Exists(res common.ExistsPrm) (common.ExistsRes, error)
 }
 
+// Type is the write-cache storage type.
+type Type string
+
+const (
+	// TypeBBolt is the write-cache storing small objects in a bbolt database
+	// and big objects in the file-system tree.
+	TypeBBolt Type = "bbolt"
+	// TypeLog is the write-cache storing all objects in segmented append-only log files.
+	TypeLog Type = "log"
+)
+
 type options struct {
 	log *logger.Logger
+	// typ is the write-cache storage type.
+	typ Type
 	// path is a path to a directory for write-cache.
 	path string
 	// blobstor is the main persistent storage.
@@ -55,6 +68,20 @@ type options struct {
 	noSync bool
 	// reportError is the function called when encountering disk errors in background workers.
 	reportError func(string, error)
+	// metrics is the metrics collector.
+	metrics Metrics
+	// backpressureThreshold is the fill ratio starting from which the PUT operations are delayed.
+	backpressureThreshold float64
+	// backpressureMaxDelay is the delay of the PUT operation when the write-cache is full.
+	// Zero disables the backpressure.
+	backpressureMaxDelay time.Duration
+}
+
+// WithType sets write-cache storage type.
+func WithType(typ Type) Option {
+	return func(o *options) {
+		o.typ = typ
+	}
 }
 
 // WithLogger sets logger.
@@ -152,3 +179,22 @@ func WithReportErrorFunc(f func(string, error)) Option {
 		o.reportError = f
 	}
 }
+
+// WithMetrics sets metrics collector.
+func WithMetrics(m Metrics) Option {
+	return func(o *options) {
+		o.metrics = m
+	}
+}
+
+// WithBackpressure sets the fill ratio of the write-cache starting from which
+// the incoming objects are delayed and the maximum delay reached when the
+// write-cache is full. Zero delay disables the backpressure.
+func WithBackpressure(threshold float64, maxDelay time.Duration) Option {
+	return func(o *options) {
+		if 0 <= threshold && threshold < 1 {
+			o.backpressureThreshold = threshold
+			o.backpressureMaxDelay = maxDelay
+		}
+	}
+}
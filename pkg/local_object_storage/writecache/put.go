@@ -17,6 +17,12 @@ var (
 
 // Put puts object to write-cache.
 func (c *cache) Put(prm common.PutPrm) (common.PutRes, error) {
+	defer c.observe("Put")()
+
+	// Sleep before taking the mode lock, so that the delayed PUTs
+	// do not block the mode change.
+	c.backpressure(c.fillRatio())
+
 	c.modeMtx.RLock()
 	defer c.modeMtx.RUnlock()
 	if c.readOnly() {
@@ -59,6 +65,7 @@ func (c *cache) putSmall(obj objectInfo) error {
 			storagelog.OpField("db PUT"),
 		)
 		c.objCounters.IncDB()
+		c.scheduler.addPending(obj.addr)
 	}
 	return nil
 }
@@ -81,6 +88,7 @@ func (c *cache) putBig(addr string, prm common.PutPrm) error {
 		c.mtx.Unlock()
 	}
 	c.objCounters.IncFS()
+	c.scheduler.addPending(addr)
 	storagelog.Write(c.log,
 		storagelog.AddressField(addr),
 		storagelog.StorageTypeField(wcStorageType),
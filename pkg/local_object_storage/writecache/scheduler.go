@@ -0,0 +1,167 @@
+package writecache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+const (
+	// flushHighWatermark is the fill ratio starting from which the objects are
+	// flushed at the full speed.
+	flushHighWatermark = 0.8
+	// minFlushDuty is the minimum fraction of time a flush worker is busy.
+	minFlushDuty = 0.1
+	// latencyWeight is the weight of the new sample in the average blobstor latency.
+	latencyWeight = 0.125
+	// maxFlushDelay is the maximum pause between the objects flushed by a single worker.
+	maxFlushDelay = time.Second
+)
+
+// flushScheduler adapts the flush rate to the main storage latency and
+// the write-cache fill level.
+//
+// When the write-cache is almost empty, flush workers pause after each object,
+// so that the flush does not compete with the client requests to the blobstor.
+// The pause is proportional to the average blobstor latency: the slower the
+// disks are, the less often the objects are flushed. As the cache fills up,
+// the pause decreases and disappears at the flushHighWatermark.
+type flushScheduler struct {
+	mtx sync.Mutex
+	// latency is the exponentially weighted moving average of the blobstor PUT latency.
+	latency time.Duration
+	// pending maps the addresses of the objects put to the write-cache and not yet
+	// flushed to their elements in the queue.
+	pending map[string]*list.Element
+	// queue contains the put times of the pending objects in the order
+	// of the put, so that the oldest object is at the front.
+	queue *list.List
+}
+
+func newFlushScheduler() *flushScheduler {
+	return &flushScheduler{
+		pending: make(map[string]*list.Element),
+		queue:   list.New(),
+	}
+}
+
+// observeLatency updates the average blobstor latency.
+func (s *flushScheduler) observeLatency(d time.Duration) {
+	s.mtx.Lock()
+	if s.latency == 0 {
+		s.latency = d
+	} else {
+		s.latency += time.Duration(latencyWeight * float64(d-s.latency))
+	}
+	s.mtx.Unlock()
+}
+
+// delay returns the pause a flush worker must make after flushing an object.
+func (s *flushScheduler) delay(fill float64) time.Duration {
+	if fill >= flushHighWatermark {
+		return 0
+	}
+
+	s.mtx.Lock()
+	latency := s.latency
+	s.mtx.Unlock()
+
+	duty := fill / flushHighWatermark
+	if duty < minFlushDuty {
+		duty = minFlushDuty
+	}
+
+	d := time.Duration(float64(latency) * (1 - duty) / duty)
+	if d > maxFlushDelay {
+		d = maxFlushDelay
+	}
+	return d
+}
+
+func (s *flushScheduler) addPending(addr string) {
+	s.mtx.Lock()
+	if _, ok := s.pending[addr]; !ok {
+		s.pending[addr] = s.queue.PushBack(time.Now())
+	}
+	s.mtx.Unlock()
+}
+
+func (s *flushScheduler) removePending(addr string) {
+	s.mtx.Lock()
+	if e, ok := s.pending[addr]; ok {
+		s.queue.Remove(e)
+		delete(s.pending, addr)
+	}
+	s.mtx.Unlock()
+}
+
+// lag returns the time the oldest pending object waits for the flush.
+func (s *flushScheduler) lag(now time.Time) time.Duration {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	e := s.queue.Front()
+	if e == nil {
+		return 0
+	}
+	return now.Sub(e.Value.(time.Time))
+}
+
+// throttle pauses the flush worker according to the current load.
+// Returns false if closeCh is closed.
+func (s *flushScheduler) throttle(fill float64, closeCh <-chan struct{}) bool {
+	d := s.delay(fill)
+	if d == 0 {
+		return true
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return true
+	case <-closeCh:
+		return false
+	}
+}
+
+// fillRatio returns the fraction of the write-cache capacity in use.
+func (o *options) fillRatio(size uint64) float64 {
+	if o.maxCacheSize == 0 {
+		return 1
+	}
+
+	fill := float64(size) / float64(o.maxCacheSize)
+	if fill > 1 {
+		fill = 1
+	}
+	return fill
+}
+
+// backpressure delays the incoming PUT when the write-cache fill ratio exceeds
+// the configured threshold. The delay grows linearly from zero at the threshold
+// to the maximum delay when the cache is full, giving the flush workers time
+// to free some space.
+func (o *options) backpressure(fill float64) {
+	if o.backpressureMaxDelay <= 0 || o.backpressureThreshold >= 1 {
+		return
+	}
+
+	if fill <= o.backpressureThreshold {
+		return
+	}
+
+	d := time.Duration(float64(o.backpressureMaxDelay) *
+		(fill - o.backpressureThreshold) / (1 - o.backpressureThreshold))
+
+	defer o.observe("Backpressure")()
+
+	time.Sleep(d)
+}
+
+// reportFlushState reports the write-cache fill ratio and flush lag.
+func (o *options) reportFlushState(fill float64, s *flushScheduler) {
+	o.metrics.SetFillRatio(fill)
+	o.metrics.SetFlushLag(s.lag(time.Now()))
+}
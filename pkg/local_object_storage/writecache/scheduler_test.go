@@ -0,0 +1,72 @@
+package writecache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushScheduler(t *testing.T) {
+	s := newFlushScheduler()
+
+	t.Run("latency", func(t *testing.T) {
+		s.observeLatency(10 * time.Millisecond)
+		require.Equal(t, 10*time.Millisecond, s.latency)
+
+		s.observeLatency(90 * time.Millisecond)
+		require.Equal(t, 20*time.Millisecond, s.latency)
+	})
+	t.Run("delay", func(t *testing.T) {
+		require.Equal(t, time.Duration(0), s.delay(flushHighWatermark))
+		require.Equal(t, time.Duration(0), s.delay(1))
+
+		// half of the high watermark, the worker is busy half of the time
+		require.Equal(t, 20*time.Millisecond, s.delay(flushHighWatermark/2))
+
+		require.Equal(t, 180*time.Millisecond, s.delay(0))
+		require.True(t, s.delay(0.1) < s.delay(0))
+
+		s.observeLatency(time.Hour)
+		require.Equal(t, maxFlushDelay, s.delay(0))
+	})
+	t.Run("lag", func(t *testing.T) {
+		now := time.Now()
+		require.Equal(t, time.Duration(0), s.lag(now))
+
+		s.addPending("a")
+		s.addPending("b")
+		s.pending["a"].Value = now.Add(-time.Minute)
+		s.pending["b"].Value = now.Add(-time.Second)
+
+		// the time of the first put is kept
+		s.addPending("a")
+		require.Equal(t, time.Minute, s.lag(now))
+
+		s.removePending("a")
+		require.Equal(t, time.Second, s.lag(now))
+
+		s.removePending("b")
+		require.Equal(t, time.Duration(0), s.lag(now))
+		require.Equal(t, 0, s.queue.Len())
+	})
+}
+
+func TestBackpressure(t *testing.T) {
+	c := New(
+		WithMaxCacheSize(100),
+		WithSmallObjectSize(1),
+		WithBackpressure(0.5, time.Second),
+	).(*cache)
+
+	measure := func(objects uint64) time.Duration {
+		c.objCounters.cDB.Store(objects)
+
+		start := time.Now()
+		c.backpressure(c.fillRatio())
+		return time.Since(start)
+	}
+
+	require.Less(t, measure(50), 50*time.Millisecond)
+	require.GreaterOrEqual(t, measure(60), 200*time.Millisecond)
+}
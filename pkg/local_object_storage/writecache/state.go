@@ -7,6 +7,10 @@ import (
 	"go.uber.org/atomic"
 )
 
+func (c *cache) fillRatio() float64 {
+	return c.options.fillRatio(c.estimateCacheSize())
+}
+
 func (c *cache) estimateCacheSize() uint64 {
 	return c.objCounters.DB()*c.smallObjectSize + c.objCounters.FS()*c.maxObjectSize
 }
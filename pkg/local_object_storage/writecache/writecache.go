@@ -65,6 +65,8 @@ type cache struct {
 	store
 	// fsTree contains big files stored directly on file-system.
 	fsTree *fstree.FSTree
+	// scheduler controls the background flush rate.
+	scheduler *flushScheduler
 }
 
 // wcStorageType is used for write-cache operations logging.
@@ -88,24 +90,33 @@ var (
 
 // New creates new writecache instance.
 func New(opts ...Option) Cache {
-	c := &cache{
-		flushCh: make(chan *object.Object),
-		mode:    mode.ReadWrite,
-
-		compressFlags: make(map[string]struct{}),
-		options: options{
-			log:             &logger.Logger{Logger: zap.NewNop()},
-			maxObjectSize:   defaultMaxObjectSize,
-			smallObjectSize: defaultSmallObjectSize,
-			workersCount:    defaultFlushWorkersCount,
-			maxCacheSize:    defaultMaxCacheSize,
-			maxBatchSize:    bbolt.DefaultMaxBatchSize,
-			maxBatchDelay:   bbolt.DefaultMaxBatchDelay,
-		},
+	o := options{
+		log:             &logger.Logger{Logger: zap.NewNop()},
+		typ:             TypeBBolt,
+		maxObjectSize:   defaultMaxObjectSize,
+		smallObjectSize: defaultSmallObjectSize,
+		workersCount:    defaultFlushWorkersCount,
+		maxCacheSize:    defaultMaxCacheSize,
+		maxBatchSize:    bbolt.DefaultMaxBatchSize,
+		maxBatchDelay:   bbolt.DefaultMaxBatchDelay,
+		metrics:         noopMetrics{},
 	}
 
 	for i := range opts {
-		opts[i](&c.options)
+		opts[i](&o)
+	}
+
+	if o.typ == TypeLog {
+		return newLogCache(o)
+	}
+
+	c := &cache{
+		flushCh:   make(chan *object.Object),
+		mode:      mode.ReadWrite,
+		scheduler: newFlushScheduler(),
+
+		compressFlags: make(map[string]struct{}),
+		options:       o,
 	}
 
 	// Make the LRU cache contain which take approximately 3/4 of the maximum space.
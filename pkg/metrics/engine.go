@@ -21,6 +21,22 @@ type (
 		listObjectsDuration           prometheus.Counter
 		containerSize                 prometheus.GaugeVec
 		payloadSize                   prometheus.GaugeVec
+		quotaLimit                    prometheus.GaugeVec
+		quotaExceeded                 prometheus.CounterVec
+		scrubbedObjects               prometheus.CounterVec
+		corruptedObjects              prometheus.CounterVec
+		writeCacheFillRatio           prometheus.GaugeVec
+		writeCacheFlushLag            prometheus.GaugeVec
+		ioOperations                  prometheus.CounterVec
+		ioThrottled                   prometheus.CounterVec
+		ioBytes                       prometheus.CounterVec
+		gcObjects                     prometheus.CounterVec
+		gcQueueLength                 prometheus.GaugeVec
+		gcLastRun                     prometheus.GaugeVec
+
+		methodDuration        prometheus.HistogramVec
+		shardMethodDuration   prometheus.HistogramVec
+		storageMethodDuration prometheus.HistogramVec
 	}
 )
 
@@ -118,6 +134,114 @@ func newEngineMetrics() engineMetrics {
 			Name:      "payload_size",
 			Help:      "Accumulated size of all objects in a shard",
 		}, []string{shardIDLabelKey})
+
+		quotaLimit = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "quota_limit",
+			Help:      "Storage quota limits of containers and container owners",
+		}, []string{quotaKindLabelKey, quotaIDLabelKey, quotaLimitLabelKey})
+
+		quotaExceeded = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "quota_exceeded_total",
+			Help:      "Number of object writes exceeding storage quotas",
+		}, []string{quotaKindLabelKey, quotaLimitLabelKey})
+
+		scrubbedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "scrub_checked_objects_total",
+			Help:      "Number of objects checked by the shard payload integrity scrubber",
+		}, []string{shardIDLabelKey})
+
+		corruptedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "scrub_corrupted_objects_total",
+			Help:      "Number of corrupted objects found by the shard payload integrity scrubber",
+		}, []string{shardIDLabelKey})
+
+		writeCacheFillRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "writecache_fill_ratio",
+			Help:      "Estimated fraction of the shard write-cache capacity in use",
+		}, []string{shardIDLabelKey})
+
+		writeCacheFlushLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "writecache_flush_lag_seconds",
+			Help:      "Time the oldest object in the shard write-cache waits to be flushed",
+		}, []string{shardIDLabelKey})
+
+		ioOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "io_operations_total",
+			Help:      "Number of objects affected by the shard I/O operations of the priority class",
+		}, []string{shardIDLabelKey, ioClassLabelKey})
+
+		ioThrottled = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "io_throttled_seconds_total",
+			Help:      "Time the shard I/O operations of the priority class were delayed by the limits",
+		}, []string{shardIDLabelKey, ioClassLabelKey})
+
+		ioBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "io_bytes_total",
+			Help:      "Amount of object data read and written by the shard I/O operations of the priority class",
+		}, []string{shardIDLabelKey, ioClassLabelKey})
+
+		gcObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "gc_objects_total",
+			Help:      "Number of objects removed, expired objects, locks and tombstones handled by the shard garbage collector",
+		}, []string{shardIDLabelKey, counterTypeLabelKey})
+
+		gcQueueLength = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "gc_queue_length",
+			Help:      "Number of objects marked as garbage and waiting for the removal from the shard",
+		}, []string{shardIDLabelKey})
+
+		gcLastRun = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "gc_last_run_timestamp_seconds",
+			Help:      "Unix timestamp of the last garbage removal run of the shard garbage collector",
+		}, []string{shardIDLabelKey})
+
+		methodDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of engine operations",
+			Buckets:   durationBuckets,
+		}, []string{methodLabelKey})
+
+		shardMethodDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "shard_request_duration_seconds",
+			Help:      "Duration of shard operations",
+			Buckets:   durationBuckets,
+		}, []string{shardIDLabelKey, methodLabelKey})
+
+		storageMethodDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "storage_request_duration_seconds",
+			Help:      "Duration of blobstor sub-storage and write-cache operations",
+			Buckets:   durationBuckets,
+		}, []string{shardIDLabelKey, storageLabelKey, methodLabelKey})
 	)
 
 	return engineMetrics{
@@ -134,6 +258,21 @@ func newEngineMetrics() engineMetrics {
 		listObjectsDuration:           listObjectsDuration,
 		containerSize:                 *containerSize,
 		payloadSize:                   *payloadSize,
+		quotaLimit:                    *quotaLimit,
+		quotaExceeded:                 *quotaExceeded,
+		scrubbedObjects:               *scrubbedObjects,
+		corruptedObjects:              *corruptedObjects,
+		writeCacheFillRatio:           *writeCacheFillRatio,
+		writeCacheFlushLag:            *writeCacheFlushLag,
+		ioOperations:                  *ioOperations,
+		ioThrottled:                   *ioThrottled,
+		ioBytes:                       *ioBytes,
+		gcObjects:                     *gcObjects,
+		gcQueueLength:                 *gcQueueLength,
+		gcLastRun:                     *gcLastRun,
+		methodDuration:                *methodDuration,
+		shardMethodDuration:           *shardMethodDuration,
+		storageMethodDuration:         *storageMethodDuration,
 	}
 }
 
@@ -151,50 +290,76 @@ func (m engineMetrics) register() {
 	prometheus.MustRegister(m.listObjectsDuration)
 	prometheus.MustRegister(m.containerSize)
 	prometheus.MustRegister(m.payloadSize)
+	prometheus.MustRegister(m.quotaLimit)
+	prometheus.MustRegister(m.quotaExceeded)
+	prometheus.MustRegister(m.scrubbedObjects)
+	prometheus.MustRegister(m.corruptedObjects)
+	prometheus.MustRegister(m.writeCacheFillRatio)
+	prometheus.MustRegister(m.writeCacheFlushLag)
+	prometheus.MustRegister(m.ioOperations)
+	prometheus.MustRegister(m.ioThrottled)
+	prometheus.MustRegister(m.ioBytes)
+	prometheus.MustRegister(m.gcObjects)
+	prometheus.MustRegister(m.gcQueueLength)
+	prometheus.MustRegister(m.gcLastRun)
+	prometheus.MustRegister(m.methodDuration)
+	prometheus.MustRegister(m.shardMethodDuration)
+	prometheus.MustRegister(m.storageMethodDuration)
 }
 
 func (m engineMetrics) AddListContainersDuration(d time.Duration) {
 	m.listObjectsDuration.Add(float64(d))
+	m.observeDuration("ListContainers", d)
 }
 
 func (m engineMetrics) AddEstimateContainerSizeDuration(d time.Duration) {
 	m.estimateContainerSizeDuration.Add(float64(d))
+	m.observeDuration("EstimateContainerSize", d)
 }
 
 func (m engineMetrics) AddDeleteDuration(d time.Duration) {
 	m.deleteDuration.Add(float64(d))
+	m.observeDuration("Delete", d)
 }
 
 func (m engineMetrics) AddExistsDuration(d time.Duration) {
 	m.existsDuration.Add(float64(d))
+	m.observeDuration("Exists", d)
 }
 
 func (m engineMetrics) AddGetDuration(d time.Duration) {
 	m.getDuration.Add(float64(d))
+	m.observeDuration("Get", d)
 }
 
 func (m engineMetrics) AddHeadDuration(d time.Duration) {
 	m.headDuration.Add(float64(d))
+	m.observeDuration("Head", d)
 }
 
 func (m engineMetrics) AddInhumeDuration(d time.Duration) {
 	m.inhumeDuration.Add(float64(d))
+	m.observeDuration("Inhume", d)
 }
 
 func (m engineMetrics) AddPutDuration(d time.Duration) {
 	m.putDuration.Add(float64(d))
+	m.observeDuration("Put", d)
 }
 
 func (m engineMetrics) AddRangeDuration(d time.Duration) {
 	m.rangeDuration.Add(float64(d))
+	m.observeDuration("GetRange", d)
 }
 
 func (m engineMetrics) AddSearchDuration(d time.Duration) {
 	m.searchDuration.Add(float64(d))
+	m.observeDuration("Search", d)
 }
 
 func (m engineMetrics) AddListObjectsDuration(d time.Duration) {
 	m.listObjectsDuration.Add(float64(d))
+	m.observeDuration("ListObjects", d)
 }
 
 func (m engineMetrics) AddToContainerSize(cnrID string, size int64) {
@@ -204,3 +369,80 @@ func (m engineMetrics) AddToContainerSize(cnrID string, size int64) {
 func (m engineMetrics) AddToPayloadCounter(shardID string, size int64) {
 	m.payloadSize.With(prometheus.Labels{shardIDLabelKey: shardID}).Add(float64(size))
 }
+
+func (m engineMetrics) SetQuotaLimits(kind, id string, soft, hard uint64) {
+	m.quotaLimit.With(prometheus.Labels{
+		quotaKindLabelKey:  kind,
+		quotaIDLabelKey:    id,
+		quotaLimitLabelKey: "soft",
+	}).Set(float64(soft))
+	m.quotaLimit.With(prometheus.Labels{
+		quotaKindLabelKey:  kind,
+		quotaIDLabelKey:    id,
+		quotaLimitLabelKey: "hard",
+	}).Set(float64(hard))
+}
+
+func (m engineMetrics) IncQuotaExceeded(kind, limit string) {
+	m.quotaExceeded.With(prometheus.Labels{
+		quotaKindLabelKey:  kind,
+		quotaLimitLabelKey: limit,
+	}).Inc()
+}
+
+func (m engineMetrics) IncScrubbedObjects(shardID string) {
+	m.scrubbedObjects.With(prometheus.Labels{shardIDLabelKey: shardID}).Inc()
+}
+
+func (m engineMetrics) IncCorruptedObjects(shardID string) {
+	m.corruptedObjects.With(prometheus.Labels{shardIDLabelKey: shardID}).Inc()
+}
+
+func (m engineMetrics) SetWriteCacheFillRatio(shardID string, v float64) {
+	m.writeCacheFillRatio.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(v)
+}
+
+func (m engineMetrics) SetWriteCacheFlushLag(shardID string, d time.Duration) {
+	m.writeCacheFlushLag.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(d.Seconds())
+}
+
+func (m engineMetrics) AddIOOperations(shardID, class string, ops int, throttled time.Duration) {
+	labels := prometheus.Labels{shardIDLabelKey: shardID, ioClassLabelKey: class}
+	m.ioOperations.With(labels).Add(float64(ops))
+	m.ioThrottled.With(labels).Add(throttled.Seconds())
+}
+
+func (m engineMetrics) AddIOBytes(shardID, class string, size uint64) {
+	m.ioBytes.With(prometheus.Labels{shardIDLabelKey: shardID, ioClassLabelKey: class}).Add(float64(size))
+}
+
+func (m engineMetrics) AddGCObjects(shardID, kind string, n int) {
+	m.gcObjects.With(prometheus.Labels{shardIDLabelKey: shardID, counterTypeLabelKey: kind}).Add(float64(n))
+}
+
+func (m engineMetrics) SetGCQueueLength(shardID string, v uint64) {
+	m.gcQueueLength.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(float64(v))
+}
+
+func (m engineMetrics) SetGCLastRun(shardID string, t time.Time) {
+	m.gcLastRun.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(float64(t.Unix()))
+}
+
+func (m engineMetrics) observeDuration(method string, d time.Duration) {
+	m.methodDuration.With(prometheus.Labels{methodLabelKey: method}).Observe(d.Seconds())
+}
+
+func (m engineMetrics) AddShardMethodDuration(shardID, method string, d time.Duration) {
+	m.shardMethodDuration.With(prometheus.Labels{
+		shardIDLabelKey: shardID,
+		methodLabelKey:  method,
+	}).Observe(d.Seconds())
+}
+
+func (m engineMetrics) AddStorageMethodDuration(shardID, storage, method string, d time.Duration) {
+	m.storageMethodDuration.With(prometheus.Labels{
+		shardIDLabelKey: shardID,
+		storageLabelKey: storage,
+		methodLabelKey:  method,
+	}).Observe(d.Seconds())
+}
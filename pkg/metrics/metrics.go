@@ -4,6 +4,10 @@ import "github.com/prometheus/client_golang/prometheus"
 
 const namespace = "frostfs_node"
 
+// durationBuckets are the buckets of the operation latency histograms
+// in seconds, from 0.5ms to ~16s.
+var durationBuckets = prometheus.ExponentialBuckets(0.0005, 2, 16)
+
 type NodeMetrics struct {
 	objectServiceMetrics
 	engineMetrics
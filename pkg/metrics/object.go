@@ -37,6 +37,12 @@ type (
 
 		shardMetrics   *prometheus.GaugeVec
 		shardsReadonly *prometheus.GaugeVec
+
+		requestDuration *prometheus.HistogramVec
+
+		getCacheRequests *prometheus.CounterVec
+		getCacheObjects  prometheus.Gauge
+		getCacheSize     prometheus.Gauge
 	}
 )
 
@@ -44,6 +50,14 @@ const (
 	shardIDLabelKey     = "shard"
 	counterTypeLabelKey = "type"
 	containerIDLabelKey = "cid"
+	quotaKindLabelKey   = "kind"
+	quotaIDLabelKey     = "id"
+	quotaLimitLabelKey  = "limit"
+	methodLabelKey      = "method"
+	storageLabelKey     = "storage"
+	statusLabelKey      = "status"
+	ioClassLabelKey     = "class"
+	cacheResultLabelKey = "result"
 )
 
 func newMethodCallCounter(name string) methodCount {
@@ -169,6 +183,41 @@ func newObjectServiceMetrics() objectServiceMetrics {
 		},
 			[]string{shardIDLabelKey},
 		)
+
+		requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of object service requests",
+			Buckets:   durationBuckets,
+		},
+			[]string{methodLabelKey, statusLabelKey},
+		)
+	)
+
+	var ( // Object cache metrics.
+		getCacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "get_cache_requests_total",
+			Help:      "Number of object cache lookups by result",
+		},
+			[]string{cacheResultLabelKey},
+		)
+
+		getCacheObjects = prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "get_cache_objects",
+			Help:      "Number of objects in the object cache",
+		})
+
+		getCacheSize = prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "get_cache_size_bytes",
+			Help:      "Total payload size of objects in the object cache",
+		})
 	)
 
 	return objectServiceMetrics{
@@ -190,6 +239,10 @@ func newObjectServiceMetrics() objectServiceMetrics {
 		getPayload:        getPayload,
 		shardMetrics:      shardsMetrics,
 		shardsReadonly:    shardsReadonly,
+		requestDuration:   requestDuration,
+		getCacheRequests:  getCacheRequests,
+		getCacheObjects:   getCacheObjects,
+		getCacheSize:      getCacheSize,
 	}
 }
 
@@ -215,6 +268,11 @@ func (m objectServiceMetrics) register() {
 
 	prometheus.MustRegister(m.shardMetrics)
 	prometheus.MustRegister(m.shardsReadonly)
+	prometheus.MustRegister(m.requestDuration)
+
+	prometheus.MustRegister(m.getCacheRequests)
+	prometheus.MustRegister(m.getCacheObjects)
+	prometheus.MustRegister(m.getCacheSize)
 }
 
 func (m objectServiceMetrics) IncGetReqCounter(success bool) {
@@ -273,6 +331,15 @@ func (m objectServiceMetrics) AddRangeHashReqDuration(d time.Duration) {
 	m.rangeHashDuration.Add(float64(d))
 }
 
+func (m objectServiceMetrics) AddRequestDuration(method, status string, d time.Duration) {
+	m.requestDuration.With(
+		prometheus.Labels{
+			methodLabelKey: method,
+			statusLabelKey: status,
+		},
+	).Observe(d.Seconds())
+}
+
 func (m objectServiceMetrics) AddPutPayload(ln int) {
 	m.putPayload.Add(float64(ln))
 }
@@ -310,3 +377,20 @@ func (m objectServiceMetrics) SetReadonly(shardID string, readonly bool) {
 		},
 	).Set(flag)
 }
+
+func (m objectServiceMetrics) IncGetCacheRequests(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.getCacheRequests.With(
+		prometheus.Labels{
+			cacheResultLabelKey: result,
+		},
+	).Inc()
+}
+
+func (m objectServiceMetrics) SetGetCacheSize(objects int, size uint64) {
+	m.getCacheObjects.Set(float64(objects))
+	m.getCacheSize.Set(float64(size))
+}
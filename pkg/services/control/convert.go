@@ -200,3 +200,219 @@ func (w *flushCacheResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 	w.FlushCacheResponse = r
 	return nil
 }
+
+type startShardEvacuationResponseWrapper struct {
+	*StartShardEvacuationResponse
+}
+
+func (w *startShardEvacuationResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StartShardEvacuationResponse
+}
+
+func (w *startShardEvacuationResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StartShardEvacuationResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StartShardEvacuationResponse)(nil))
+	}
+
+	w.StartShardEvacuationResponse = r
+	return nil
+}
+
+type getShardEvacuationStatusResponseWrapper struct {
+	*GetShardEvacuationStatusResponse
+}
+
+func (w *getShardEvacuationStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetShardEvacuationStatusResponse
+}
+
+func (w *getShardEvacuationStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetShardEvacuationStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetShardEvacuationStatusResponse)(nil))
+	}
+
+	w.GetShardEvacuationStatusResponse = r
+	return nil
+}
+
+type stopShardEvacuationResponseWrapper struct {
+	*StopShardEvacuationResponse
+}
+
+func (w *stopShardEvacuationResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StopShardEvacuationResponse
+}
+
+func (w *stopShardEvacuationResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StopShardEvacuationResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StopShardEvacuationResponse)(nil))
+	}
+
+	w.StopShardEvacuationResponse = r
+	return nil
+}
+
+type startShardRebuildResponseWrapper struct {
+	*StartShardRebuildResponse
+}
+
+func (w *startShardRebuildResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StartShardRebuildResponse
+}
+
+func (w *startShardRebuildResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StartShardRebuildResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StartShardRebuildResponse)(nil))
+	}
+
+	w.StartShardRebuildResponse = r
+	return nil
+}
+
+type getShardRebuildStatusResponseWrapper struct {
+	*GetShardRebuildStatusResponse
+}
+
+func (w *getShardRebuildStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetShardRebuildStatusResponse
+}
+
+func (w *getShardRebuildStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetShardRebuildStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetShardRebuildStatusResponse)(nil))
+	}
+
+	w.GetShardRebuildStatusResponse = r
+	return nil
+}
+
+type startShardScrubResponseWrapper struct {
+	*StartShardScrubResponse
+}
+
+func (w *startShardScrubResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StartShardScrubResponse
+}
+
+func (w *startShardScrubResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StartShardScrubResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StartShardScrubResponse)(nil))
+	}
+
+	w.StartShardScrubResponse = r
+	return nil
+}
+
+type stopShardScrubResponseWrapper struct {
+	*StopShardScrubResponse
+}
+
+func (w *stopShardScrubResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StopShardScrubResponse
+}
+
+func (w *stopShardScrubResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StopShardScrubResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StopShardScrubResponse)(nil))
+	}
+
+	w.StopShardScrubResponse = r
+	return nil
+}
+
+type getShardScrubStatusResponseWrapper struct {
+	*GetShardScrubStatusResponse
+}
+
+func (w *getShardScrubStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetShardScrubStatusResponse
+}
+
+func (w *getShardScrubStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetShardScrubStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetShardScrubStatusResponse)(nil))
+	}
+
+	w.GetShardScrubStatusResponse = r
+	return nil
+}
+
+type addShardResponseWrapper struct {
+	*AddShardResponse
+}
+
+func (w *addShardResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.AddShardResponse
+}
+
+func (w *addShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*AddShardResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*AddShardResponse)(nil))
+	}
+
+	w.AddShardResponse = r
+	return nil
+}
+
+type removeShardResponseWrapper struct {
+	*RemoveShardResponse
+}
+
+func (w *removeShardResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.RemoveShardResponse
+}
+
+func (w *removeShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*RemoveShardResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*RemoveShardResponse)(nil))
+	}
+
+	w.RemoveShardResponse = r
+	return nil
+}
+
+type runShardGCResponseWrapper struct {
+	*RunShardGCResponse
+}
+
+func (w *runShardGCResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.RunShardGCResponse
+}
+
+func (w *runShardGCResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*RunShardGCResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*RunShardGCResponse)(nil))
+	}
+
+	w.RunShardGCResponse = r
+	return nil
+}
+
+type setShardGCParamsResponseWrapper struct {
+	*SetShardGCParamsResponse
+}
+
+func (w *setShardGCParamsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.SetShardGCParamsResponse
+}
+
+func (w *setShardGCParamsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*SetShardGCParamsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*SetShardGCParamsResponse)(nil))
+	}
+
+	w.SetShardGCParamsResponse = r
+	return nil
+}
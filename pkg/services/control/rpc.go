@@ -18,6 +18,19 @@ const (
 	rpcSynchronizeTree = "SynchronizeTree"
 	rpcEvacuateShard   = "EvacuateShard"
 	rpcFlushCache      = "FlushCache"
+
+	rpcStartShardEvacuation     = "StartShardEvacuation"
+	rpcGetShardEvacuationStatus = "GetShardEvacuationStatus"
+	rpcStopShardEvacuation      = "StopShardEvacuation"
+	rpcStartShardRebuild        = "StartShardRebuild"
+	rpcGetShardRebuildStatus    = "GetShardRebuildStatus"
+	rpcStartShardScrub          = "StartShardScrub"
+	rpcStopShardScrub           = "StopShardScrub"
+	rpcGetShardScrubStatus      = "GetShardScrubStatus"
+	rpcAddShard                 = "AddShard"
+	rpcRemoveShard              = "RemoveShard"
+	rpcRunShardGC               = "RunShardGC"
+	rpcSetShardGCParams         = "SetShardGCParams"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -191,3 +204,159 @@ func FlushCache(cli *client.Client, req *FlushCacheRequest, opts ...client.CallO
 
 	return wResp.FlushCacheResponse, nil
 }
+
+// StartShardEvacuation executes ControlService.StartShardEvacuation RPC.
+func StartShardEvacuation(cli *client.Client, req *StartShardEvacuationRequest, opts ...client.CallOption) (*StartShardEvacuationResponse, error) {
+	wResp := &startShardEvacuationResponseWrapper{new(StartShardEvacuationResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStartShardEvacuation), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StartShardEvacuationResponse, nil
+}
+
+// GetShardEvacuationStatus executes ControlService.GetShardEvacuationStatus RPC.
+func GetShardEvacuationStatus(cli *client.Client, req *GetShardEvacuationStatusRequest, opts ...client.CallOption) (*GetShardEvacuationStatusResponse, error) {
+	wResp := &getShardEvacuationStatusResponseWrapper{new(GetShardEvacuationStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetShardEvacuationStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetShardEvacuationStatusResponse, nil
+}
+
+// StopShardEvacuation executes ControlService.StopShardEvacuation RPC.
+func StopShardEvacuation(cli *client.Client, req *StopShardEvacuationRequest, opts ...client.CallOption) (*StopShardEvacuationResponse, error) {
+	wResp := &stopShardEvacuationResponseWrapper{new(StopShardEvacuationResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStopShardEvacuation), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StopShardEvacuationResponse, nil
+}
+
+// StartShardRebuild executes ControlService.StartShardRebuild RPC.
+func StartShardRebuild(cli *client.Client, req *StartShardRebuildRequest, opts ...client.CallOption) (*StartShardRebuildResponse, error) {
+	wResp := &startShardRebuildResponseWrapper{new(StartShardRebuildResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStartShardRebuild), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StartShardRebuildResponse, nil
+}
+
+// GetShardRebuildStatus executes ControlService.GetShardRebuildStatus RPC.
+func GetShardRebuildStatus(cli *client.Client, req *GetShardRebuildStatusRequest, opts ...client.CallOption) (*GetShardRebuildStatusResponse, error) {
+	wResp := &getShardRebuildStatusResponseWrapper{new(GetShardRebuildStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetShardRebuildStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetShardRebuildStatusResponse, nil
+}
+
+// StartShardScrub executes ControlService.StartShardScrub RPC.
+func StartShardScrub(cli *client.Client, req *StartShardScrubRequest, opts ...client.CallOption) (*StartShardScrubResponse, error) {
+	wResp := &startShardScrubResponseWrapper{new(StartShardScrubResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStartShardScrub), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StartShardScrubResponse, nil
+}
+
+// StopShardScrub executes ControlService.StopShardScrub RPC.
+func StopShardScrub(cli *client.Client, req *StopShardScrubRequest, opts ...client.CallOption) (*StopShardScrubResponse, error) {
+	wResp := &stopShardScrubResponseWrapper{new(StopShardScrubResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStopShardScrub), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StopShardScrubResponse, nil
+}
+
+// GetShardScrubStatus executes ControlService.GetShardScrubStatus RPC.
+func GetShardScrubStatus(cli *client.Client, req *GetShardScrubStatusRequest, opts ...client.CallOption) (*GetShardScrubStatusResponse, error) {
+	wResp := &getShardScrubStatusResponseWrapper{new(GetShardScrubStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetShardScrubStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetShardScrubStatusResponse, nil
+}
+
+// AddShard executes ControlService.AddShard RPC.
+func AddShard(cli *client.Client, req *AddShardRequest, opts ...client.CallOption) (*AddShardResponse, error) {
+	wResp := &addShardResponseWrapper{new(AddShardResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcAddShard), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.AddShardResponse, nil
+}
+
+// RemoveShard executes ControlService.RemoveShard RPC.
+func RemoveShard(cli *client.Client, req *RemoveShardRequest, opts ...client.CallOption) (*RemoveShardResponse, error) {
+	wResp := &removeShardResponseWrapper{new(RemoveShardResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcRemoveShard), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.RemoveShardResponse, nil
+}
+
+// RunShardGC executes ControlService.RunShardGC RPC.
+func RunShardGC(cli *client.Client, req *RunShardGCRequest, opts ...client.CallOption) (*RunShardGCResponse, error) {
+	wResp := &runShardGCResponseWrapper{new(RunShardGCResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcRunShardGC), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.RunShardGCResponse, nil
+}
+
+// SetShardGCParams executes ControlService.SetShardGCParams RPC.
+func SetShardGCParams(cli *client.Client, req *SetShardGCParamsRequest, opts ...client.CallOption) (*SetShardGCParamsResponse, error) {
+	wResp := &setShardGCParamsResponseWrapper{new(SetShardGCParamsResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcSetShardGCParams), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.SetShardGCParamsResponse, nil
+}
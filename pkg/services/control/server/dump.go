@@ -20,14 +20,20 @@ func (s *Server) DumpShard(_ context.Context, req *control.DumpShardRequest) (*c
 	var prm shard.DumpPrm
 	prm.WithPath(req.GetBody().GetFilepath())
 	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+	prm.WithCompression(req.GetBody().GetCompress())
+	prm.WithBase(req.GetBody().GetBasePath())
 
-	err = s.s.DumpShard(shardID, prm)
+	res, err := s.s.DumpShard(shardID, prm)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	body := new(control.DumpShardResponse_Body)
+	body.SetCount(uint64(res.Count()))
+	body.SetCheckpoint(res.Checkpoint())
+
 	resp := new(control.DumpShardResponse)
-	resp.SetBody(new(control.DumpShardResponse_Body))
+	resp.SetBody(body)
 
 	err = SignMessage(s.key, resp)
 	if err != nil {
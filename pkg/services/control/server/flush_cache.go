@@ -18,6 +18,7 @@ func (s *Server) FlushCache(_ context.Context, req *control.FlushCacheRequest) (
 	for _, shardID := range s.getShardIDList(req.GetBody().GetShard_ID()) {
 		var prm engine.FlushWriteCachePrm
 		prm.SetShardID(shardID)
+		prm.SetDisable(req.GetBody().GetDisable())
 
 		_, err = s.s.FlushWriteCache(prm)
 		if err != nil {
@@ -17,7 +17,7 @@ import (
 //
 // If some address is not a valid object address in a binary format, an error returns.
 // If request is unsigned or signed by disallowed key, permission error returns.
-func (s *Server) DropObjects(_ context.Context, req *control.DropObjectsRequest) (*control.DropObjectsResponse, error) {
+func (s *Server) DropObjects(ctx context.Context, req *control.DropObjectsRequest) (*control.DropObjectsResponse, error) {
 	// verify request
 	if err := s.isValidRequest(req); err != nil {
 		return nil, status.Error(codes.PermissionDenied, err.Error())
@@ -41,7 +41,7 @@ func (s *Server) DropObjects(_ context.Context, req *control.DropObjectsRequest)
 		prm.WithForceRemoval()
 		prm.WithAddress(addrList[i])
 
-		_, err := s.s.Delete(prm)
+		_, err := s.s.Delete(ctx, prm)
 		if err != nil && firstErr == nil {
 			firstErr = err
 		}
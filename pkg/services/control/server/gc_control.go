@@ -0,0 +1,82 @@
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) RunShardGC(_ context.Context, req *control.RunShardGCRequest) (*control.RunShardGCResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	err = s.s.TriggerGC(s.getShardIDList(req.GetBody().GetShard_ID()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.RunShardGCResponse{
+		Body: &control.RunShardGCResponse_Body{},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) SetShardGCParams(_ context.Context, req *control.SetShardGCParamsRequest) (*control.SetShardGCParamsResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	body := req.GetBody()
+	if body.GetPause() && body.GetResume() {
+		return nil, status.Error(codes.InvalidArgument, "garbage collection can't be paused and resumed at the same time")
+	}
+
+	ids := s.getShardIDList(body.GetShard_ID())
+
+	err = s.s.SetGCParams(ids, time.Duration(body.GetInterval())*time.Millisecond, int(body.GetBatchSize()))
+	if err == nil && (body.GetPause() || body.GetResume()) {
+		err = s.s.SetGCPaused(ids, body.GetPause())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.SetShardGCParamsResponse{
+		Body: &control.SetShardGCParamsResponse_Body{},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func gcStatusToProto(st engine.ShardGCStatus) *control.GCStatus {
+	res := &control.GCStatus{
+		Paused:            st.Paused(),
+		Interval:          uint64(st.Interval().Milliseconds()),
+		BatchSize:         uint32(st.BatchSize()),
+		QueueLength:       st.QueueLength(),
+		Removed:           st.Removed(),
+		ExpiredObjects:    st.ExpiredObjects(),
+		ExpiredLocks:      st.ExpiredLocks(),
+		ExpiredTombstones: st.ExpiredTombstones(),
+	}
+	if t := st.LastRun(); !t.IsZero() {
+		res.LastRun = t.Unix()
+	}
+	return res
+}
@@ -2,8 +2,11 @@ package control
 
 import (
 	"context"
+	"crypto/sha256"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
 	"google.golang.org/grpc/codes"
@@ -24,6 +27,19 @@ func (s *Server) ListShards(_ context.Context, req *control.ListShardsRequest) (
 
 	info := s.s.DumpInfo()
 
+	ids := make([]*shard.ID, 0, len(info.Shards))
+	for _, sh := range info.Shards {
+		ids = append(ids, sh.ID)
+	}
+
+	// a shard can be detached concurrently, GC states are omitted then
+	gcStates := make(map[string]*control.GCStatus, len(ids))
+	if states, err := s.s.GCStatus(ids); err == nil {
+		for i := range states {
+			gcStates[states[i].ShardID().String()] = gcStatusToProto(states[i])
+		}
+	}
+
 	shardInfos := make([]*control.ShardInfo, 0, len(info.Shards))
 
 	for _, sh := range info.Shards {
@@ -32,7 +48,9 @@ func (s *Server) ListShards(_ context.Context, req *control.ListShardsRequest) (
 		si.SetID(*sh.ID)
 		si.SetMetabasePath(sh.MetaBaseInfo.Path)
 		si.Blobstor = blobstorInfoToProto(sh.BlobStorInfo)
-		si.SetWriteCachePath(sh.WriteCacheInfo.Path)
+		if !sh.WriteCacheDisabled {
+			si.SetWriteCachePath(sh.WriteCacheInfo.Path)
+		}
 		si.SetPiloramaPath(sh.PiloramaInfo.Path)
 
 		var m control.ShardMode
@@ -52,11 +70,13 @@ func (s *Server) ListShards(_ context.Context, req *control.ListShardsRequest) (
 
 		si.SetMode(m)
 		si.SetErrorCount(sh.ErrorCount)
+		si.SetGC(gcStates[sh.ID.String()])
 
 		shardInfos = append(shardInfos, si)
 	}
 
 	body.SetShards(shardInfos)
+	body.SetQuotas(quotaInfoToProto(s.s.QuotaInfo()))
 
 	// sign the response
 	if err := SignMessage(s.key, resp); err != nil {
@@ -76,3 +96,21 @@ func blobstorInfoToProto(info blobstor.Info) []*control.BlobstorInfo {
 	}
 	return res
 }
+
+func quotaInfoToProto(info []engine.QuotaInfo) []*control.QuotaInfo {
+	res := make([]*control.QuotaInfo, len(info))
+	for i := range info {
+		res[i] = &control.QuotaInfo{
+			Owner_ID:  info[i].Owner.WalletBytes(),
+			SoftLimit: info[i].Limits.Soft,
+			HardLimit: info[i].Limits.Hard,
+			Size:      info[i].Size,
+		}
+
+		if info[i].Container != nil {
+			res[i].Container_ID = make([]byte, sha256.Size)
+			info[i].Container.Encode(res[i].Container_ID)
+		}
+	}
+	return res
+}
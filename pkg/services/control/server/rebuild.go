@@ -0,0 +1,83 @@
+package control
+
+import (
+	"context"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) StartShardRebuild(_ context.Context, req *control.StartShardRebuildRequest) (*control.StartShardRebuildResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	fillPercent := req.GetBody().GetFillPercent()
+	if fillPercent > 100 {
+		return nil, status.Error(codes.InvalidArgument, "fill percent must not exceed 100")
+	}
+
+	var prm engine.RebuildPrm
+	prm.SetShardIDs(s.getShardIDList(req.GetBody().GetShard_ID()))
+	prm.SetFillPercent(int(fillPercent))
+
+	err = s.s.StartRebuild(prm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.StartShardRebuildResponse{
+		Body: &control.StartShardRebuildResponse_Body{},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) GetShardRebuildStatus(_ context.Context, req *control.GetShardRebuildStatusRequest) (*control.GetShardRebuildStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	states, err := s.s.RebuildState(s.getShardIDList(req.GetBody().GetShard_ID()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	results := make([]*control.GetShardRebuildStatusResponse_Body_Status, 0, len(states))
+	for i := range states {
+		st := &control.GetShardRebuildStatusResponse_Body_Status{
+			Shard_ID:     *states[i].ShardID(),
+			Running:      states[i].Running(),
+			ObjectsMoved: states[i].ObjectsMoved(),
+			FilesRemoved: states[i].FilesRemoved(),
+			ErrorMessage: states[i].ErrorMessage(),
+		}
+		if t := states[i].StartedAt(); !t.IsZero() {
+			st.StartedAt = t.Unix()
+		}
+		if t := states[i].FinishedAt(); !t.IsZero() {
+			st.FinishedAt = t.Unix()
+		}
+		results = append(results, st)
+	}
+
+	resp := &control.GetShardRebuildStatusResponse{
+		Body: &control.GetShardRebuildStatusResponse_Body{
+			Results: results,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -20,6 +20,9 @@ func (s *Server) RestoreShard(_ context.Context, req *control.RestoreShardReques
 	var prm shard.RestorePrm
 	prm.WithPath(req.GetBody().GetFilepath())
 	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+	if s.maxObjSizeSrc != nil {
+		prm.WithMaxObjectSize(s.maxObjSizeSrc.MaxObjectSize())
+	}
 
 	err = s.s.RestoreShard(shardID, prm)
 	if err != nil {
@@ -0,0 +1,106 @@
+package control
+
+import (
+	"context"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) StartShardScrub(_ context.Context, req *control.StartShardScrubRequest) (*control.StartShardScrubResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	var prm engine.ScrubPrm
+	prm.SetShardIDs(s.getShardIDList(req.GetBody().GetShard_ID()))
+	prm.SetRateLimit(req.GetBody().GetRateLimit())
+
+	err = s.s.StartScrub(prm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.StartShardScrubResponse{
+		Body: &control.StartShardScrubResponse_Body{},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) StopShardScrub(_ context.Context, req *control.StopShardScrubRequest) (*control.StopShardScrubResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	err = s.s.StopScrub(s.getShardIDList(req.GetBody().GetShard_ID()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.StopShardScrubResponse{
+		Body: &control.StopShardScrubResponse_Body{},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) GetShardScrubStatus(_ context.Context, req *control.GetShardScrubStatusRequest) (*control.GetShardScrubStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	states, err := s.s.ScrubState(s.getShardIDList(req.GetBody().GetShard_ID()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	results := make([]*control.GetShardScrubStatusResponse_Body_Status, 0, len(states))
+	for i := range states {
+		corrupted := states[i].CorruptedObjects()
+
+		st := &control.GetShardScrubStatusResponse_Body_Status{
+			Shard_ID:         *states[i].ShardID(),
+			Running:          states[i].Running(),
+			ObjectsChecked:   states[i].ObjectsChecked(),
+			ObjectsCorrupted: states[i].ObjectsCorrupted(),
+			CorruptedObjects: make([]string, 0, len(corrupted)),
+			ErrorMessage:     states[i].ErrorMessage(),
+		}
+		for j := range corrupted {
+			st.CorruptedObjects = append(st.CorruptedObjects, corrupted[j].EncodeToString())
+		}
+		if t := states[i].StartedAt(); !t.IsZero() {
+			st.StartedAt = t.Unix()
+		}
+		if t := states[i].FinishedAt(); !t.IsZero() {
+			st.FinishedAt = t.Unix()
+		}
+		results = append(results, st)
+	}
+
+	resp := &control.GetShardScrubStatusResponse{
+		Body: &control.GetShardScrubStatusResponse_Body{
+			Results: results,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -45,6 +45,13 @@ type NodeState interface {
 	ForceMaintenance() error
 }
 
+// MaxObjectSizeSource is a source of the maximum object payload size in the network.
+type MaxObjectSizeSource interface {
+	// MaxObjectSize returns the maximum object payload size,
+	// zero if it can't be determined.
+	MaxObjectSize() uint64
+}
+
 // Option of the Server's constructor.
 type Option func(*cfg)
 
@@ -65,6 +72,10 @@ type cfg struct {
 
 	treeService TreeService
 
+	shardConfigurator ShardConfigurator
+
+	maxObjSizeSrc MaxObjectSizeSource
+
 	s *engine.StorageEngine
 }
 
@@ -151,3 +162,19 @@ func WithTreeService(s TreeService) Option {
 		c.treeService = s
 	}
 }
+
+// WithShardConfigurator returns an option to set the component
+// changing the set of the shards of the running node.
+func WithShardConfigurator(sc ShardConfigurator) Option {
+	return func(c *cfg) {
+		c.shardConfigurator = sc
+	}
+}
+
+// WithMaxObjectSizeSource returns an option to set the source of the
+// maximum object size used to validate the restored dumps.
+func WithMaxObjectSizeSource(src MaxObjectSizeSource) Option {
+	return func(c *cfg) {
+		c.maxObjSizeSrc = src
+	}
+}
@@ -0,0 +1,152 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ShardConfigurator changes the set of the shards of the running node.
+type ShardConfigurator interface {
+	// AddShard creates a shard from the configuration section in YAML format,
+	// attaches it to the local storage and persists the configuration change.
+	AddShard(cfg []byte) (*shard.ID, error)
+
+	// RemoveShards detaches the shards from the local storage and persists
+	// the configuration change.
+	RemoveShards(ids []*shard.ID) error
+}
+
+func (s *Server) AddShard(_ context.Context, req *control.AddShardRequest) (*control.AddShardResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.shardConfigurator == nil {
+		return nil, status.Error(codes.Unimplemented, "shards configuration is not supported")
+	}
+
+	cfg := req.GetBody().GetConfig()
+	if len(cfg) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty shard configuration")
+	}
+
+	id, err := s.shardConfigurator.AddShard(cfg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.AddShardResponse{
+		Body: &control.AddShardResponse_Body{
+			Shard_ID: *id,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) RemoveShard(ctx context.Context, req *control.RemoveShardRequest) (*control.RemoveShardResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.shardConfigurator == nil {
+		return nil, status.Error(codes.Unimplemented, "shards configuration is not supported")
+	}
+
+	// an empty list means all the shards for the other RPCs,
+	// removing all of them is never intended
+	if len(req.GetBody().GetShard_ID()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no shards to remove")
+	}
+
+	// the removed shards may be evacuated or be the evacuation targets
+	if s.s.GetEvacuationState().ProcessingStatus() == engine.EvacuateProcessStateRunning {
+		return nil, status.Error(codes.FailedPrecondition, "shards can't be removed while evacuation is running")
+	}
+
+	ids := s.getShardIDList(req.GetBody().GetShard_ID())
+
+	var evacuated uint64
+	if req.GetBody().GetEvacuate() {
+		res, err := s.evacuateRemovedShards(ctx, ids, req.GetBody().GetIgnoreErrors())
+		if err != nil {
+			return nil, err
+		}
+		evacuated = res.Evacuated()
+	}
+
+	err = s.shardConfigurator.RemoveShards(ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if evacuated > math.MaxUint32 {
+		evacuated = math.MaxUint32
+	}
+
+	resp := &control.RemoveShardResponse{
+		Body: &control.RemoveShardResponse_Body{
+			Evacuated: uint32(evacuated),
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// evacuateRemovedShards moves the objects from the shards to be removed to
+// the other ones. The shards are switched to read-only mode for the evacuation,
+// their previous modes are restored if it fails.
+func (s *Server) evacuateRemovedShards(ctx context.Context, ids []*shard.ID, ignoreErrors bool) (*engine.EvacuateShardRes, error) {
+	prevModes := make(map[string]mode.Mode, len(ids))
+	for _, sh := range s.s.DumpInfo().Shards {
+		prevModes[sh.ID.String()] = sh.Mode
+	}
+
+	restore := func(ids []*shard.ID) {
+		for _, id := range ids {
+			if m, ok := prevModes[id.String()]; ok {
+				// the mode can't be restored if the shard is removed concurrently,
+				// there is nothing to do with it then
+				_ = s.s.SetShardMode(id, m, false)
+			}
+		}
+	}
+
+	for i, id := range ids {
+		err := s.s.SetShardMode(id, mode.ReadOnly, false)
+		if err != nil {
+			restore(ids[:i])
+			return nil, status.Error(codes.Internal, fmt.Sprintf("set %s shard read-only: %v", id, err))
+		}
+	}
+
+	var prm engine.EvacuateShardPrm
+	prm.WithShardIDList(ids)
+	prm.WithIgnoreErrors(ignoreErrors)
+	prm.WithFaultHandler(s.replicate)
+
+	res, err := s.s.Evacuate(ctx, prm)
+	if err != nil {
+		restore(ids)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("evacuate shards: %v", err))
+	}
+	return res, nil
+}
@@ -89,6 +89,13 @@ func (x *ListShardsResponse_Body) SetShards(v []*ShardInfo) {
 	}
 }
 
+// SetQuotas sets storage quotas of the storage node.
+func (x *ListShardsResponse_Body) SetQuotas(v []*QuotaInfo) {
+	if x != nil {
+		x.Quotas = v
+	}
+}
+
 // SetBody sets list shards response body.
 func (x *ListShardsResponse) SetBody(v *ListShardsResponse_Body) {
 	if x != nil {
@@ -142,6 +149,16 @@ func (x *DumpShardRequest_Body) SetIgnoreErrors(ignore bool) {
 	x.IgnoreErrors = ignore
 }
 
+// SetCompress sets compression flag for the dump shard request.
+func (x *DumpShardRequest_Body) SetCompress(compress bool) {
+	x.Compress = compress
+}
+
+// SetBasePath sets path to the previous dump for the dump shard request.
+func (x *DumpShardRequest_Body) SetBasePath(p string) {
+	x.BasePath = p
+}
+
 // SetBody sets request body.
 func (x *DumpShardRequest) SetBody(v *DumpShardRequest_Body) {
 	if x != nil {
@@ -156,6 +173,16 @@ func (x *DumpShardResponse) SetBody(v *DumpShardResponse_Body) {
 	}
 }
 
+// SetCount sets number of the dumped objects.
+func (x *DumpShardResponse_Body) SetCount(v uint64) {
+	x.Count = v
+}
+
+// SetCheckpoint sets checkpoint of the dump.
+func (x *DumpShardResponse_Body) SetCheckpoint(v uint64) {
+	x.Checkpoint = v
+}
+
 // SetShardID sets shard ID for the restore shard request.
 func (x *RestoreShardRequest_Body) SetShardID(id []byte) {
 	x.Shard_ID = id
@@ -2,6 +2,7 @@ package control_test
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
@@ -71,7 +72,7 @@ func TestListShardsResponse_Body_StableMarshal(t *testing.T) {
 }
 
 func equalListShardResponseBodies(b1, b2 *control.ListShardsResponse_Body) bool {
-	if len(b1.Shards) != len(b2.Shards) {
+	if len(b1.Shards) != len(b2.Shards) || !compareQuotaInfo(b1.Quotas, b2.Quotas) {
 		return false
 	}
 
@@ -79,7 +80,8 @@ func equalListShardResponseBodies(b1, b2 *control.ListShardsResponse_Body) bool
 		if b1.Shards[i].GetMetabasePath() != b2.Shards[i].GetMetabasePath() ||
 			b1.Shards[i].GetWritecachePath() != b2.Shards[i].GetWritecachePath() ||
 			b1.Shards[i].GetPiloramaPath() != b2.Shards[i].GetPiloramaPath() ||
-			!bytes.Equal(b1.Shards[i].GetShard_ID(), b2.Shards[i].GetShard_ID()) {
+			!bytes.Equal(b1.Shards[i].GetShard_ID(), b2.Shards[i].GetShard_ID()) ||
+			!compareGCStatus(b1.Shards[i].GetGc(), b2.Shards[i].GetGc()) {
 			return false
 		}
 
@@ -101,6 +103,19 @@ func equalListShardResponseBodies(b1, b2 *control.ListShardsResponse_Body) bool
 
 	return true
 }
+
+func compareGCStatus(a, b *control.GCStatus) bool {
+	return a.GetPaused() == b.GetPaused() &&
+		a.GetInterval() == b.GetInterval() &&
+		a.GetBatchSize() == b.GetBatchSize() &&
+		a.GetQueueLength() == b.GetQueueLength() &&
+		a.GetRemoved() == b.GetRemoved() &&
+		a.GetExpiredObjects() == b.GetExpiredObjects() &&
+		a.GetExpiredLocks() == b.GetExpiredLocks() &&
+		a.GetExpiredTombstones() == b.GetExpiredTombstones() &&
+		a.GetLastRun() == b.GetLastRun()
+}
+
 func compareBlobstorInfo(a, b []*control.BlobstorInfo) bool {
 	if len(a) != len(b) {
 		return false
@@ -114,12 +129,42 @@ func compareBlobstorInfo(a, b []*control.BlobstorInfo) bool {
 	return true
 }
 
+func compareQuotaInfo(a, b []*control.QuotaInfo) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i].Container_ID, b[i].Container_ID) ||
+			!bytes.Equal(a[i].Owner_ID, b[i].Owner_ID) ||
+			a[i].SoftLimit != b[i].SoftLimit ||
+			a[i].HardLimit != b[i].HardLimit ||
+			a[i].Size != b[i].Size {
+			return false
+		}
+	}
+	return true
+}
+
 func generateListShardsResponseBody() *control.ListShardsResponse_Body {
 	body := new(control.ListShardsResponse_Body)
 	body.SetShards([]*control.ShardInfo{
 		generateShardInfo(0),
 		generateShardInfo(1),
 	})
+	body.SetQuotas([]*control.QuotaInfo{
+		{
+			Container_ID: []byte{0, 1, 2},
+			Owner_ID:     []byte{3, 4, 5},
+			SoftLimit:    100,
+			HardLimit:    200,
+			Size:         150,
+		},
+		{
+			Owner_ID:  []byte{6, 7, 8},
+			HardLimit: 1000,
+			Size:      500,
+		},
+	})
 
 	return body
 }
@@ -176,3 +221,258 @@ func TestSynchronizeTreeRequest_Body_StableMarshal(t *testing.T) {
 		},
 	)
 }
+
+func TestGetShardEvacuationStatusResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.GetShardEvacuationStatusResponse_Body{
+			TotalObjects:     100,
+			EvacuatedObjects: 40,
+			FailedObjects:    2,
+			SkippedObjects:   8,
+			EvacuatedBytes:   4096,
+			Shard_ID:         [][]byte{{1, 2, 3}, {4, 5}},
+			Status:           control.GetShardEvacuationStatusResponse_Body_RUNNING,
+			Duration:         &control.GetShardEvacuationStatusResponse_Body_Duration{Seconds: 60},
+			StartedAt:        &control.GetShardEvacuationStatusResponse_Body_UnixTimestamp{Value: 1672531200},
+			Eta:              &control.GetShardEvacuationStatusResponse_Body_Duration{Seconds: 90},
+			ErrorMessage:     "some error",
+		},
+		new(control.GetShardEvacuationStatusResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.GetShardEvacuationStatusResponse_Body)
+			b2 := m2.(*control.GetShardEvacuationStatusResponse_Body)
+			if len(b1.GetShard_ID()) != len(b2.GetShard_ID()) {
+				return false
+			}
+			for i := range b1.GetShard_ID() {
+				if !bytes.Equal(b1.GetShard_ID()[i], b2.GetShard_ID()[i]) {
+					return false
+				}
+			}
+			return b1.GetTotalObjects() == b2.GetTotalObjects() &&
+				b1.GetEvacuatedObjects() == b2.GetEvacuatedObjects() &&
+				b1.GetFailedObjects() == b2.GetFailedObjects() &&
+				b1.GetSkippedObjects() == b2.GetSkippedObjects() &&
+				b1.GetEvacuatedBytes() == b2.GetEvacuatedBytes() &&
+				b1.GetStatus() == b2.GetStatus() &&
+				b1.GetDuration().GetSeconds() == b2.GetDuration().GetSeconds() &&
+				b1.GetStartedAt().GetValue() == b2.GetStartedAt().GetValue() &&
+				b1.GetEta().GetSeconds() == b2.GetEta().GetSeconds() &&
+				b1.GetErrorMessage() == b2.GetErrorMessage()
+		},
+	)
+}
+
+func TestGetShardRebuildStatusResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.GetShardRebuildStatusResponse_Body{
+			Results: []*control.GetShardRebuildStatusResponse_Body_Status{
+				{
+					Shard_ID:     []byte{1, 2, 3},
+					Running:      true,
+					StartedAt:    1672531200,
+					ErrorMessage: "",
+				},
+				{
+					Shard_ID:     []byte{4, 5},
+					StartedAt:    1672531200,
+					FinishedAt:   1672534800,
+					ObjectsMoved: 1000,
+					FilesRemoved: 10,
+					ErrorMessage: "some error",
+				},
+			},
+		},
+		new(control.GetShardRebuildStatusResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			r1 := m1.(*control.GetShardRebuildStatusResponse_Body).GetResults()
+			r2 := m2.(*control.GetShardRebuildStatusResponse_Body).GetResults()
+			if len(r1) != len(r2) {
+				return false
+			}
+			for i := range r1 {
+				if !bytes.Equal(r1[i].GetShard_ID(), r2[i].GetShard_ID()) ||
+					r1[i].GetRunning() != r2[i].GetRunning() ||
+					r1[i].GetStartedAt() != r2[i].GetStartedAt() ||
+					r1[i].GetFinishedAt() != r2[i].GetFinishedAt() ||
+					r1[i].GetObjectsMoved() != r2[i].GetObjectsMoved() ||
+					r1[i].GetFilesRemoved() != r2[i].GetFilesRemoved() ||
+					r1[i].GetErrorMessage() != r2[i].GetErrorMessage() {
+					return false
+				}
+			}
+			return true
+		},
+	)
+}
+
+func TestGetShardScrubStatusResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.GetShardScrubStatusResponse_Body{
+			Results: []*control.GetShardScrubStatusResponse_Body_Status{
+				{
+					Shard_ID:       []byte{1, 2, 3},
+					Running:        true,
+					StartedAt:      1672531200,
+					ObjectsChecked: 100,
+				},
+				{
+					Shard_ID:         []byte{4, 5},
+					StartedAt:        1672531200,
+					FinishedAt:       1672534800,
+					ObjectsChecked:   1000,
+					ObjectsCorrupted: 2,
+					CorruptedObjects: []string{"addr1", "addr2"},
+					ErrorMessage:     "some error",
+				},
+			},
+		},
+		new(control.GetShardScrubStatusResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			r1 := m1.(*control.GetShardScrubStatusResponse_Body).GetResults()
+			r2 := m2.(*control.GetShardScrubStatusResponse_Body).GetResults()
+			if len(r1) != len(r2) {
+				return false
+			}
+			for i := range r1 {
+				if !bytes.Equal(r1[i].GetShard_ID(), r2[i].GetShard_ID()) ||
+					r1[i].GetRunning() != r2[i].GetRunning() ||
+					r1[i].GetStartedAt() != r2[i].GetStartedAt() ||
+					r1[i].GetFinishedAt() != r2[i].GetFinishedAt() ||
+					r1[i].GetObjectsChecked() != r2[i].GetObjectsChecked() ||
+					r1[i].GetObjectsCorrupted() != r2[i].GetObjectsCorrupted() ||
+					strings.Join(r1[i].GetCorruptedObjects(), ",") != strings.Join(r2[i].GetCorruptedObjects(), ",") ||
+					r1[i].GetErrorMessage() != r2[i].GetErrorMessage() {
+					return false
+				}
+			}
+			return true
+		},
+	)
+}
+
+func TestAddShardRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.AddShardRequest_Body{
+			Config: []byte("metabase:\n  path: /storage/meta\n"),
+		},
+		new(control.AddShardRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return bytes.Equal(m1.(*control.AddShardRequest_Body).GetConfig(),
+				m2.(*control.AddShardRequest_Body).GetConfig())
+		},
+	)
+}
+
+func TestRemoveShardRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.RemoveShardRequest_Body{
+			Shard_ID:     [][]byte{{1, 2, 3}, {4, 5}},
+			Evacuate:     true,
+			IgnoreErrors: true,
+		},
+		new(control.RemoveShardRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.RemoveShardRequest_Body)
+			b2 := m2.(*control.RemoveShardRequest_Body)
+			if len(b1.GetShard_ID()) != len(b2.GetShard_ID()) {
+				return false
+			}
+			for i := range b1.GetShard_ID() {
+				if !bytes.Equal(b1.GetShard_ID()[i], b2.GetShard_ID()[i]) {
+					return false
+				}
+			}
+			return b1.GetEvacuate() == b2.GetEvacuate() &&
+				b1.GetIgnoreErrors() == b2.GetIgnoreErrors()
+		},
+	)
+}
+
+func TestRunShardGCRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.RunShardGCRequest_Body{
+			Shard_ID: [][]byte{{1, 2, 3}, {4, 5}},
+		},
+		new(control.RunShardGCRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.RunShardGCRequest_Body)
+			b2 := m2.(*control.RunShardGCRequest_Body)
+			if len(b1.GetShard_ID()) != len(b2.GetShard_ID()) {
+				return false
+			}
+			for i := range b1.GetShard_ID() {
+				if !bytes.Equal(b1.GetShard_ID()[i], b2.GetShard_ID()[i]) {
+					return false
+				}
+			}
+			return true
+		},
+	)
+}
+
+func TestSetShardGCParamsRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.SetShardGCParamsRequest_Body{
+			Shard_ID:  [][]byte{{1, 2, 3}},
+			Pause:     true,
+			Interval:  60000,
+			BatchSize: 500,
+		},
+		new(control.SetShardGCParamsRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.SetShardGCParamsRequest_Body)
+			b2 := m2.(*control.SetShardGCParamsRequest_Body)
+			if len(b1.GetShard_ID()) != len(b2.GetShard_ID()) {
+				return false
+			}
+			for i := range b1.GetShard_ID() {
+				if !bytes.Equal(b1.GetShard_ID()[i], b2.GetShard_ID()[i]) {
+					return false
+				}
+			}
+			return b1.GetPause() == b2.GetPause() &&
+				b1.GetResume() == b2.GetResume() &&
+				b1.GetInterval() == b2.GetInterval() &&
+				b1.GetBatchSize() == b2.GetBatchSize()
+		},
+	)
+}
+
+func TestDumpShardRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.DumpShardRequest_Body{
+			Shard_ID:     []byte{1, 2, 3},
+			Filepath:     "/tmp/shard.dump",
+			IgnoreErrors: true,
+			Compress:     true,
+			BasePath:     "/tmp/shard.base.dump",
+		},
+		new(control.DumpShardRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.DumpShardRequest_Body)
+			b2 := m2.(*control.DumpShardRequest_Body)
+			return bytes.Equal(b1.GetShard_ID(), b2.GetShard_ID()) &&
+				b1.GetFilepath() == b2.GetFilepath() &&
+				b1.GetIgnoreErrors() == b2.GetIgnoreErrors() &&
+				b1.GetCompress() == b2.GetCompress() &&
+				b1.GetBasePath() == b2.GetBasePath()
+		},
+	)
+}
+
+func TestDumpShardResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.DumpShardResponse_Body{
+			Count:      42,
+			Checkpoint: 100500,
+		},
+		new(control.DumpShardResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.DumpShardResponse_Body)
+			b2 := m2.(*control.DumpShardResponse_Body)
+			return b1.GetCount() == b2.GetCount() &&
+				b1.GetCheckpoint() == b2.GetCheckpoint()
+		},
+	)
+}
@@ -116,3 +116,8 @@ func (x *ShardInfo) SetMode(v ShardMode) {
 func (x *ShardInfo) SetErrorCount(count uint32) {
 	x.ErrorCount = count
 }
+
+// SetGC sets state of shard's garbage collector.
+func (x *ShardInfo) SetGC(v *GCStatus) {
+	x.Gc = v
+}
@@ -145,6 +145,13 @@ func generateShardInfo(id int) *control.ShardInfo {
 		{Type: blobovniczatree.Type, Path: filepath.Join(path, "blobtree")}}
 	si.SetWriteCachePath(filepath.Join(path, "writecache"))
 	si.SetPiloramaPath(filepath.Join(path, "pilorama"))
+	si.SetGC(&control.GCStatus{
+		Interval:    60000,
+		BatchSize:   100,
+		QueueLength: uint64(id),
+		Removed:     uint64(id * 10),
+		LastRun:     int64(id),
+	})
 
 	return si
 }
@@ -141,3 +141,36 @@ func lookupKeyInContainer(
 
 	return false, nil
 }
+
+// isNetmapKey checks whether the key belongs to a storage node
+// from the current or the previous network map.
+func isNetmapKey(src core.Source, key []byte) (bool, error) {
+	nm, err := core.GetLatestNetworkMap(src)
+	if err != nil {
+		return false, err
+	}
+
+	if lookupKeyInNetmap(nm, key) {
+		return true, nil
+	}
+
+	// then check previous netmap, this can happen in-between epoch change
+	nm, err = core.GetPreviousNetworkMap(src)
+	if err != nil {
+		return false, err
+	}
+
+	return lookupKeyInNetmap(nm, key), nil
+}
+
+func lookupKeyInNetmap(nm *netmap.NetMap, key []byte) bool {
+	nodes := nm.Nodes()
+
+	for i := range nodes {
+		if bytes.Equal(nodes[i].PublicKey(), key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -6,13 +6,18 @@ import (
 	"fmt"
 
 	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	refsV2 "github.com/TrueCloudLab/frostfs-api-go/v2/refs"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/container"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object"
+	copysvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/copy"
+	deletesvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/delete"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	"github.com/TrueCloudLab/frostfs-sdk-go/bearer"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	"github.com/TrueCloudLab/frostfs-sdk-go/container/acl"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	sessionSDK "github.com/TrueCloudLab/frostfs-sdk-go/session"
 	"github.com/TrueCloudLab/frostfs-sdk-go/user"
@@ -332,6 +337,27 @@ func (b Service) Delete(
 		return nil, eACLErr(reqInfo, err)
 	}
 
+	members, err := deletesvc.ParseMembers(originalXHeaders(request.GetMetaHeader()))
+	if err != nil {
+		return nil, err
+	}
+
+	// objects removed by the same tombstone are checked like the request one
+	for i := range members {
+		if sTok != nil {
+			err = assertSessionRelation(*sTok, cnr, &members[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		reqInfo.obj = &members[i]
+
+		if err := b.checker.CheckEACL(request, reqInfo); err != nil {
+			return nil, eACLErr(reqInfo, err)
+		}
+	}
+
 	return b.next.Delete(ctx, request)
 }
 
@@ -528,6 +554,18 @@ func (p putStreamBasicChecker) Send(request *objectV2.PutRequest) error {
 		} else if err := p.source.checker.CheckEACL(request, reqInfo); err != nil {
 			return eACLErr(reqInfo, err)
 		}
+
+		if isErasureCodedChunk(part.GetHeader()) {
+			if err := p.source.checkChunkSender(reqInfo, idOwner); err != nil {
+				return err
+			}
+		}
+
+		if part.GetSignature() == nil {
+			if err := p.source.checkCopySource(request, sTok, bTok); err != nil {
+				return err
+			}
+		}
 	}
 
 	return p.next.Send(request)
@@ -563,12 +601,154 @@ func (g *searchStreamBasicChecker) Send(resp *objectV2.SearchResponse) error {
 	return g.SearchStream.Send(resp)
 }
 
-func (b Service) findRequestInfo(req MetaWithToken, idCnr cid.ID, op acl.Op) (info RequestInfo, err error) {
-	cnr, err := b.containers.Get(idCnr) // fetch actual container
+// checkChunkSender checks that the chunk of the erasure coded object owned by
+// another user is sent by a storage node. Chunks are signed by the node encoding
+// the object, so otherwise anyone could save an object on behalf of any user.
+func (b Service) checkChunkSender(info RequestInfo, owner user.ID) error {
+	key, err := unmarshalPublicKey(info.SenderKey())
 	if err != nil {
-		return info, err
+		return fmt.Errorf("invalid sender key: %w", err)
+	}
+
+	if isOwnerFromKey(owner, key) {
+		return nil
+	}
+
+	ok, err := isNetmapKey(b.nm, info.SenderKey())
+	if err != nil {
+		return fmt.Errorf("could not check chunk sender: %w", err)
+	} else if !ok {
+		var errAccessDenied apistatus.ObjectAccessDenied
+		errAccessDenied.WriteReason("chunk of another user can be saved by storage nodes only")
+
+		return errAccessDenied
+	}
+
+	return nil
+}
+
+// checkCopySource checks that the sender of the PUT request copying the object
+// is allowed to GET the source object. The session token is verified for the
+// destination container, so it is used only if it also covers reading the
+// source object. The copies are owned by the session issuer, so the copying
+// without the session is denied.
+func (b Service) checkCopySource(request *objectV2.PutRequest, sTok *sessionSDK.Object, bTok *bearer.Token) error {
+	reqInfo, getReq, err := b.copySourceInfo(request, sTok, bTok)
+	if err != nil || getReq == nil {
+		return err
+	}
+
+	if !b.checker.CheckBasicACL(reqInfo) {
+		return basicACLErr(reqInfo)
+	} else if err := b.checker.CheckEACL(getReq, reqInfo); err != nil {
+		return eACLErr(reqInfo, err)
+	}
+
+	return nil
+}
+
+// CheckCopySource checks that the sender of the PUT request copying the object
+// is allowed to GET the source object with the given header. The header is read
+// by the node, so the eACL rules depending on the object headers are checked
+// against it before the copy is stored.
+func (b Service) CheckCopySource(request *objectV2.PutRequest, src *objectSDK.Object) error {
+	var sTok *sessionSDK.Object
+
+	if tokV2 := request.GetMetaHeader().GetSessionToken(); tokV2 != nil {
+		sTok = new(sessionSDK.Object)
+
+		err := sTok.ReadFromV2(*tokV2)
+		if err != nil {
+			return fmt.Errorf("invalid session token: %w", err)
+		}
+	}
+
+	bTok, err := originalBearerToken(request.GetMetaHeader())
+	if err != nil {
+		return err
+	}
+
+	reqInfo, getReq, err := b.copySourceInfo(request, sTok, bTok)
+	if err != nil || getReq == nil {
+		return err
+	}
+
+	srcV2 := src.ToV2()
+
+	part := new(objectV2.GetObjectPartInit)
+	part.SetObjectID(srcV2.GetObjectID())
+	part.SetSignature(srcV2.GetSignature())
+	part.SetHeader(srcV2.GetHeader())
+
+	body := new(objectV2.GetResponseBody)
+	body.SetObjectPart(part)
+
+	resp := new(objectV2.GetResponse)
+	resp.SetBody(body)
+
+	if err := b.checker.CheckEACL(resp, reqInfo); err != nil {
+		return eACLErr(reqInfo, err)
+	}
+
+	return nil
+}
+
+// copySourceInfo returns the information about reading the source object
+// of the PUT request and the equivalent GET request. The request is nil
+// if the object is not copied.
+func (b Service) copySourceInfo(request *objectV2.PutRequest, sTok *sessionSDK.Object, bTok *bearer.Token) (RequestInfo, *objectV2.GetRequest, error) {
+	src, _, err := copysvc.ParseXHeaders(originalXHeaders(request.GetMetaHeader()))
+	if err != nil || src == nil {
+		return RequestInfo{}, nil, err
+	}
+
+	if sTok == nil {
+		var errAccessDenied apistatus.ObjectAccessDenied
+		errAccessDenied.WriteReason("object can be copied within the session only")
+
+		return RequestInfo{}, nil, errAccessDenied
+	}
+
+	cnr := src.Container()
+	obj := src.Object()
+
+	// the tokens are attached for the destination
+	// container and can't always be applied to the source
+	sTok = copySourceToken(sTok, *src)
+
+	if bTok != nil && !bTok.AssertContainer(cnr) {
+		bTok = nil
+	}
+
+	var addrV2 refsV2.Address
+	src.WriteToV2(&addrV2)
+
+	body := new(objectV2.GetRequestBody)
+	body.SetAddress(&addrV2)
+
+	getReq := new(objectV2.GetRequest)
+	getReq.SetBody(body)
+	getReq.SetMetaHeader(request.GetMetaHeader())
+	getReq.SetVerificationHeader(request.GetVerificationHeader())
+
+	req := MetaWithToken{
+		vheader: request.GetVerificationHeader(),
+		token:   sTok,
+		bearer:  bTok,
+		src:     getReq,
+	}
+
+	reqInfo, err := b.requestInfo(req, cnr, acl.OpObjectGet)
+	if err != nil {
+		return RequestInfo{}, nil, err
 	}
 
+	reqInfo.obj = &obj
+
+	return reqInfo, getReq, nil
+}
+
+func (b Service) findRequestInfo(req MetaWithToken, idCnr cid.ID, op acl.Op) (info RequestInfo, err error) {
 	if req.token != nil {
 		currentEpoch, err := b.nm.Epoch()
 		if err != nil {
@@ -587,6 +767,16 @@ func (b Service) findRequestInfo(req MetaWithToken, idCnr cid.ID, op acl.Op) (in
 		}
 	}
 
+	return b.requestInfo(req, idCnr, op)
+}
+
+// requestInfo classifies the request w/o session token verification.
+func (b Service) requestInfo(req MetaWithToken, idCnr cid.ID, op acl.Op) (info RequestInfo, err error) {
+	cnr, err := b.containers.Get(idCnr) // fetch actual container
+	if err != nil {
+		return info, err
+	}
+
 	// find request role and key
 	res, err := b.c.classify(req, idCnr, cnr.Value)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	refsV2 "github.com/TrueCloudLab/frostfs-api-go/v2/refs"
 	sessionV2 "github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
 	"github.com/TrueCloudLab/frostfs-sdk-go/bearer"
 	"github.com/TrueCloudLab/frostfs-sdk-go/container/acl"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
@@ -71,6 +72,23 @@ func originalBearerToken(header *sessionV2.RequestMetaHeader) (*bearer.Token, er
 	return &tok, tok.ReadFromV2(*tokV2)
 }
 
+// originalXHeaders goes down to original request meta header and fetches
+// X-headers from there in key-value pairs.
+func originalXHeaders(header *sessionV2.RequestMetaHeader) []string {
+	for header.GetOrigin() != nil {
+		header = header.GetOrigin()
+	}
+
+	xs := header.GetXHeaders()
+	res := make([]string, 0, 2*len(xs))
+
+	for i := range xs {
+		res = append(res, xs[i].GetKey(), xs[i].GetValue())
+	}
+
+	return res
+}
+
 // originalSessionToken goes down to original request meta header and fetches
 // session token from there.
 func originalSessionToken(header *sessionV2.RequestMetaHeader) (*sessionSDK.Object, error) {
@@ -149,6 +167,18 @@ func originalBodySignature(v *sessionV2.RequestVerificationHeader) *refsV2.Signa
 	return v.GetBodySignature()
 }
 
+// isErasureCodedChunk checks whether the header describes
+// a chunk of the erasure coded object.
+func isErasureCodedChunk(hdr *objectV2.Header) bool {
+	for _, a := range hdr.GetAttributes() {
+		if a.GetKey() == erasurecode.AttributeParent {
+			return true
+		}
+	}
+
+	return false
+}
+
 func unmarshalPublicKey(bs []byte) (*keys.PublicKey, error) {
 	return keys.NewPublicKeyFromBytes(bs, elliptic.P256())
 }
@@ -192,6 +222,24 @@ func assertVerb(tok sessionSDK.Object, op acl.Op) bool {
 	return false
 }
 
+// copySourceToken returns the session token of the PUT request if it also
+// authorizes reading the source object of the copy: the token must be issued
+// for the GET verb and be related to the source object. Otherwise nil is
+// returned and the access to the source object is checked for the request
+// sender.
+func copySourceToken(tok *sessionSDK.Object, src oid.Address) *sessionSDK.Object {
+	if tok == nil || !assertVerb(*tok, acl.OpObjectGet) {
+		return nil
+	}
+
+	obj := src.Object()
+	if assertSessionRelation(*tok, src.Container(), &obj) != nil {
+		return nil
+	}
+
+	return tok
+}
+
 // assertSessionRelation checks if given token describing the FrostFS session
 // relates to the given container and optional object. Missing object
 // means that the context isn't bound to any FrostFS object in the container.
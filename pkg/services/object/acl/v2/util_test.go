@@ -7,10 +7,14 @@ import (
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-api-go/v2/acl"
+	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	copysvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/copy"
 	bearertest "github.com/TrueCloudLab/frostfs-sdk-go/bearer/test"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	aclsdk "github.com/TrueCloudLab/frostfs-sdk-go/container/acl"
 	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
 	sessionSDK "github.com/TrueCloudLab/frostfs-sdk-go/session"
 	sessiontest "github.com/TrueCloudLab/frostfs-sdk-go/session/test"
@@ -134,3 +138,44 @@ func TestAssertSessionRelation(t *testing.T) {
 	require.NoError(t, assertSessionRelation(tok, cnr, &obj))
 	require.Error(t, assertSessionRelation(tok, cnr, &objOther))
 }
+
+func TestCopySourceToken(t *testing.T) {
+	var tok sessionSDK.Object
+	var src oid.Address
+	src.SetContainer(cidtest.ID())
+	src.SetObject(oidtest.ID())
+
+	require.Nil(t, copySourceToken(nil, src))
+
+	// token of the destination container
+	tok.BindContainer(cidtest.ID())
+	tok.ForVerb(sessionSDK.VerbObjectGet)
+	require.Nil(t, copySourceToken(&tok, src))
+
+	// token of the source container issued for another verb
+	tok.BindContainer(src.Container())
+	tok.ForVerb(sessionSDK.VerbObjectPut)
+	require.Nil(t, copySourceToken(&tok, src))
+
+	tok.ForVerb(sessionSDK.VerbObjectGet)
+	require.Equal(t, &tok, copySourceToken(&tok, src))
+}
+
+func TestCopySourceWithoutSession(t *testing.T) {
+	var b Service
+
+	// not a copy
+	require.NoError(t, b.checkCopySource(new(objectV2.PutRequest), nil, nil))
+
+	var xhdr session.XHeader
+	xhdr.SetKey(copysvc.XHeaderSource)
+	xhdr.SetValue(oidtest.Address().EncodeToString())
+
+	meta := new(session.RequestMetaHeader)
+	meta.SetXHeaders([]session.XHeader{xhdr})
+
+	req := new(objectV2.PutRequest)
+	req.SetMetaHeader(meta)
+
+	require.ErrorAs(t, b.checkCopySource(req, nil, nil), new(apistatus.ObjectAccessDenied))
+}
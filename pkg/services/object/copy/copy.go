@@ -0,0 +1,161 @@
+package copysvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	getsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get"
+	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	"go.uber.org/zap"
+)
+
+var (
+	errNotRegular     = errors.New("only regular objects can be copied")
+	errMissingSession = errors.New("object can be copied within the session only")
+)
+
+// Copy reads the object and stores its copy with the header signed
+// by the session key. The owner of the copy is the session token issuer.
+func (s *Service) Copy(ctx context.Context, prm Prm) (*Res, error) {
+	owner, err := s.owner(prm.common)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &copyWriter{
+		ctx:   ctx,
+		svc:   s,
+		prm:   &prm,
+		owner: owner,
+	}
+
+	// the session token relates to the destination container,
+	// so the source object is read on behalf of the local node
+	getCommon := *prm.common
+	getCommon.ForgetTokens()
+
+	var getPrm getsvc.Prm
+	getPrm.SetCommonParameters(&getCommon)
+	getPrm.WithAddress(prm.src)
+	getPrm.SetObjectWriter(w)
+
+	err = s.getSvc.Get(ctx, getPrm)
+	if err != nil {
+		return nil, fmt.Errorf("could not read source object: %w", err)
+	}
+
+	if w.stream == nil {
+		return nil, errors.New("source object header has not been received")
+	}
+
+	r, err := w.stream.Close()
+	if err != nil {
+		return nil, fmt.Errorf("could not store object copy: %w", err)
+	}
+
+	res := &Res{id: r.ObjectID()}
+
+	s.log.Debug("object copied",
+		zap.Stringer("source", prm.src),
+		zap.Stringer("container", prm.cnr),
+		zap.Stringer("object", res.id),
+	)
+
+	return res, nil
+}
+
+func (s *Service) owner(common *util.CommonPrm) (user.ID, error) {
+	tok := common.SessionToken()
+	if tok == nil {
+		return user.ID{}, errMissingSession
+	}
+
+	// fail immediately if the session key is missing
+	_, err := s.keyStorage.GetKey(&util.SessionInfo{
+		ID:    tok.ID(),
+		Owner: tok.Issuer(),
+	})
+	if err != nil {
+		return user.ID{}, err
+	}
+
+	return tok.Issuer(), nil
+}
+
+// copyWriter streams the payload of the source object to the Put service.
+type copyWriter struct {
+	ctx context.Context
+
+	svc *Service
+
+	prm *Prm
+
+	owner user.ID
+
+	stream *putsvc.Streamer
+}
+
+func (w *copyWriter) WriteHeader(src *object.Object) error {
+	if src.Type() != object.TypeRegular {
+		return errNotRegular
+	}
+
+	if w.prm.checkSrc != nil {
+		if err := w.prm.checkSrc(src); err != nil {
+			return err
+		}
+	}
+
+	hdr := object.New()
+	hdr.SetContainerID(w.prm.cnr)
+	hdr.SetOwnerID(&w.owner)
+	hdr.SetAttributes(copyAttributes(src.Attributes(), w.prm.attrs, w.prm.rewriteAttrs)...)
+
+	stream, err := w.svc.putSvc.Put(w.ctx)
+	if err != nil {
+		return err
+	}
+
+	err = stream.Init(new(putsvc.PutInitPrm).
+		WithCommonPrm(w.prm.common).
+		WithObject(hdr))
+	if err != nil {
+		return err
+	}
+
+	w.stream = stream
+
+	return nil
+}
+
+func (w *copyWriter) WriteChunk(p []byte) error {
+	return w.stream.SendChunk(new(putsvc.PutChunkPrm).WithChunk(p))
+}
+
+// copyAttributes returns the attributes of the copy: the source ones
+// replaced by the requested ones with the same keys, or only the
+// requested ones if rewrite is set.
+func copyAttributes(src, req []object.Attribute, rewrite bool) []object.Attribute {
+	if rewrite {
+		return req
+	}
+
+	res := make([]object.Attribute, 0, len(src)+len(req))
+
+loop:
+	for i := range src {
+		for j := range req {
+			if req[j].Key() == src[i].Key() {
+				continue loop
+			}
+		}
+
+		res = append(res, src[i])
+	}
+
+	return append(res, req...)
+}
@@ -0,0 +1,79 @@
+package copysvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseXHeaders(t *testing.T) {
+	addr := oidtest.Address()
+
+	src, rewrite, err := ParseXHeaders([]string{"key", "value"})
+	require.NoError(t, err)
+	require.Nil(t, src)
+	require.False(t, rewrite)
+
+	src, rewrite, err = ParseXHeaders([]string{XHeaderSource, addr.EncodeToString()})
+	require.NoError(t, err)
+	require.Equal(t, addr, *src)
+	require.False(t, rewrite)
+
+	src, rewrite, err = ParseXHeaders([]string{XHeaderSource, addr.EncodeToString(), XHeaderRewriteAttributes, "true"})
+	require.NoError(t, err)
+	require.Equal(t, addr, *src)
+	require.True(t, rewrite)
+
+	_, _, err = ParseXHeaders([]string{XHeaderSource, "not an address"})
+	require.Error(t, err)
+
+	_, _, err = ParseXHeaders([]string{XHeaderRewriteAttributes, "true"})
+	require.Error(t, err)
+}
+
+func TestCopyAttributes(t *testing.T) {
+	attr := func(k, v string) object.Attribute {
+		var a object.Attribute
+		a.SetKey(k)
+		a.SetValue(v)
+		return a
+	}
+
+	src := []object.Attribute{attr("a", "1"), attr("b", "2")}
+	req := []object.Attribute{attr("b", "3"), attr("c", "4")}
+
+	require.Equal(t, []object.Attribute{attr("a", "1"), attr("b", "3"), attr("c", "4")},
+		copyAttributes(src, req, false))
+	require.Equal(t, req, copyAttributes(src, req, true))
+	require.Equal(t, src, copyAttributes(src, nil, false))
+}
+
+func TestCopyWithoutSession(t *testing.T) {
+	var prm Prm
+	prm.SetCommonParameters(new(util.CommonPrm))
+
+	_, err := New().Copy(context.Background(), prm)
+	require.ErrorIs(t, err, errMissingSession)
+}
+
+func TestCopySourceChecker(t *testing.T) {
+	errDenied := errors.New("denied")
+
+	var prm Prm
+	prm.WithSourceChecker(func(*object.Object) error {
+		return errDenied
+	})
+
+	src := object.New()
+	src.SetType(object.TypeRegular)
+
+	// the copy is not stored if the source is denied
+	w := &copyWriter{prm: &prm}
+	require.ErrorIs(t, w.WriteHeader(src), errDenied)
+	require.Nil(t, w.stream)
+}
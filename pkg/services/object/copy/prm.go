@@ -0,0 +1,66 @@
+package copysvc
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Prm groups parameters of Copy service call.
+type Prm struct {
+	common *util.CommonPrm
+
+	src oid.Address
+
+	cnr cid.ID
+
+	attrs []object.Attribute
+
+	rewriteAttrs bool
+
+	checkSrc SourceChecker
+}
+
+// SourceChecker checks the header of the source object
+// before the copy is stored.
+type SourceChecker func(src *object.Object) error
+
+// Res groups the resulting values of Copy service call.
+type Res struct {
+	id oid.ID
+}
+
+// SetCommonParameters sets common parameters of the operation.
+func (p *Prm) SetCommonParameters(common *util.CommonPrm) {
+	p.common = common
+}
+
+// WithSource sets address of the object to be copied.
+func (p *Prm) WithSource(addr oid.Address) {
+	p.src = addr
+}
+
+// WithContainerID sets identifier of the container to store the copy in.
+func (p *Prm) WithContainerID(cnr cid.ID) {
+	p.cnr = cnr
+}
+
+// WithAttributes sets attributes of the copy. If rewrite is false, the
+// attributes of the source object are kept and only the ones with the
+// same keys are replaced.
+func (p *Prm) WithAttributes(attrs []object.Attribute, rewrite bool) {
+	p.attrs = attrs
+	p.rewriteAttrs = rewrite
+}
+
+// WithSourceChecker sets the function checking the access to the
+// source object by its header read by the node.
+func (p *Prm) WithSourceChecker(f SourceChecker) {
+	p.checkSrc = f
+}
+
+// ObjectID returns identifier of the stored copy.
+func (r Res) ObjectID() oid.ID {
+	return r.id
+}
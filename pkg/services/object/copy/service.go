@@ -0,0 +1,77 @@
+package copysvc
+
+import (
+	getsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get"
+	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	"go.uber.org/zap"
+)
+
+// Service utility serving requests to copy the objects.
+type Service struct {
+	*cfg
+}
+
+// Option is a Service's constructor option.
+type Option func(*cfg)
+
+type cfg struct {
+	log *logger.Logger
+
+	getSvc *getsvc.Service
+
+	putSvc *putsvc.Service
+
+	keyStorage *util.KeyStorage
+}
+
+func defaultCfg() *cfg {
+	return &cfg{
+		log: &logger.Logger{Logger: zap.L()},
+	}
+}
+
+// New creates, initializes and returns utility serving
+// requests to copy the objects.
+func New(opts ...Option) *Service {
+	c := defaultCfg()
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	return &Service{
+		cfg: c,
+	}
+}
+
+// WithLogger returns option to specify Copy service's logger.
+func WithLogger(l *logger.Logger) Option {
+	return func(c *cfg) {
+		c.log = &logger.Logger{Logger: l.With(zap.String("component", "Object.Copy service"))}
+	}
+}
+
+// WithGetService returns option to set Get service
+// to read the source objects.
+func WithGetService(g *getsvc.Service) Option {
+	return func(c *cfg) {
+		c.getSvc = g
+	}
+}
+
+// WithPutService returns option to set Put service
+// to store the copies.
+func WithPutService(p *putsvc.Service) Option {
+	return func(c *cfg) {
+		c.putSvc = p
+	}
+}
+
+// WithKeyStorage returns option to set local private key storage.
+func WithKeyStorage(ks *util.KeyStorage) Option {
+	return func(c *cfg) {
+		c.keyStorage = ks
+	}
+}
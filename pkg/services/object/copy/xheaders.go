@@ -0,0 +1,57 @@
+package copysvc
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+const (
+	// XHeaderSource is the PUT request X-header with the address of the object
+	// to be copied in "<container>/<object>" format. The request must carry the
+	// object header w/o signature and no payload: the container and the attributes
+	// of the copy are taken from the header, the payload is read from the source
+	// object by the node. The request must be sent within the session, the copy
+	// is owned by the session issuer.
+	XHeaderSource = session.ReservedXHeaderPrefix + "COPY_FROM"
+	// XHeaderRewriteAttributes is the PUT request X-header which, if set to "true",
+	// makes the copy carry only the attributes of the request header instead of
+	// the source object attributes replaced by the requested ones.
+	XHeaderRewriteAttributes = session.ReservedXHeaderPrefix + "COPY_REWRITE_ATTRIBUTES"
+)
+
+// ParseXHeaders returns the copy parameters from the request X-headers
+// in key-value pairs. Nil address is returned if the request doesn't copy
+// an object.
+func ParseXHeaders(xhdrs []string) (*oid.Address, bool, error) {
+	var (
+		src     *oid.Address
+		rewrite bool
+		err     error
+	)
+
+	for i := 0; i+1 < len(xhdrs); i += 2 {
+		switch xhdrs[i] {
+		case XHeaderSource:
+			src = new(oid.Address)
+
+			err = src.DecodeString(xhdrs[i+1])
+			if err != nil {
+				return nil, false, fmt.Errorf("invalid copy source address: %w", err)
+			}
+		case XHeaderRewriteAttributes:
+			rewrite, err = strconv.ParseBool(xhdrs[i+1])
+			if err != nil {
+				return nil, false, fmt.Errorf("invalid %s value: %w", XHeaderRewriteAttributes, err)
+			}
+		}
+	}
+
+	if src == nil && rewrite {
+		return nil, false, fmt.Errorf("%s without %s", XHeaderRewriteAttributes, XHeaderSource)
+	}
+
+	return src, rewrite, nil
+}
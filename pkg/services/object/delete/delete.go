@@ -25,6 +25,7 @@ func (s *Service) Delete(ctx context.Context, prm Prm) error {
 		svc: s,
 		ctx: ctx,
 		prm: prm,
+		obj: prm.addr.Object(),
 	}
 
 	exec.setLogger(s.log)
@@ -0,0 +1,303 @@
+package deletesvc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/container"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger/test"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	usertest "github.com/TrueCloudLab/frostfs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testHeader struct {
+	splitInfos map[oid.ID]*object.SplitInfo
+	linked     map[oid.ID][]oid.ID
+	errs       map[oid.ID]error
+}
+
+func (h *testHeader) splitInfo(exec *execCtx) (*object.SplitInfo, error) {
+	id := exec.address().Object()
+	if err := h.errs[id]; err != nil {
+		return nil, err
+	}
+
+	return h.splitInfos[id], nil
+}
+
+func (h *testHeader) children(exec *execCtx) ([]oid.ID, error) {
+	link, _ := exec.splitInfo.Link()
+	return h.linked[link], nil
+}
+
+func (h *testHeader) previous(*execCtx, oid.ID) (*oid.ID, error) {
+	return nil, nil
+}
+
+type testSearcher struct {
+	ecChunks map[oid.ID][]oid.ID
+}
+
+func (testSearcher) splitMembers(*execCtx) ([]oid.ID, error) {
+	return nil, nil
+}
+
+func (s testSearcher) chunks(_ *execCtx, id oid.ID) ([]oid.ID, error) {
+	return s.ecChunks[id], nil
+}
+
+type testLockSource map[oid.ID]struct{}
+
+func (s testLockSource) IsLocked(addr oid.Address) (bool, error) {
+	_, ok := s[addr.Object()]
+	return ok, nil
+}
+
+type testContainerSource struct {
+	cnr containerSDK.Container
+}
+
+func (s testContainerSource) Get(cid.ID) (*container.Container, error) {
+	return &container.Container{Value: s.cnr}, nil
+}
+
+type testPlacer struct {
+	tombstones []*object.Tombstone
+}
+
+func (p *testPlacer) put(exec *execCtx) (*oid.ID, error) {
+	ts := object.NewTombstone()
+	if err := ts.Unmarshal(exec.tombstoneObj.Payload()); err != nil {
+		return nil, err
+	}
+
+	p.tombstones = append(p.tombstones, ts)
+
+	id := oidtest.ID()
+
+	return &id, nil
+}
+
+type testNetInfo struct{}
+
+func (testNetInfo) CurrentEpoch() uint64 { return 10 }
+
+func (testNetInfo) TombstoneLifetime() (uint64, error) { return 5, nil }
+
+func (testNetInfo) LocalNodeID() user.ID { return *usertest.ID() }
+
+type testAddressWriter struct {
+	addr *oid.Address
+}
+
+func (w *testAddressWriter) SetAddress(addr oid.Address) {
+	w.addr = &addr
+}
+
+type testSkippedWriter struct {
+	objs []SkippedObject
+}
+
+func (w *testSkippedWriter) SetSkipped(objs []SkippedObject) {
+	w.objs = objs
+}
+
+func TestBulkDelete(t *testing.T) {
+	var (
+		phy     = oidtest.ID()
+		parent  = oidtest.ID()
+		link    = oidtest.ID()
+		missing = oidtest.ID()
+		removed = oidtest.ID()
+		locked  = oidtest.ID()
+
+		children = []oid.ID{oidtest.ID(), oidtest.ID()}
+	)
+
+	si := object.NewSplitInfo()
+	si.SetLink(link)
+
+	h := &testHeader{
+		splitInfos: map[oid.ID]*object.SplitInfo{parent: si},
+		linked:     map[oid.ID][]oid.ID{link: children},
+		errs: map[oid.ID]error{
+			missing: apistatus.ObjectNotFound{},
+			removed: apistatus.ObjectAlreadyRemoved{},
+		},
+	}
+
+	placer := new(testPlacer)
+
+	svc := New(
+		WithLogger(test.NewLogger(false)),
+		WithNetworkInfo(testNetInfo{}),
+		WithLockSource(testLockSource{locked: {}}),
+	)
+	svc.header = h
+	svc.searcher = testSearcher{}
+	svc.placer = placer
+
+	newPrm := func(obj oid.ID, members ...oid.ID) (Prm, *testAddressWriter, *testSkippedWriter) {
+		var addr oid.Address
+		addr.SetContainer(cidtest.ID())
+		addr.SetObject(obj)
+
+		w := new(testAddressWriter)
+		sw := new(testSkippedWriter)
+
+		var prm Prm
+		prm.SetCommonParameters(new(util.CommonPrm))
+		prm.WithAddress(addr)
+		prm.WithMembers(members)
+		prm.WithTombstoneAddressTarget(w)
+		prm.WithSkippedObjectsTarget(sw)
+
+		return prm, w, sw
+	}
+
+	t.Run("single tombstone", func(t *testing.T) {
+		placer.tombstones = nil
+
+		prm, w, sw := newPrm(phy, parent, missing, removed, locked, phy)
+		require.NoError(t, svc.Delete(context.Background(), prm))
+		require.NotNil(t, w.addr)
+		require.Len(t, placer.tombstones, 1)
+		require.ElementsMatch(t, []SkippedObject{
+			{ID: missing, Reason: SkipReasonNotFound},
+			{ID: removed, Reason: SkipReasonAlreadyRemoved},
+			{ID: locked, Reason: SkipReasonLocked},
+		}, sw.objs)
+
+		ts := placer.tombstones[0]
+		require.ElementsMatch(t, append([]oid.ID{phy, parent, link}, children...), ts.Members())
+		require.EqualValues(t, 15, ts.ExpirationEpoch())
+	})
+
+	t.Run("nothing to remove", func(t *testing.T) {
+		placer.tombstones = nil
+
+		prm, w, _ := newPrm(missing, missing)
+		require.Error(t, svc.Delete(context.Background(), prm))
+		require.Nil(t, w.addr)
+		require.Empty(t, placer.tombstones)
+	})
+
+	t.Run("all locked", func(t *testing.T) {
+		placer.tombstones = nil
+
+		prm, w, _ := newPrm(locked, locked)
+		require.ErrorAs(t, svc.Delete(context.Background(), prm), new(apistatus.ObjectLocked))
+		require.Nil(t, w.addr)
+		require.Empty(t, placer.tombstones)
+	})
+
+	t.Run("single object", func(t *testing.T) {
+		placer.tombstones = nil
+
+		prm, _, _ := newPrm(missing)
+		require.Error(t, svc.Delete(context.Background(), prm))
+		require.Empty(t, placer.tombstones)
+	})
+}
+
+func TestDeleteErasureCoded(t *testing.T) {
+	var (
+		phy    = oidtest.ID()
+		parent = oidtest.ID()
+		link   = oidtest.ID()
+		child  = oidtest.ID()
+
+		phyChunks   = []oid.ID{oidtest.ID(), oidtest.ID()}
+		childChunks = []oid.ID{oidtest.ID(), oidtest.ID()}
+	)
+
+	si := object.NewSplitInfo()
+	si.SetLink(link)
+
+	h := &testHeader{
+		splitInfos: map[oid.ID]*object.SplitInfo{parent: si},
+		linked:     map[oid.ID][]oid.ID{link: {child}},
+	}
+
+	var cnr containerSDK.Container
+	cnr.SetAttribute(erasurecode.AttributeContainerPolicy, "2+1")
+
+	placer := new(testPlacer)
+
+	svc := New(
+		WithLogger(test.NewLogger(false)),
+		WithNetworkInfo(testNetInfo{}),
+		WithErasureCoding(testContainerSource{cnr: cnr}),
+	)
+	svc.header = h
+	svc.searcher = testSearcher{ecChunks: map[oid.ID][]oid.ID{
+		phy:   phyChunks,
+		child: childChunks,
+	}}
+	svc.placer = placer
+
+	var addr oid.Address
+	addr.SetContainer(cidtest.ID())
+	addr.SetObject(phy)
+
+	var prm Prm
+	prm.SetCommonParameters(new(util.CommonPrm))
+	prm.WithAddress(addr)
+	prm.WithMembers([]oid.ID{phy, parent})
+	prm.WithTombstoneAddressTarget(new(testAddressWriter))
+
+	require.NoError(t, svc.Delete(context.Background(), prm))
+	require.Len(t, placer.tombstones, 1)
+
+	exp := []oid.ID{phy, parent, link, child}
+	exp = append(exp, phyChunks...)
+	exp = append(exp, childChunks...)
+
+	require.ElementsMatch(t, exp, placer.tombstones[0].Members())
+}
+
+func TestParseMembers(t *testing.T) {
+	ids := []oid.ID{oidtest.ID(), oidtest.ID()}
+
+	res, err := ParseMembers([]string{"key", "value", XHeaderMembers, FormatMembers(ids)})
+	require.NoError(t, err)
+	require.Equal(t, ids, res)
+
+	res, err = ParseMembers([]string{"key", "value"})
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	_, err = ParseMembers([]string{XHeaderMembers, "not an ID"})
+	require.Error(t, err)
+}
+
+func TestParseSkipped(t *testing.T) {
+	objs := []SkippedObject{
+		{ID: oidtest.ID(), Reason: SkipReasonLocked},
+		{ID: oidtest.ID(), Reason: SkipReasonFailure},
+	}
+
+	res, err := ParseSkipped(FormatSkipped(objs))
+	require.NoError(t, err)
+	require.Equal(t, objs, res)
+
+	res, err = ParseSkipped("")
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	_, err = ParseSkipped(objs[0].ID.EncodeToString())
+	require.Error(t, err)
+
+	_, err = ParseSkipped("not an ID:" + SkipReasonLocked)
+	require.Error(t, err)
+}
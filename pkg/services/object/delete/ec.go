@@ -0,0 +1,73 @@
+package deletesvc
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/container"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// WithErasureCoding returns option to remove the erasure coded chunks
+// together with the original objects.
+func WithErasureCoding(cnrSrc container.Source) Option {
+	return func(c *cfg) {
+		c.cnrSrc = cnrSrc
+	}
+}
+
+// erasureCoded checks if the container of the removed objects uses erasure coding.
+func (exec *execCtx) erasureCoded() (bool, bool) {
+	if exec.svc.cnrSrc == nil {
+		return false, true
+	}
+
+	if exec.ecChecked {
+		return exec.ec, true
+	}
+
+	cnr, err := exec.svc.cnrSrc.Get(exec.containerID())
+	if err != nil {
+		exec.status = statusUndefined
+		exec.err = err
+
+		exec.log.Debug("could not get container to check erasure coding",
+			zap.String("error", err.Error()),
+		)
+
+		return false, false
+	}
+
+	_, exec.ec, _ = erasurecode.ContainerPolicy(cnr.Value)
+	exec.ecChecked = true
+
+	return exec.ec, true
+}
+
+// collectChunks adds the erasure coded chunks of the objects to the tombstone.
+func (exec *execCtx) collectChunks(objs []oid.ID) bool {
+	ec, ok := exec.erasureCoded()
+	if !ok || !ec {
+		return ok
+	}
+
+	exec.log.Debug("collecting erasure coded chunks...")
+
+	for i := range objs {
+		chunks, err := exec.svc.searcher.chunks(exec, objs[i])
+		if err != nil {
+			exec.status = statusUndefined
+			exec.err = err
+
+			exec.log.Debug("could not search for erasure coded chunks",
+				zap.Stringer("object", objs[i]),
+				zap.String("error", err.Error()),
+			)
+
+			return false
+		}
+
+		exec.addMembers(chunks)
+	}
+
+	return true
+}
@@ -25,6 +25,9 @@ type execCtx struct {
 
 	prm Prm
 
+	// obj is the currently processed object.
+	obj oid.ID
+
 	statusError
 
 	log *logger.Logger
@@ -33,7 +36,15 @@ type execCtx struct {
 
 	splitInfo *object.SplitInfo
 
+	// ec is set if the container uses erasure coding,
+	// valid only if ecChecked is set.
+	ec, ecChecked bool
+
 	tombstoneObj *object.Object
+
+	// skipped contains the objects that are requested
+	// in the bulk removal but are not added to the tombstone.
+	skipped []SkippedObject
 }
 
 const (
@@ -60,7 +71,33 @@ func (exec execCtx) isLocal() bool {
 }
 
 func (exec *execCtx) address() oid.Address {
-	return exec.prm.addr
+	return exec.newAddress(exec.obj)
+}
+
+func (exec *execCtx) isBulk() bool {
+	return len(exec.prm.members) > 0
+}
+
+// targets returns the requested objects w/o duplicates.
+func (exec *execCtx) targets() []oid.ID {
+	res := make([]oid.ID, 0, len(exec.prm.members)+1)
+	res = append(res, exec.prm.addr.Object())
+
+	if !exec.isBulk() {
+		return res
+	}
+
+	seen := make(map[oid.ID]struct{}, cap(res))
+	seen[res[0]] = struct{}{}
+
+	for _, id := range exec.prm.members {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			res = append(res, id)
+		}
+	}
+
+	return res
 }
 
 func (exec *execCtx) containerID() cid.ID {
@@ -79,6 +116,26 @@ func (exec *execCtx) newAddress(id oid.ID) oid.Address {
 	return a
 }
 
+// isLocked checks whether the object is locked. The check failure is not
+// fatal: the tombstone is not saved with the locked member anyway.
+func (exec *execCtx) isLocked(id oid.ID) bool {
+	if exec.svc.lockSrc == nil {
+		return false
+	}
+
+	locked, err := exec.svc.lockSrc.IsLocked(exec.newAddress(id))
+	if err != nil {
+		exec.log.Debug("could not check object lock",
+			zap.Stringer("object", id),
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	}
+
+	return locked
+}
+
 func (exec *execCtx) formSplitInfo() bool {
 	var err error
 
@@ -280,8 +337,14 @@ func (exec *execCtx) saveTombstone() bool {
 		exec.status = statusOK
 		exec.err = nil
 
-		exec.prm.tombAddrWriter.
-			SetAddress(exec.newAddress(*id))
+		if exec.prm.tombAddrWriter != nil {
+			exec.prm.tombAddrWriter.
+				SetAddress(exec.newAddress(*id))
+		}
+
+		if exec.prm.skipWriter != nil && len(exec.skipped) > 0 {
+			exec.prm.skipWriter.SetSkipped(exec.skipped)
+		}
 	}
 
 	return true
@@ -1,6 +1,9 @@
 package deletesvc
 
 import (
+	"errors"
+
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"go.uber.org/zap"
@@ -36,30 +39,102 @@ func (exec *execCtx) formTombstone() (ok bool) {
 	exec.tombstone.SetExpirationEpoch(
 		exec.svc.netInfo.CurrentEpoch() + tsLifetime,
 	)
-	exec.addMembers([]oid.ID{exec.address().Object()})
 
-	exec.log.Debug("forming split info...")
+	var added int
+
+	for _, id := range exec.targets() {
+		if exec.addTarget(id) {
+			added++
+		}
+	}
+
+	if added == 0 {
+		return false
+	}
 
-	ok = exec.formSplitInfo()
+	ok = exec.initTombstoneObject()
 	if !ok {
 		return
 	}
 
-	exec.log.Debug("split info successfully formed, collecting members...")
+	return true
+}
 
-	exec.tombstone.SetSplitID(exec.splitInfo.SplitID())
+// addTarget adds the object, the members of its split chain and their
+// erasure coded chunks to the tombstone. In the bulk removal the object that
+// can not be removed, e.g. the locked one, is skipped: a locked member fails
+// the whole tombstone.
+func (exec *execCtx) addTarget(id oid.ID) bool {
+	exec.obj = id
 
-	ok = exec.collectMembers()
-	if !ok {
-		return
+	if exec.isBulk() && exec.isLocked(id) {
+		exec.status = statusUndefined
+		exec.err = apistatus.ObjectLocked{}
+
+		exec.log.Debug("locked object is skipped in bulk removal",
+			zap.Stringer("object", id),
+		)
+
+		exec.skipped = append(exec.skipped, SkippedObject{ID: id, Reason: SkipReasonLocked})
+
+		return false
 	}
 
-	exec.log.Debug("members successfully collected")
+	members := exec.tombstone.Members()
+
+	exec.addMembers([]oid.ID{id})
+
+	exec.log.Debug("forming split info...",
+		zap.Stringer("object", id),
+	)
+
+	ok := exec.formSplitInfo()
+	if ok {
+		exec.log.Debug("split info successfully formed, collecting members...")
+
+		if !exec.isBulk() {
+			exec.tombstone.SetSplitID(exec.splitInfo.SplitID())
+		}
+
+		ok = exec.collectMembers()
+	}
+
+	if ok {
+		ok = exec.collectChunks(exec.tombstone.Members()[len(members):])
+	}
 
-	ok = exec.initTombstoneObject()
 	if !ok {
-		return
+		if exec.isBulk() {
+			exec.log.Debug("object is skipped in bulk removal",
+				zap.Stringer("object", id),
+				zap.String("error", exec.err.Error()),
+			)
+
+			exec.tombstone.SetMembers(members)
+			exec.skipped = append(exec.skipped, SkippedObject{ID: id, Reason: skipReason(exec.err)})
+		}
+
+		return false
 	}
 
+	exec.log.Debug("members successfully collected")
+
 	return true
 }
+
+// skipReason returns the reason of skipping the object failed with the error
+// in the bulk removal.
+func skipReason(err error) string {
+	switch {
+	case errors.As(err, new(apistatus.ObjectLocked)):
+		return SkipReasonLocked
+	case errors.As(err, new(apistatus.ObjectNotFound)):
+		return SkipReasonNotFound
+	case errors.As(err, new(apistatus.ObjectAlreadyRemoved)):
+		return SkipReasonAlreadyRemoved
+	case errors.As(err, new(apistatus.ObjectAccessDenied)):
+		return SkipReasonAccessDenied
+	default:
+		return SkipReasonFailure
+	}
+}
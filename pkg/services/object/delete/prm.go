@@ -10,13 +10,30 @@ type TombstoneAddressWriter interface {
 	SetAddress(address oid.Address)
 }
 
+// SkippedObject is the object requested in the bulk removal but not removed.
+type SkippedObject struct {
+	ID oid.ID
+
+	// Reason is one of the SkipReason* values.
+	Reason string
+}
+
+// SkippedObjectsWriter is an interface of the bulk removal outcome setter.
+type SkippedObjectsWriter interface {
+	SetSkipped(objs []SkippedObject)
+}
+
 // Prm groups parameters of Delete service call.
 type Prm struct {
 	common *util.CommonPrm
 
 	addr oid.Address
 
+	members []oid.ID
+
 	tombAddrWriter TombstoneAddressWriter
+
+	skipWriter SkippedObjectsWriter
 }
 
 // SetCommonParameters sets common parameters of the operation.
@@ -29,7 +46,21 @@ func (p *Prm) WithAddress(addr oid.Address) {
 	p.addr = addr
 }
 
+// WithMembers sets identifiers of the objects from the same container
+// to be removed by the same tombstone.
+func (p *Prm) WithMembers(ids []oid.ID) {
+	p.members = ids
+}
+
 // WithTombstoneAddressTarget sets tombstone address destination.
+// The address is not reported if the destination is not set.
 func (p *Prm) WithTombstoneAddressTarget(w TombstoneAddressWriter) {
 	p.tombAddrWriter = w
 }
+
+// WithSkippedObjectsTarget sets the destination of the objects that are
+// requested in the bulk removal but are not removed, e.g. locked ones.
+// The objects are not reported if the destination is not set.
+func (p *Prm) WithSkippedObjectsTarget(w SkippedObjectsWriter) {
+	p.skipWriter = w
+}
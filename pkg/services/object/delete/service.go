@@ -1,6 +1,7 @@
 package deletesvc
 
 import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/container"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	getsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get"
 	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
@@ -34,6 +35,11 @@ type NetworkInfo interface {
 	LocalNodeID() user.ID
 }
 
+// LockSource checks whether the object is locked.
+type LockSource interface {
+	IsLocked(oid.Address) (bool, error)
+}
+
 type cfg struct {
 	log *logger.Logger
 
@@ -49,6 +55,9 @@ type cfg struct {
 
 	searcher interface {
 		splitMembers(*execCtx) ([]oid.ID, error)
+
+		// must return erasure coded chunks of the object
+		chunks(*execCtx, oid.ID) ([]oid.ID, error)
 	}
 
 	placer interface {
@@ -57,6 +66,10 @@ type cfg struct {
 
 	netInfo NetworkInfo
 
+	lockSrc LockSource
+
+	cnrSrc container.Source
+
 	keyStorage *util.KeyStorage
 }
 
@@ -122,3 +135,11 @@ func WithKeyStorage(ks *util.KeyStorage) Option {
 		c.keyStorage = ks
 	}
 }
+
+// WithLockSource returns option to set the source of the object locks
+// checked in the bulk removal.
+func WithLockSource(src LockSource) Option {
+	return func(c *cfg) {
+		c.lockSrc = src
+	}
+}
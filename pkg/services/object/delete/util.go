@@ -3,6 +3,7 @@ package deletesvc
 import (
 	"errors"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
 	getsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get"
 	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
 	searchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/search"
@@ -101,6 +102,26 @@ func (w *searchSvcWrapper) splitMembers(exec *execCtx) ([]oid.ID, error) {
 	return wr.ids, nil
 }
 
+func (w *searchSvcWrapper) chunks(exec *execCtx, id oid.ID) ([]oid.ID, error) {
+	fs := object.SearchFilters{}
+	fs.AddFilter(erasurecode.AttributeParent, id.EncodeToString(), object.MatchStringEqual)
+
+	wr := new(simpleIDWriter)
+
+	p := searchsvc.Prm{}
+	p.SetWriter(wr)
+	p.SetCommonParameters(exec.commonParameters())
+	p.WithContainerID(exec.containerID())
+	p.WithSearchFilters(fs)
+
+	err := (*searchsvc.Service)(w).Search(exec.context(), p)
+	if err != nil {
+		return nil, err
+	}
+
+	return wr.ids, nil
+}
+
 func (s *simpleIDWriter) WriteIDs(ids []oid.ID) error {
 	s.ids = append(s.ids, ids...)
 
@@ -39,7 +39,7 @@ func (s *Service) Delete(ctx context.Context, req *objectV2.DeleteRequest) (*obj
 	body := new(objectV2.DeleteResponseBody)
 	resp.SetBody(body)
 
-	p, err := s.toPrm(req, body)
+	p, err := s.toPrm(req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@ import (
 
 	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	"github.com/TrueCloudLab/frostfs-api-go/v2/refs"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
 	deletesvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/delete"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
@@ -15,7 +16,11 @@ type tombstoneBodyWriter struct {
 	body *objectV2.DeleteResponseBody
 }
 
-func (s *Service) toPrm(req *objectV2.DeleteRequest, respBody *objectV2.DeleteResponseBody) (*deletesvc.Prm, error) {
+type skippedMetaWriter struct {
+	resp *objectV2.DeleteResponse
+}
+
+func (s *Service) toPrm(req *objectV2.DeleteRequest, resp *objectV2.DeleteResponse) (*deletesvc.Prm, error) {
 	body := req.GetBody()
 
 	addrV2 := body.GetAddress()
@@ -38,9 +43,18 @@ func (s *Service) toPrm(req *objectV2.DeleteRequest, respBody *objectV2.DeleteRe
 	p := new(deletesvc.Prm)
 	p.SetCommonParameters(commonPrm)
 
+	members, err := deletesvc.ParseMembers(commonPrm.XHeaders())
+	if err != nil {
+		return nil, err
+	}
+
 	p.WithAddress(addr)
+	p.WithMembers(members)
 	p.WithTombstoneAddressTarget(&tombstoneBodyWriter{
-		body: respBody,
+		body: resp.GetBody(),
+	})
+	p.WithSkippedObjectsTarget(&skippedMetaWriter{
+		resp: resp,
 	})
 
 	return p, nil
@@ -52,3 +66,14 @@ func (w *tombstoneBodyWriter) SetAddress(addr oid.Address) {
 
 	w.body.SetTombstone(&addrV2)
 }
+
+func (w *skippedMetaWriter) SetSkipped(objs []deletesvc.SkippedObject) {
+	var x session.XHeader
+	x.SetKey(deletesvc.XHeaderSkipped)
+	x.SetValue(deletesvc.FormatSkipped(objs))
+
+	meta := new(session.ResponseMetaHeader)
+	meta.SetXHeaders([]session.XHeader{x})
+
+	w.resp.SetMetaHeader(meta)
+}
@@ -0,0 +1,117 @@
+package deletesvc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// XHeaderMembers is the DELETE request X-header with the comma-separated
+// identifiers of the objects from the container of the request object.
+// All the objects are removed by a single tombstone, the ones that can not
+// be removed are skipped, are missing in the tombstone members and are
+// reported in XHeaderSkipped.
+const XHeaderMembers = session.ReservedXHeaderPrefix + "DELETE_OBJECTS"
+
+// XHeaderSkipped is the DELETE response X-header with the comma-separated
+// objects requested in XHeaderMembers that are not removed. Each object is
+// set as its identifier and one of the SkipReason* values separated by colon.
+// The header is set in the meta header of the node serving the request, so
+// the client should look for it in the origin meta headers too.
+const XHeaderSkipped = session.ReservedXHeaderPrefix + "DELETE_SKIPPED"
+
+// Reasons of skipping the objects in the bulk removal.
+const (
+	// SkipReasonLocked is set for the locked objects.
+	SkipReasonLocked = "locked"
+	// SkipReasonNotFound is set for the missing objects.
+	SkipReasonNotFound = "not_found"
+	// SkipReasonAlreadyRemoved is set for the objects removed before.
+	SkipReasonAlreadyRemoved = "already_removed"
+	// SkipReasonAccessDenied is set for the objects the request
+	// sender can not access.
+	SkipReasonAccessDenied = "access_denied"
+	// SkipReasonFailure is set for the objects failed for other reasons.
+	SkipReasonFailure = "failure"
+)
+
+// MaxMembers is the maximum number of objects in XHeaderMembers.
+const MaxMembers = 10000
+
+// FormatMembers returns XHeaderMembers value for the given objects.
+func FormatMembers(ids []oid.ID) string {
+	ss := make([]string, len(ids))
+	for i := range ids {
+		ss[i] = ids[i].EncodeToString()
+	}
+
+	return strings.Join(ss, ",")
+}
+
+// ParseMembers returns the objects listed in XHeaderMembers of the request
+// X-headers in key-value pairs.
+func ParseMembers(xhdrs []string) ([]oid.ID, error) {
+	for i := 0; i+1 < len(xhdrs); i += 2 {
+		if xhdrs[i] != XHeaderMembers {
+			continue
+		}
+
+		ss := strings.Split(xhdrs[i+1], ",")
+		if len(ss) > MaxMembers {
+			return nil, fmt.Errorf("too many objects to remove: %d > %d", len(ss), MaxMembers)
+		}
+
+		ids := make([]oid.ID, len(ss))
+
+		for j := range ss {
+			if err := ids[j].DecodeString(ss[j]); err != nil {
+				return nil, fmt.Errorf("invalid object #%d to remove: %w", j, err)
+			}
+		}
+
+		return ids, nil
+	}
+
+	return nil, nil
+}
+
+// FormatSkipped returns XHeaderSkipped value for the given objects.
+func FormatSkipped(objs []SkippedObject) string {
+	ss := make([]string, len(objs))
+	for i := range objs {
+		ss[i] = objs[i].ID.EncodeToString() + ":" + objs[i].Reason
+	}
+
+	return strings.Join(ss, ",")
+}
+
+// ParseSkipped returns the objects listed in XHeaderSkipped value.
+func ParseSkipped(v string) ([]SkippedObject, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	ss := strings.Split(v, ",")
+	if len(ss) > MaxMembers+1 {
+		return nil, fmt.Errorf("too many skipped objects: %d > %d", len(ss), MaxMembers+1)
+	}
+
+	res := make([]SkippedObject, len(ss))
+
+	for i := range ss {
+		id, reason, ok := strings.Cut(ss[i], ":")
+		if !ok {
+			return nil, fmt.Errorf("missing reason of skipped object #%d", i)
+		}
+
+		if err := res[i].ID.DecodeString(id); err != nil {
+			return nil, fmt.Errorf("invalid skipped object #%d: %w", i, err)
+		}
+
+		res[i].Reason = reason
+	}
+
+	return res, nil
+}
@@ -0,0 +1,323 @@
+package ecsvc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	clientcore "github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	netmapCore "github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	internalclient "github.com/TrueCloudLab/frostfs-node/pkg/services/object/internal/client"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ClientConstructor is an interface of remote node clients' cache.
+type ClientConstructor interface {
+	Get(clientcore.NodeInfo) (clientcore.Client, error)
+}
+
+// Collector locates and fetches the chunks of erasure coded objects
+// from the local storage and the container nodes.
+type Collector struct {
+	log *logger.Logger
+
+	localStorage *engine.StorageEngine
+
+	keyStorage *util.KeyStorage
+
+	clientCache ClientConstructor
+
+	netmapKeys netmapCore.AnnouncedKeys
+}
+
+// Chunk describes the located chunk of an erasure coded object.
+type Chunk struct {
+	// Address is the address of the chunk object.
+	Address oid.Address
+
+	// Info is the chunk information read from the header.
+	Info erasurecode.ChunkInfo
+
+	// Node is the container node storing the chunk.
+	Node clientcore.NodeInfo
+
+	// Local is true if the chunk is stored in the local storage.
+	Local bool
+}
+
+// remoteOpTTL is the TTL of the requests to the container nodes.
+const remoteOpTTL = 1
+
+// NewCollector creates, initializes and returns new Collector instance.
+func NewCollector(log *logger.Logger, localStorage *engine.StorageEngine, keyStorage *util.KeyStorage,
+	cache ClientConstructor, netmapKeys netmapCore.AnnouncedKeys) *Collector {
+	return &Collector{
+		log:          log,
+		localStorage: localStorage,
+		keyStorage:   keyStorage,
+		clientCache:  cache,
+		netmapKeys:   netmapKeys,
+	}
+}
+
+// Locate searches for the chunks of the object on the given nodes. Nodes which
+// failed to respond are skipped. Chunks stored on several nodes are returned
+// for each of them.
+func (c *Collector) Locate(ctx context.Context, cnr cid.ID, parent oid.ID, nodes []clientcore.NodeInfo) []Chunk {
+	ctx, span := tracing.StartSpanFromContext(ctx, "ecCollector.Locate")
+	defer span.End()
+
+	var fs object.SearchFilters
+	fs.AddFilter(erasurecode.AttributeParent, parent.EncodeToString(), object.MatchStringEqual)
+
+	var (
+		mtx sync.Mutex
+		wg  sync.WaitGroup
+		res []Chunk
+	)
+
+	for i := range nodes {
+		wg.Add(1)
+
+		go func(node clientcore.NodeInfo) {
+			defer wg.Done()
+
+			chunks, err := c.locateOnNode(ctx, cnr, fs, node)
+			if err != nil {
+				c.log.Debug("could not locate erasure coded chunks",
+					zap.Stringer("object", parent),
+					zap.String("node", hex.EncodeToString(node.PublicKey())),
+					zap.Error(err))
+				return
+			}
+
+			mtx.Lock()
+			res = append(res, chunks...)
+			mtx.Unlock()
+		}(nodes[i])
+	}
+
+	wg.Wait()
+
+	return res
+}
+
+func (c *Collector) locateOnNode(ctx context.Context, cnr cid.ID, fs object.SearchFilters, node clientcore.NodeInfo) ([]Chunk, error) {
+	local := c.netmapKeys.IsLocalKey(node.PublicKey())
+
+	var ids []oid.ID
+
+	if local {
+		var prm engine.SelectPrm
+		prm.WithContainerID(cnr)
+		prm.WithFilters(fs)
+
+		res, err := c.localStorage.Select(ctx, prm)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, addr := range res.AddressList() {
+			ids = append(ids, addr.Object())
+		}
+	} else {
+		cli, key, err := c.remoteClient(node)
+		if err != nil {
+			return nil, err
+		}
+
+		var prm internalclient.SearchObjectsPrm
+		prm.SetContext(tracing.InjectToOutgoingContext(ctx))
+		prm.SetClient(cli)
+		prm.SetPrivateKey(key)
+		prm.SetTTL(remoteOpTTL)
+		prm.SetContainerID(cnr)
+		prm.SetFilters(fs)
+
+		res, err := internalclient.SearchObjects(prm)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = res.IDList()
+	}
+
+	chunks := make([]Chunk, 0, len(ids))
+	for i := range ids {
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(ids[i])
+
+		hdr, err := c.head(ctx, addr, node, local)
+		if err != nil {
+			return nil, fmt.Errorf("could not get chunk header %s: %w", addr, err)
+		}
+
+		info, ok, err := erasurecode.ReadChunkInfo(hdr)
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+
+		chunks = append(chunks, Chunk{
+			Address: addr,
+			Info:    info,
+			Node:    node,
+			Local:   local,
+		})
+	}
+
+	return chunks, nil
+}
+
+func (c *Collector) head(ctx context.Context, addr oid.Address, node clientcore.NodeInfo, local bool) (*object.Object, error) {
+	if local {
+		var prm engine.HeadPrm
+		prm.WithAddress(addr)
+		prm.WithRaw(true)
+
+		res, err := c.localStorage.Head(ctx, prm)
+		if err != nil {
+			return nil, err
+		}
+		return res.Header(), nil
+	}
+
+	cli, key, err := c.remoteClient(node)
+	if err != nil {
+		return nil, err
+	}
+
+	var prm internalclient.HeadObjectPrm
+	prm.SetContext(tracing.InjectToOutgoingContext(ctx))
+	prm.SetClient(cli)
+	prm.SetPrivateKey(key)
+	prm.SetTTL(remoteOpTTL)
+	prm.SetRawFlag()
+	prm.SetAddress(addr)
+
+	res, err := internalclient.HeadObject(prm)
+	if err != nil {
+		return nil, err
+	}
+	return res.Header(), nil
+}
+
+// Fetch reads the full chunk object.
+func (c *Collector) Fetch(ctx context.Context, chunk Chunk) (*object.Object, error) {
+	if chunk.Local {
+		var prm engine.GetPrm
+		prm.WithAddress(chunk.Address)
+
+		res, err := c.localStorage.Get(ctx, prm)
+		if err != nil {
+			return nil, err
+		}
+		return res.Object(), nil
+	}
+
+	cli, key, err := c.remoteClient(chunk.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	var prm internalclient.GetObjectPrm
+	prm.SetContext(tracing.InjectToOutgoingContext(ctx))
+	prm.SetClient(cli)
+	prm.SetPrivateKey(key)
+	prm.SetTTL(remoteOpTTL)
+	prm.SetRawFlag()
+	prm.SetAddress(chunk.Address)
+
+	res, err := internalclient.GetObject(prm)
+	if err != nil {
+		return nil, err
+	}
+	return res.Object(), nil
+}
+
+// Restore fetches the located chunks until the object can be decoded and
+// returns the original object. Local chunks are read first.
+func (c *Collector) Restore(ctx context.Context, parent oid.ID, chunks []Chunk) (*object.Object, error) {
+	ctx, span := tracing.StartSpanFromContext(ctx, "ecCollector.Restore")
+	defer span.End()
+
+	if len(chunks) == 0 {
+		return nil, erasurecode.ErrNotEnoughChunks
+	}
+
+	ordered := make([]Chunk, 0, len(chunks))
+	for i := range chunks {
+		if chunks[i].Local {
+			ordered = append(ordered, chunks[i])
+		}
+	}
+	for i := range chunks {
+		if !chunks[i].Local {
+			ordered = append(ordered, chunks[i])
+		}
+	}
+
+	need := chunks[0].Info.Policy.DataChunks
+	fetched := make(map[int]*object.Object, need)
+
+	var lastErr error
+
+	for i := 0; i < len(ordered) && len(fetched) < need; i++ {
+		if _, ok := fetched[ordered[i].Info.Index]; ok {
+			continue
+		}
+
+		obj, err := c.Fetch(ctx, ordered[i])
+		if err != nil {
+			lastErr = err
+			c.log.Debug("could not fetch erasure coded chunk",
+				zap.Stringer("chunk", ordered[i].Address),
+				zap.String("node", hex.EncodeToString(ordered[i].Node.PublicKey())),
+				zap.Error(err))
+			continue
+		}
+
+		fetched[ordered[i].Info.Index] = obj
+	}
+
+	if len(fetched) < need {
+		err := fmt.Errorf("%w: fetched %d of %d", erasurecode.ErrNotEnoughChunks, len(fetched), need)
+		if lastErr != nil {
+			err = fmt.Errorf("%w: last error: %v", err, lastErr)
+		}
+		return nil, err
+	}
+
+	objs := make([]*object.Object, 0, len(fetched))
+	for _, obj := range fetched {
+		objs = append(objs, obj)
+	}
+
+	return erasurecode.Decode(parent, objs)
+}
+
+func (c *Collector) remoteClient(node clientcore.NodeInfo) (clientcore.Client, *ecdsa.PrivateKey, error) {
+	key, err := c.keyStorage.GetKey(nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not receive private key: %w", err)
+	}
+
+	cli, err := c.clientCache.Get(node)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create SDK client %s: %w", node.AddressGroup(), err)
+	}
+
+	return cli, key, nil
+}
@@ -1,6 +1,9 @@
 package getsvc
 
 import (
+	"context"
+	"errors"
+
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
@@ -42,6 +45,15 @@ func (exec *execCtx) assemble() {
 			exec.log.Debug("neither linking nor last part of split-chain is presented in split info")
 			return
 		}
+
+		if exec.isErasureCodedChunk(childID) {
+			exec.status = statusUndefined
+			exec.err = apistatus.ObjectNotFound{}
+
+			exec.restoreErasureCoded()
+
+			return
+		}
 	}
 
 	prev, children := exec.initFromChild(childID)
@@ -52,14 +64,7 @@ func (exec *execCtx) assemble() {
 				exec.overtakePayloadDirectly(children, nil, true)
 			}
 		} else {
-			// TODO: #1155 choose one-by-one restoring algorithm according to size
-			//  * if size > MAX => go right-to-left with HEAD and back with GET
-			//  * else go right-to-left with GET and compose in single object before writing
-
-			if ok := exec.overtakePayloadInReverse(children[len(children)-1]); ok {
-				// payload of all children except the last are written, write last payload
-				exec.writeObjectPayload(exec.collectedObject)
-			}
+			exec.overtakePayloadInRange(children)
 		}
 	} else if prev != nil {
 		if ok := exec.writeCollectedHeader(); ok {
@@ -148,24 +153,328 @@ func (exec *execCtx) initFromChild(obj oid.ID) (prev *oid.ID, children []oid.ID)
 func (exec *execCtx) overtakePayloadDirectly(children []oid.ID, rngs []objectSDK.Range, checkRight bool) {
 	withRng := len(rngs) > 0 && exec.ctxRange() != nil
 
-	for i := range children {
+	fetch := func(ctx context.Context, i int) (*objectSDK.Object, statusError) {
 		var r *objectSDK.Range
 		if withRng {
 			r = &rngs[i]
 		}
 
-		child, ok := exec.getChild(children[i], r, !withRng && checkRight)
+		return exec.fetchChild(ctx, children[i], r, !withRng && checkRight)
+	}
+
+	childSize := exec.childPayloadSize()
+
+	size := func(i int) uint64 {
+		if withRng {
+			return rngs[i].GetLength()
+		}
+
+		return childSize
+	}
+
+	if ok := exec.fetchChildren(len(children), size, fetch, func(_ int, child *objectSDK.Object) bool {
+		return exec.writeObjectPayload(child)
+	}); !ok {
+		return
+	}
+
+	exec.status = statusOK
+	exec.err = nil
+}
+
+// childPayloadSize returns the expected payload size of the child object:
+// the maximum object size limited by the parent payload size. Returns
+// the parent payload size if the maximum object size is unknown.
+func (exec *execCtx) childPayloadSize() uint64 {
+	var sz uint64
+	if exec.svc.maxSizeSrc != nil {
+		sz = exec.svc.maxSizeSrc.MaxObjectSize()
+	}
+
+	if parSize := exec.collectedObject.PayloadSize(); sz == 0 || sz > parSize {
+		sz = parSize
+	}
+
+	return sz
+}
+
+// overtakePayloadInRange writes the requested payload range using the
+// children list of the linking object. Only the overlapping parts of
+// the children payloads are read.
+func (exec *execCtx) overtakePayloadInRange(children []oid.ID) {
+	chain, rngs, ok := exec.childRangesBySize(children)
+	if !ok {
+		chain, rngs, ok = exec.childRangesByHeaders(children)
 		if !ok {
 			return
 		}
+	}
 
-		if ok := exec.writeObjectPayload(child); !ok {
-			return
+	exec.overtakePayloadDirectly(chain, rngs, false)
+}
+
+// childRangesBySize finds the children overlapping the requested range
+// using the maximum object size: all the children except the last one
+// have the payload of that size. Returns false if the maximum object
+// size is unknown or doesn't match the parent payload size, e.g. it
+// has been changed after the object was split.
+func (exec *execCtx) childRangesBySize(children []oid.ID) ([]oid.ID, []objectSDK.Range, bool) {
+	if exec.svc.maxSizeSrc == nil || len(children) == 0 {
+		return nil, nil, false
+	}
+
+	var (
+		childSize = exec.svc.maxSizeSrc.MaxObjectSize()
+		parSize   = exec.collectedObject.PayloadSize()
+		n         = uint64(len(children))
+	)
+
+	if childSize == 0 || parSize <= (n-1)*childSize || parSize > n*childSize {
+		return nil, nil, false
+	}
+
+	var (
+		seekRng = exec.ctxRange()
+		from    = seekRng.GetOffset()
+		to      = from + seekRng.GetLength()
+	)
+
+	if from == to {
+		return nil, nil, true
+	}
+
+	first, last := from/childSize, (to-1)/childSize
+
+	chain := make([]oid.ID, 0, last-first+1)
+	rngs := make([]objectSDK.Range, 0, last-first+1)
+
+	for i := first; i <= last; i++ {
+		left, right := i*childSize, (i+1)*childSize
+		if right > parSize {
+			right = parSize
+		}
+
+		off := left
+		if left < from {
+			left = from
+		}
+
+		if right > to {
+			right = to
 		}
+
+		var r objectSDK.Range
+		r.SetOffset(left - off)
+		r.SetLength(right - left)
+
+		chain = append(chain, children[i])
+		rngs = append(rngs, r)
 	}
 
-	exec.status = statusOK
-	exec.err = nil
+	return chain, rngs, true
+}
+
+// childRangesByHeaders finds the children overlapping the requested range
+// requesting their headers. The execution status is set on failure.
+func (exec *execCtx) childRangesByHeaders(children []oid.ID) ([]oid.ID, []objectSDK.Range, bool) {
+	var (
+		seekRng = exec.ctxRange()
+		from    = seekRng.GetOffset()
+		to      = from + seekRng.GetLength()
+
+		off   uint64
+		chain []oid.ID
+		rngs  []objectSDK.Range
+	)
+
+	fetch := func(ctx context.Context, i int) (*objectSDK.Object, statusError) {
+		head, err := exec.fetchChildHeader(ctx, children[i])
+		if err != nil {
+			exec.log.Debug("could not get child object header",
+				zap.Stringer("child ID", children[i]),
+				zap.String("error", err.Error()),
+			)
+
+			return nil, statusError{status: statusUndefined, err: err}
+		}
+
+		if !exec.isChild(head) {
+			exec.log.Debug("parent address in child object differs")
+
+			return nil, statusError{status: statusUndefined, err: errors.New("wrong child header")}
+		}
+
+		return head, statusError{status: statusOK}
+	}
+
+	completed := exec.fetchChildren(len(children), nil, fetch, func(i int, head *objectSDK.Object) bool {
+		sz := head.PayloadSize()
+
+		if off < to && off+sz > from {
+			left, right := off, off+sz
+			if left < from {
+				left = from
+			}
+
+			if right > to {
+				right = to
+			}
+
+			var r objectSDK.Range
+			r.SetOffset(left - off)
+			r.SetLength(right - left)
+
+			chain = append(chain, children[i])
+			rngs = append(rngs, r)
+		}
+
+		off += sz
+
+		return off < to
+	})
+	if off < to {
+		if completed {
+			exec.status = statusUndefined
+			exec.err = errors.New("children payload is shorter than the requested range")
+
+			exec.log.Debug("children payload is shorter than the requested range")
+		}
+
+		return nil, nil, false
+	}
+
+	return chain, rngs, true
+}
+
+// fetchChildren calls fetch for the first n children concurrently keeping
+// at most assemblyConcurrency calls in flight and passes the results to
+// handle in the children order. Returns true if all the children have been
+// fetched and handled. The execution status is set on the fetch failure,
+// handle is expected to set it when it returns false.
+//
+// If size is set, it returns the expected payload size of the child, the
+// payloads are buffered within the assembly memory limit of the service.
+// The next child in order is always fetched, so the assembly can't be
+// blocked by the buffers of the other assemblies.
+func (exec *execCtx) fetchChildren(n int,
+	size func(int) uint64,
+	fetch func(context.Context, int) (*objectSDK.Object, statusError),
+	handle func(int, *objectSDK.Object) bool,
+) bool {
+	type result struct {
+		obj *objectSDK.Object
+		st  statusError
+	}
+
+	ctx, cancel := context.WithCancel(exec.context())
+
+	window := exec.svc.assemblyConcurrency
+	if window < 1 {
+		window = 1
+	}
+
+	var (
+		results = make([]chan result, n)
+		weights = make([]int64, n)
+
+		started, next int
+	)
+
+	defer func() {
+		cancel()
+
+		// wait for the abandoned children to free the memory
+		for ; next < started; next++ {
+			<-results[next]
+			exec.svc.releaseAssemblyMemory(weights[next])
+		}
+	}()
+
+	start := func(i int, wait bool) bool {
+		if size != nil {
+			weights[i] = exec.svc.acquireAssemblyMemory(ctx, size(i), wait)
+			if weights[i] < 0 {
+				return false
+			}
+		}
+
+		ch := make(chan result, 1)
+		results[i] = ch
+		started++
+
+		go func() {
+			obj, st := fetch(ctx, i)
+			ch <- result{obj: obj, st: st}
+		}()
+
+		return true
+	}
+
+	for i := 0; i < n; i++ {
+		for started < n && started < i+window {
+			// nothing is buffered if the next child in order
+			// is not started yet, so it is safe to wait
+			if !start(started, started == i) {
+				break
+			}
+		}
+
+		if started == i {
+			exec.status = statusUndefined
+			exec.err = ctx.Err()
+
+			return false
+		}
+
+		res := <-results[i]
+		next = i + 1
+
+		ok := res.st.status == statusOK
+		if !ok {
+			exec.statusError = res.st
+		} else {
+			ok = handle(i, res.obj)
+		}
+
+		exec.svc.releaseAssemblyMemory(weights[i])
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// acquireAssemblyMemory reserves the memory for the child payload of the
+// specified size. Sizes above the limit reserve the whole memory. If wait
+// is false, it doesn't wait for the memory to be freed. Returns the size
+// to be released or -1 if the memory hasn't been reserved.
+func (c *cfg) acquireAssemblyMemory(ctx context.Context, sz uint64, wait bool) int64 {
+	if c.assemblyMemory == nil {
+		return 0
+	}
+
+	w := c.assemblyMemoryLimit
+	if sz < uint64(w) {
+		w = int64(sz)
+	}
+
+	if wait {
+		if c.assemblyMemory.Acquire(ctx, w) != nil {
+			return -1
+		}
+	} else if !c.assemblyMemory.TryAcquire(w) {
+		return -1
+	}
+
+	return w
+}
+
+func (c *cfg) releaseAssemblyMemory(w int64) {
+	if c.assemblyMemory != nil && w > 0 {
+		c.assemblyMemory.Release(w)
+	}
 }
 
 func (exec *execCtx) overtakePayloadInReverse(prev oid.ID) bool {
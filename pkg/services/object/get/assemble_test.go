@@ -0,0 +1,281 @@
+package getsvc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/container"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	netmaptest "github.com/TrueCloudLab/frostfs-sdk-go/netmap/test"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+// countingClient counts the concurrent requests and remembers
+// the objects which payload has been requested.
+type countingClient struct {
+	*testClient
+
+	mtx         sync.Mutex
+	inFlight    int
+	maxInFlight int
+	payloadReqs map[oid.ID]struct{}
+	headReqs    int
+}
+
+func (c *countingClient) getObject(exec *execCtx, info client.NodeInfo) (*objectSDK.Object, error) {
+	c.mtx.Lock()
+	c.inFlight++
+	if c.inFlight > c.maxInFlight {
+		c.maxInFlight = c.inFlight
+	}
+	if !exec.headOnly() {
+		c.payloadReqs[exec.address().Object()] = struct{}{}
+	} else {
+		c.headReqs++
+	}
+	c.mtx.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+
+	defer func() {
+		c.mtx.Lock()
+		c.inFlight--
+		c.mtx.Unlock()
+	}()
+
+	return c.testClient.getObject(exec, info)
+}
+
+func (c *countingClient) reset() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.maxInFlight = 0
+	c.payloadReqs = make(map[oid.ID]struct{})
+	c.headReqs = 0
+}
+
+type testMaxSize uint64
+
+func (x testMaxSize) MaxObjectSize() uint64 {
+	return uint64(x)
+}
+
+func TestAssembleConcurrently(t *testing.T) {
+	ctx := context.Background()
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(netmaptest.PlacementPolicy())
+
+	var idCnr cid.ID
+	container.CalculateID(&idCnr, cnr)
+
+	const (
+		childrenNum = 6
+		concurrency = 3
+		curEpoch    = 13
+	)
+
+	addr := oidtest.Address()
+	addr.SetContainer(idCnr)
+
+	children, childIDs, payload := generateChain(childrenNum, idCnr)
+
+	srcObj := generateObject(addr, nil, payload)
+	children[len(children)-1].SetParent(srcObj)
+
+	splitInfo := objectSDK.NewSplitInfo()
+	splitInfo.SetLink(oidtest.ID())
+
+	var linkAddr oid.Address
+	linkAddr.SetContainer(idCnr)
+	idLink, _ := splitInfo.Link()
+	linkAddr.SetObject(idLink)
+
+	linkingObj := generateObject(linkAddr, nil, nil, childIDs...)
+	linkingObj.SetParentID(addr.Object())
+	linkingObj.SetParent(srcObj)
+
+	ns, _ := testNodeMatrix(t, []int{1})
+
+	c := &countingClient{testClient: newTestClient()}
+	c.addResult(addr, nil, objectSDK.NewSplitInfoError(splitInfo))
+	c.addResult(linkAddr, linkingObj, nil)
+
+	builder := &testPlacementBuilder{
+		vectors: map[string][][]netmap.NodeInfo{
+			addr.EncodeToString():     ns,
+			linkAddr.EncodeToString(): ns,
+		},
+	}
+
+	for i := range children {
+		var childAddr oid.Address
+		childAddr.SetContainer(idCnr)
+		childAddr.SetObject(childIDs[i])
+
+		c.addResult(childAddr, children[i], nil)
+		builder.vectors[childAddr.EncodeToString()] = ns
+	}
+
+	svc := &Service{cfg: new(cfg)}
+	svc.log = test.NewLogger(false)
+	svc.localStorage = newTestStorage()
+	svc.assembly = true
+	svc.assemblyConcurrency = concurrency
+	svc.traverserGenerator = &testTraverserGenerator{
+		c: cnr,
+		b: map[uint64]placement.Builder{
+			curEpoch: builder,
+		},
+	}
+	svc.clientCache = singleClientCache{c}
+	svc.currentEpochReceiver = testEpochReceiver(curEpoch)
+
+	t.Run("get", func(t *testing.T) {
+		c.reset()
+
+		w := NewSimpleObjectWriter()
+
+		var p Prm
+		p.SetObjectWriter(w)
+		p.WithAddress(addr)
+		p.common = new(util.CommonPrm)
+
+		require.NoError(t, svc.Get(ctx, p))
+		require.Equal(t, srcObj, w.Object())
+
+		require.Greater(t, c.maxInFlight, 1)
+		require.LessOrEqual(t, c.maxInFlight, concurrency)
+	})
+
+	const childSize = 10 // see generateChain
+
+	t.Run("memory limit", func(t *testing.T) {
+		defer func() {
+			svc.maxSizeSrc = nil
+			WithAssemblyMemoryLimit(0)(svc.cfg)
+		}()
+
+		svc.maxSizeSrc = testMaxSize(childSize)
+		WithAssemblyMemoryLimit(2 * childSize)(svc.cfg)
+
+		c.reset()
+
+		w := NewSimpleObjectWriter()
+
+		var p Prm
+		p.SetObjectWriter(w)
+		p.WithAddress(addr)
+		p.common = new(util.CommonPrm)
+
+		require.NoError(t, svc.Get(ctx, p))
+		require.Equal(t, srcObj, w.Object())
+
+		require.Greater(t, c.maxInFlight, 1)
+		require.LessOrEqual(t, c.maxInFlight, 2)
+
+		// all the memory is released
+		require.True(t, svc.assemblyMemory.TryAcquire(2*childSize))
+		svc.assemblyMemory.Release(2 * childSize)
+	})
+
+	testRange := func(t *testing.T, withHeaders bool) {
+		for _, tc := range []struct {
+			off, ln uint64
+		}{
+			{off: 0, ln: 1},
+			{off: 5, ln: 10},
+			{off: 10, ln: 10},
+			{off: 25, ln: 20},
+			{off: 0, ln: childrenNum * childSize},
+			{off: childrenNum*childSize - 1, ln: 1},
+		} {
+			c.reset()
+
+			w := NewSimpleObjectWriter()
+
+			var p RangePrm
+			p.SetChunkWriter(w)
+			p.WithAddress(addr)
+			p.common = new(util.CommonPrm)
+
+			r := objectSDK.NewRange()
+			r.SetOffset(tc.off)
+			r.SetLength(tc.ln)
+			p.SetRange(r)
+
+			require.NoError(t, svc.GetRange(ctx, p))
+			require.Equal(t, payload[tc.off:tc.off+tc.ln], w.Object().Payload())
+
+			for i := range childIDs {
+				_, requested := c.payloadReqs[childIDs[i]]
+				overlaps := uint64(i*childSize) < tc.off+tc.ln && uint64((i+1)*childSize) > tc.off
+				require.Equal(t, overlaps, requested, "range [%d:%d], child #%d", tc.off, tc.off+tc.ln, i)
+			}
+
+			require.Equal(t, withHeaders, c.headReqs > 0)
+		}
+	}
+
+	t.Run("range", func(t *testing.T) {
+		testRange(t, true)
+	})
+
+	t.Run("range with max object size", func(t *testing.T) {
+		defer func() { svc.maxSizeSrc = nil }()
+
+		svc.maxSizeSrc = testMaxSize(childSize)
+		testRange(t, false)
+
+		// the children headers are requested if the max object
+		// size doesn't match the size of the parent object
+		svc.maxSizeSrc = testMaxSize(childSize / 2)
+		testRange(t, true)
+	})
+
+	t.Run("local only", func(t *testing.T) {
+		// the children are always read from the network, the common
+		// parameters shared by the concurrent requests must stay intact
+		c.reset()
+
+		exec := &execCtx{
+			svc: svc,
+			ctx: ctx,
+			log: svc.log,
+		}
+		exec.prm.addr = addr
+		exec.prm.common = new(util.CommonPrm).WithLocalOnly(true)
+
+		fetch := func(ctx context.Context, i int) (*objectSDK.Object, statusError) {
+			return exec.fetchChild(ctx, childIDs[i], nil, true)
+		}
+
+		require.True(t, exec.fetchChildren(len(childIDs), nil, fetch, func(i int, child *objectSDK.Object) bool {
+			require.Equal(t, children[i], child)
+			return true
+		}))
+		require.True(t, exec.isLocal())
+
+		require.Greater(t, c.maxInFlight, 1)
+	})
+}
+
+// singleClientCache returns the same client for any node.
+type singleClientCache struct {
+	c getClient
+}
+
+func (c singleClientCache) get(client.NodeInfo) (getClient, error) {
+	return c.c, nil
+}
@@ -0,0 +1,288 @@
+package getsvc
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+)
+
+const (
+	// DefaultCacheTTL is the default time during which
+	// the cached object is served.
+	DefaultCacheTTL = time.Minute
+
+	// DefaultCacheMaxObjectSize is the default maximum payload
+	// size of the cached object.
+	DefaultCacheMaxObjectSize = 1 << 20
+)
+
+// CacheMetrics is an interface of the object cache metrics.
+type CacheMetrics interface {
+	// IncGetCacheRequests must increment the number of the cache lookups.
+	IncGetCacheRequests(hit bool)
+	// SetGetCacheSize must set the number of the cached objects
+	// and their total payload size.
+	SetGetCacheSize(objects int, size uint64)
+}
+
+// ObjectCache is an in-memory cache of the whole objects read by the
+// Get service. Objects are evicted in LRU order when the total payload
+// size exceeds the limit and are not served after the TTL.
+//
+// The cache is filled by the full GET requests and serves GET, HEAD
+// and GETRANGE requests. Removed objects must be explicitly
+// invalidated with Invalidate.
+type ObjectCache struct {
+	mtx sync.Mutex
+
+	lru *simplelru.LRU[oid.Address, cachedObject]
+
+	size    uint64
+	maxSize uint64
+
+	// gen is incremented on every invalidation to prevent caching
+	// of the objects read before they were removed.
+	gen uint64
+
+	ttl           time.Duration
+	maxObjectSize uint64
+
+	metrics CacheMetrics
+}
+
+type cachedObject struct {
+	obj       *objectSDK.Object
+	expiresAt time.Time
+}
+
+// CacheOption is an ObjectCache's constructor option.
+type CacheOption func(*ObjectCache)
+
+// WithCacheTTL returns option to set the time during which
+// the cached object is served.
+func WithCacheTTL(ttl time.Duration) CacheOption {
+	return func(c *ObjectCache) {
+		c.ttl = ttl
+	}
+}
+
+// WithCacheMaxObjectSize returns option to set the maximum payload
+// size of the cached object.
+func WithCacheMaxObjectSize(sz uint64) CacheOption {
+	return func(c *ObjectCache) {
+		c.maxObjectSize = sz
+	}
+}
+
+// WithCacheMetrics returns option to set the cache metrics.
+func WithCacheMetrics(m CacheMetrics) CacheOption {
+	return func(c *ObjectCache) {
+		c.metrics = m
+	}
+}
+
+// NewObjectCache creates the object cache with the specified
+// limit of the total payload size of the cached objects.
+func NewObjectCache(size uint64, opts ...CacheOption) *ObjectCache {
+	c := &ObjectCache{
+		maxSize:       size,
+		ttl:           DefaultCacheTTL,
+		maxObjectSize: DefaultCacheMaxObjectSize,
+	}
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	// the number of entries is limited by the payload size only
+	c.lru, _ = simplelru.NewLRU[oid.Address, cachedObject](math.MaxInt, func(_ oid.Address, v cachedObject) {
+		c.size -= v.obj.PayloadSize()
+	})
+
+	return c
+}
+
+// Invalidate removes the objects from the cache.
+func (c *ObjectCache) Invalidate(addrs ...oid.Address) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.gen++
+
+	for i := range addrs {
+		c.lru.Remove(addrs[i])
+	}
+
+	c.reportSize()
+}
+
+func (c *ObjectCache) get(addr oid.Address) (*objectSDK.Object, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	v, ok := c.lru.Get(addr)
+	if ok && time.Now().After(v.expiresAt) {
+		c.lru.Remove(addr)
+		c.reportSize()
+		ok = false
+	}
+
+	if c.metrics != nil {
+		c.metrics.IncGetCacheRequests(ok)
+	}
+
+	return v.obj, ok
+}
+
+// generation returns the current generation of the cache which
+// must be passed to put.
+func (c *ObjectCache) generation() uint64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.gen
+}
+
+// put caches the object read after the cache had the specified
+// generation. The object is not cached if the cache was invalidated
+// since then.
+func (c *ObjectCache) put(addr oid.Address, obj *objectSDK.Object, gen uint64) {
+	sz := obj.PayloadSize()
+	if sz > c.maxObjectSize || sz > c.maxSize {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if gen != c.gen {
+		return
+	}
+
+	c.lru.Remove(addr)
+	c.lru.Add(addr, cachedObject{
+		obj:       obj,
+		expiresAt: time.Now().Add(c.ttl),
+	})
+	c.size += sz
+
+	for c.size > c.maxSize {
+		c.lru.RemoveOldest()
+	}
+
+	c.reportSize()
+}
+
+func (c *ObjectCache) reportSize() {
+	if c.metrics != nil {
+		c.metrics.SetGetCacheSize(c.lru.Len(), c.size)
+	}
+}
+
+// cacheable checks if the request can be served from the cache.
+// Raw and local requests are served by the storage only because
+// they must not return the assembled objects.
+func (s *Service) cacheable(prm commonPrm) bool {
+	return s.cache != nil && !prm.raw && !prm.common.LocalOnly()
+}
+
+// getCached serves the request from the cache. Returns false
+// if the object is not cached.
+func (s *Service) getCached(prm commonPrm, rng *objectSDK.Range, head bool) (bool, error) {
+	obj, ok := s.cache.get(prm.addr)
+	if !ok {
+		return false, nil
+	}
+
+	if rng != nil {
+		from := rng.GetOffset()
+		to := from + rng.GetLength()
+
+		if pLen := obj.PayloadSize(); to < from || pLen < from || pLen < to {
+			return true, apistatus.ObjectOutOfRange{}
+		}
+
+		return true, prm.objWriter.WriteChunk(obj.Payload()[from:to])
+	}
+
+	if err := prm.objWriter.WriteHeader(obj.CutPayload()); err != nil {
+		return true, err
+	}
+
+	if head {
+		return true, nil
+	}
+
+	return true, prm.objWriter.WriteChunk(obj.Payload())
+}
+
+// getToCache serves the full GET request and caches the object.
+func (s *Service) getToCache(exec func(commonPrm) error, prm commonPrm) error {
+	gen := s.cache.generation()
+
+	w := &cachingWriter{
+		ObjectWriter: prm.objWriter,
+		limit:        s.cache.maxObjectSize,
+	}
+	prm.objWriter = w
+
+	if err := exec(prm); err != nil {
+		return err
+	}
+
+	if obj := w.object(); obj != nil {
+		s.cache.put(prm.addr, obj, gen)
+	}
+	return nil
+}
+
+// cachingWriter accumulates the object written to the underlying writer
+// unless the object payload exceeds the limit.
+type cachingWriter struct {
+	ObjectWriter
+
+	limit uint64
+
+	hdr *objectSDK.Object
+	pld []byte
+}
+
+func (w *cachingWriter) WriteHeader(obj *objectSDK.Object) error {
+	if sz := obj.PayloadSize(); sz <= w.limit {
+		w.hdr = obj
+		w.pld = make([]byte, 0, sz)
+	}
+
+	return w.ObjectWriter.WriteHeader(obj)
+}
+
+func (w *cachingWriter) WriteChunk(p []byte) error {
+	if w.hdr != nil {
+		if uint64(len(w.pld)+len(p)) > w.hdr.PayloadSize() {
+			w.hdr = nil
+			w.pld = nil
+		} else {
+			w.pld = append(w.pld, p...)
+		}
+	}
+
+	return w.ObjectWriter.WriteChunk(p)
+}
+
+// object returns the whole written object, nil if the object
+// is too big or incomplete.
+func (w *cachingWriter) object() *objectSDK.Object {
+	if w.hdr == nil || uint64(len(w.pld)) != w.hdr.PayloadSize() {
+		return nil
+	}
+
+	// the header can be used by the underlying writer
+	obj := w.hdr.CutPayload()
+	obj.SetPayload(w.pld)
+	return obj
+}
@@ -0,0 +1,217 @@
+package getsvc
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger/test"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testCacheMetrics struct {
+	hits, misses int
+	objects      int
+	size         uint64
+}
+
+func (m *testCacheMetrics) IncGetCacheRequests(hit bool) {
+	if hit {
+		m.hits++
+	} else {
+		m.misses++
+	}
+}
+
+func (m *testCacheMetrics) SetGetCacheSize(objects int, size uint64) {
+	m.objects = objects
+	m.size = size
+}
+
+func generateCachedObject(sz int) (oid.Address, *objectSDK.Object) {
+	payload := make([]byte, sz)
+	rand.Read(payload)
+
+	addr := oidtest.Address()
+	return addr, generateObject(addr, nil, payload)
+}
+
+func TestObjectCache(t *testing.T) {
+	t.Run("size limit", func(t *testing.T) {
+		m := new(testCacheMetrics)
+		c := NewObjectCache(25, WithCacheMetrics(m))
+
+		addr1, obj1 := generateCachedObject(10)
+		addr2, obj2 := generateCachedObject(10)
+		addr3, obj3 := generateCachedObject(10)
+
+		c.put(addr1, obj1, c.generation())
+		c.put(addr2, obj2, c.generation())
+		require.Equal(t, 2, m.objects)
+		require.EqualValues(t, 20, m.size)
+
+		// make the first object recently used
+		_, ok := c.get(addr1)
+		require.True(t, ok)
+
+		c.put(addr3, obj3, c.generation())
+		require.Equal(t, 2, m.objects)
+		require.EqualValues(t, 20, m.size)
+
+		_, ok = c.get(addr2)
+		require.False(t, ok)
+
+		for _, addr := range []oid.Address{addr1, addr3} {
+			_, ok := c.get(addr)
+			require.True(t, ok)
+		}
+
+		require.Equal(t, 3, m.hits)
+		require.Equal(t, 1, m.misses)
+	})
+
+	t.Run("object size limit", func(t *testing.T) {
+		c := NewObjectCache(100, WithCacheMaxObjectSize(10))
+
+		addr, obj := generateCachedObject(11)
+		c.put(addr, obj, c.generation())
+
+		_, ok := c.get(addr)
+		require.False(t, ok)
+	})
+
+	t.Run("TTL", func(t *testing.T) {
+		m := new(testCacheMetrics)
+		c := NewObjectCache(100, WithCacheTTL(time.Millisecond), WithCacheMetrics(m))
+
+		addr, obj := generateCachedObject(10)
+		c.put(addr, obj, c.generation())
+
+		time.Sleep(2 * time.Millisecond)
+
+		_, ok := c.get(addr)
+		require.False(t, ok)
+		require.Equal(t, 0, m.objects)
+		require.EqualValues(t, 0, m.size)
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		c := NewObjectCache(100)
+
+		addr, obj := generateCachedObject(10)
+		c.put(addr, obj, c.generation())
+
+		c.Invalidate(addr)
+
+		_, ok := c.get(addr)
+		require.False(t, ok)
+	})
+
+	t.Run("invalidate during the read", func(t *testing.T) {
+		c := NewObjectCache(100)
+
+		addr, obj := generateCachedObject(10)
+
+		gen := c.generation()
+		c.Invalidate(addr)
+		c.put(addr, obj, gen)
+
+		_, ok := c.get(addr)
+		require.False(t, ok)
+	})
+}
+
+func TestGetCached(t *testing.T) {
+	ctx := context.Background()
+
+	storage := newTestStorage()
+
+	svc := &Service{cfg: new(cfg)}
+	svc.log = test.NewLogger(false)
+	svc.localStorage = storage
+	svc.assembly = true
+	svc.cache = NewObjectCache(1024)
+
+	const payloadSz = 30
+
+	addr, obj := generateCachedObject(payloadSz)
+	storage.addPhy(addr, obj)
+
+	get := func(raw bool) (*objectSDK.Object, error) {
+		w := NewSimpleObjectWriter()
+
+		var p Prm
+		p.SetObjectWriter(w)
+		p.WithRawFlag(raw)
+		p.WithAddress(addr)
+		p.common = new(util.CommonPrm)
+
+		err := svc.Get(ctx, p)
+		return w.Object(), err
+	}
+
+	res, err := get(false)
+	require.NoError(t, err)
+	require.Equal(t, obj, res)
+
+	// the object is served from the cache only
+	storage.inhume(addr)
+
+	res, err = get(false)
+	require.NoError(t, err)
+	require.Equal(t, obj, res)
+
+	t.Run("raw", func(t *testing.T) {
+		_, err := get(true)
+		require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+	})
+
+	t.Run("range", func(t *testing.T) {
+		rng := func(off, ln uint64) ([]byte, error) {
+			w := NewSimpleObjectWriter()
+
+			var p RangePrm
+			p.SetChunkWriter(w)
+			p.WithAddress(addr)
+			p.common = new(util.CommonPrm)
+
+			r := objectSDK.NewRange()
+			r.SetOffset(off)
+			r.SetLength(ln)
+			p.SetRange(r)
+
+			err := svc.GetRange(ctx, p)
+			return w.Object().Payload(), err
+		}
+
+		data, err := rng(payloadSz/3, payloadSz/3)
+		require.NoError(t, err)
+		require.Equal(t, obj.Payload()[payloadSz/3:2*payloadSz/3], data)
+
+		_, err = rng(payloadSz/2, payloadSz)
+		require.ErrorAs(t, err, new(apistatus.ObjectOutOfRange))
+	})
+
+	t.Run("head", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+
+		var p HeadPrm
+		p.SetHeaderWriter(w)
+		p.WithAddress(addr)
+		p.common = new(util.CommonPrm)
+
+		require.NoError(t, svc.Head(ctx, p))
+		require.Equal(t, obj.CutPayload(), w.Object())
+	})
+
+	svc.cache.Invalidate(addr)
+
+	_, err = get(false)
+	require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
+}
@@ -0,0 +1,150 @@
+package getsvc
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/container"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
+	ecsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/ec"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// WithErasureCoding returns option to enable restoring of the erasure
+// coded objects from their chunks.
+func WithErasureCoding(cnrSrc container.Source, collector *ecsvc.Collector) Option {
+	return func(c *cfg) {
+		c.ec = &ecCfg{
+			cnrSrc:    cnrSrc,
+			collector: collector,
+		}
+	}
+}
+
+type ecCfg struct {
+	cnrSrc container.Source
+
+	collector *ecsvc.Collector
+}
+
+// isErasureCodedChunk checks whether the last part of the split chain is
+// a chunk of the erasure coded object. Chunks carry the header of the
+// original object, so it is indexed as a virtual one by the storage nodes.
+func (exec *execCtx) isErasureCodedChunk(id oid.ID) bool {
+	if exec.svc.ec == nil {
+		return false
+	}
+
+	hdr, err := exec.fetchChildHeader(exec.context(), id)
+	if err != nil {
+		return false
+	}
+
+	return erasurecode.IsChunk(hdr)
+}
+
+// restoreErasureCoded tries to restore the requested object from the
+// erasure coded chunks stored on the container nodes. The execution status
+// is left unchanged if the container does not use erasure coding or the
+// object can not be restored.
+func (exec *execCtx) restoreErasureCoded() {
+	if exec.svc.ec == nil || exec.isLocal() {
+		return
+	}
+
+	addr := exec.address()
+
+	cnr, err := exec.svc.ec.cnrSrc.Get(addr.Container())
+	if err != nil {
+		exec.log.Debug("could not get container to check erasure coding",
+			zap.String("error", err.Error()),
+		)
+		return
+	}
+
+	if _, ok, err := erasurecode.ContainerPolicy(cnr.Value); err != nil || !ok {
+		return
+	}
+
+	if !exec.initEpoch() {
+		return
+	}
+
+	traverser, ok := exec.generateTraverser(addr)
+	if !ok {
+		return
+	}
+
+	var nodes []client.NodeInfo
+
+	for {
+		addrs := traverser.Next()
+		if len(addrs) == 0 {
+			break
+		}
+
+		for i := range addrs {
+			var info client.NodeInfo
+			client.NodeInfoFromNetmapElement(&info, addrs[i])
+			nodes = append(nodes, info)
+		}
+	}
+
+	chunks := exec.svc.ec.collector.Locate(exec.context(), addr.Container(), addr.Object(), nodes)
+	if len(chunks) == 0 {
+		return
+	}
+
+	exec.log.Debug("restoring erasure coded object",
+		zap.Int("chunks", len(chunks)),
+	)
+
+	if exec.headOnly() {
+		for i := range chunks {
+			chunk, err := exec.svc.ec.collector.Fetch(exec.context(), chunks[i])
+			if err != nil {
+				continue
+			}
+
+			hdr, err := erasurecode.ParentHeader(chunk)
+			if err != nil {
+				continue
+			}
+
+			exec.collectedObject = hdr
+			exec.writeCollectedHeader()
+
+			return
+		}
+
+		exec.log.Debug("could not read header of erasure coded object from chunks")
+
+		return
+	}
+
+	obj, err := exec.svc.ec.collector.Restore(exec.context(), addr.Object(), chunks)
+	if err != nil {
+		exec.log.Debug("could not restore erasure coded object",
+			zap.String("error", err.Error()),
+		)
+		return
+	}
+
+	if rng := exec.ctxRange(); rng != nil {
+		payload := obj.Payload()
+		from := rng.GetOffset()
+		to := from + rng.GetLength()
+
+		if pLen := uint64(len(payload)); to < from || pLen < from || pLen < to {
+			exec.status = statusOutOfRange
+			exec.err = apistatus.ObjectOutOfRange{}
+
+			return
+		}
+
+		obj.SetPayload(payload[from:to])
+	}
+
+	exec.collectedObject = obj
+	exec.writeCollectedObject()
+}
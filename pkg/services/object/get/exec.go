@@ -95,7 +95,7 @@ func (exec execCtx) isRaw() bool {
 	return exec.prm.raw
 }
 
-func (exec execCtx) address() oid.Address {
+func (exec *execCtx) address() oid.Address {
 	return exec.prm.addr
 }
 
@@ -103,7 +103,7 @@ func (exec execCtx) address() oid.Address {
 // Object without reference to the parent (only children with the parent header
 // have it) is automatically considered as child: this should be guaranteed by
 // upper level logic.
-func (exec execCtx) isChild(obj *objectSDK.Object) bool {
+func (exec *execCtx) isChild(obj *objectSDK.Object) bool {
 	par := obj.Parent()
 	return par == nil || equalAddresses(exec.address(), object.AddressOf(par))
 }
@@ -200,45 +200,38 @@ func (exec *execCtx) generateTraverser(addr oid.Address) (*placement.Traverser,
 }
 
 func (exec *execCtx) getChild(id oid.ID, rng *objectSDK.Range, withHdr bool) (*objectSDK.Object, bool) {
+	var child *objectSDK.Object
+
+	child, exec.statusError = exec.fetchChild(exec.context(), id, rng, withHdr)
+
+	return child, exec.status == statusOK
+}
+
+// fetchChild reads the child object or its payload range. It doesn't change
+// the execution state, so it can be called concurrently.
+func (exec *execCtx) fetchChild(ctx context.Context, id oid.ID, rng *objectSDK.Range, withHdr bool) (*objectSDK.Object, statusError) {
 	w := NewSimpleObjectWriter()
 
-	p := exec.prm
-	p.common = p.common.WithLocalOnly(false)
+	p := exec.childPrm(id)
 	p.objWriter = w
 	p.SetRange(rng)
 
-	p.addr.SetContainer(exec.containerID())
-	p.addr.SetObject(id)
-
-	exec.statusError = exec.svc.get(exec.context(), p.commonPrm, withPayloadRange(rng))
+	st := exec.svc.get(ctx, p.commonPrm, withPayloadRange(rng))
 
 	child := w.Object()
-	ok := exec.status == statusOK
 
-	if ok && withHdr && !exec.isChild(child) {
-		exec.status = statusUndefined
-		exec.err = errors.New("wrong child header")
+	if st.status == statusOK && withHdr && !exec.isChild(child) {
+		st.status = statusUndefined
+		st.err = errors.New("wrong child header")
 
 		exec.log.Debug("parent address in child object differs")
 	}
 
-	return child, ok
+	return child, st
 }
 
 func (exec *execCtx) headChild(id oid.ID) (*objectSDK.Object, bool) {
-	p := exec.prm
-	p.common = p.common.WithLocalOnly(false)
-	p.addr.SetContainer(exec.containerID())
-	p.addr.SetObject(id)
-
-	prm := HeadPrm{
-		commonPrm: p.commonPrm,
-	}
-
-	w := NewSimpleObjectWriter()
-	prm.SetHeaderWriter(w)
-
-	err := exec.svc.Head(exec.context(), prm)
+	child, err := exec.fetchChildHeader(exec.context(), id)
 
 	switch {
 	default:
@@ -252,8 +245,6 @@ func (exec *execCtx) headChild(id oid.ID) (*objectSDK.Object, bool) {
 
 		return nil, false
 	case err == nil:
-		child := w.Object()
-
 		if !exec.isChild(child) {
 			exec.status = statusUndefined
 
@@ -267,6 +258,42 @@ func (exec *execCtx) headChild(id oid.ID) (*objectSDK.Object, bool) {
 	}
 }
 
+// fetchChildHeader reads the child object header. It doesn't change
+// the execution state, so it can be called concurrently.
+func (exec *execCtx) fetchChildHeader(ctx context.Context, id oid.ID) (*objectSDK.Object, error) {
+	p := exec.childPrm(id)
+
+	prm := HeadPrm{
+		commonPrm: p.commonPrm,
+	}
+
+	w := NewSimpleObjectWriter()
+	prm.SetHeaderWriter(w)
+
+	err := exec.svc.Head(ctx, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.Object(), nil
+}
+
+// childPrm returns the parameters of the child object request. The children
+// are fetched concurrently, so the common parameters are copied instead of
+// being changed in place.
+func (exec *execCtx) childPrm(id oid.ID) RangePrm {
+	p := exec.prm
+	if p.common.LocalOnly() {
+		common := *p.common
+		p.common = common.WithLocalOnly(false)
+	}
+
+	p.addr.SetContainer(exec.containerID())
+	p.addr.SetObject(id)
+
+	return p
+}
+
 func (exec execCtx) remoteClient(info clientcore.NodeInfo) (getClient, bool) {
 	c, err := exec.svc.clientCache.get(info)
 
@@ -4,17 +4,36 @@ import (
 	"context"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // Get serves a request to get an object by address, and returns Streamer instance.
 func (s *Service) Get(ctx context.Context, prm Prm) error {
-	return s.get(ctx, prm.commonPrm).err
+	if !s.cacheable(prm.commonPrm) {
+		return s.get(ctx, prm.commonPrm).err
+	}
+
+	if ok, err := s.getCached(prm.commonPrm, nil, false); ok {
+		return err
+	}
+
+	return s.getToCache(func(p commonPrm) error {
+		return s.get(ctx, p).err
+	}, prm.commonPrm)
 }
 
 // GetRange serves a request to get an object by address, and returns Streamer instance.
 func (s *Service) GetRange(ctx context.Context, prm RangePrm) error {
+	if s.cacheable(prm.commonPrm) {
+		if ok, err := s.getCached(prm.commonPrm, prm.rng, false); ok {
+			return err
+		}
+	}
+
 	return s.getRange(ctx, prm)
 }
 
@@ -59,6 +78,12 @@ func (s *Service) GetRangeHash(ctx context.Context, prm RangeHashPrm) (*RangeHas
 // Returns ErrNotFound if the header was not received for the call.
 // Returns SplitInfoError if object is virtual and raw flag is set.
 func (s *Service) Head(ctx context.Context, prm HeadPrm) error {
+	if s.cacheable(prm.commonPrm) {
+		if ok, err := s.getCached(prm.commonPrm, nil, true); ok {
+			return err
+		}
+	}
+
 	return s.get(ctx, prm.commonPrm, headOnly()).err
 }
 
@@ -76,6 +101,17 @@ func (s *Service) get(ctx context.Context, prm commonPrm, opts ...execOption) st
 		opts[i](exec)
 	}
 
+	var span trace.Span
+	exec.ctx, span = tracing.StartSpanFromContext(ctx, "getService.get",
+		trace.WithAttributes(
+			attribute.String("address", exec.address().EncodeToString()),
+			attribute.Bool("raw", exec.isRaw()),
+			attribute.Bool("local", exec.isLocal()),
+			attribute.Bool("head_only", exec.headOnly()),
+			attribute.Bool("with_range", exec.ctxRange() != nil),
+		))
+	defer span.End()
+
 	exec.setLogger(s.log)
 
 	exec.execute()
@@ -112,6 +148,8 @@ func (exec *execCtx) analyzeStatus(execCnr bool) {
 		if execCnr {
 			exec.executeOnContainer()
 			exec.analyzeStatus(false)
+		} else {
+			exec.restoreErasureCoded()
 		}
 	}
 }
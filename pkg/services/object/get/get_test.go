@@ -747,7 +747,7 @@ func TestGetRemoteSmall(t *testing.T) {
 				addr.SetObject(oidtest.ID())
 
 				srcObj := generateObject(addr, nil, nil)
-				srcObj.SetPayloadSize(10)
+				srcObj.SetPayloadSize(20)
 
 				ns, as := testNodeMatrix(t, []int{2})
 
@@ -809,7 +809,7 @@ func TestGetRemoteSmall(t *testing.T) {
 				err := svc.Get(ctx, p)
 				require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 
-				rngPrm := newRngPrm(false, NewSimpleObjectWriter(), 0, 1)
+				rngPrm := newRngPrm(false, NewSimpleObjectWriter(), 10, 1)
 				rngPrm.WithAddress(addr)
 
 				err = svc.GetRange(ctx, rngPrm)
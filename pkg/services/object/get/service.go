@@ -11,6 +11,7 @@ import (
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"go.uber.org/zap"
+	"golang.org/x/sync/semaphore"
 )
 
 // Service utility serving requests of Object.Get service.
@@ -18,6 +19,19 @@ type Service struct {
 	*cfg
 }
 
+// DefaultAssemblyConcurrency is the default maximum number of the child
+// objects fetched concurrently during the assembly.
+const DefaultAssemblyConcurrency = 4
+
+// MaxSizeSource is a source of the maximum object size.
+type MaxSizeSource interface {
+	// MaxObjectSize returns maximum payload size
+	// of physically stored object in system.
+	//
+	// Must return 0 if value can not be obtained.
+	MaxObjectSize() uint64
+}
+
 // Option is a Service's constructor option.
 type Option func(*cfg)
 
@@ -28,6 +42,18 @@ type getClient interface {
 type cfg struct {
 	assembly bool
 
+	// assemblyConcurrency is the maximum number of the child
+	// objects fetched concurrently during the assembly.
+	assemblyConcurrency int
+
+	// assemblyMemory limits the size of the child payloads buffered
+	// by all the assemblies, nil if the size is not limited.
+	assemblyMemory *semaphore.Weighted
+
+	assemblyMemoryLimit int64
+
+	maxSizeSrc MaxSizeSource
+
 	log *logger.Logger
 
 	localStorage interface {
@@ -47,14 +73,21 @@ type cfg struct {
 	}
 
 	keyStore *util.KeyStorage
+
+	// ec is set if the erasure coded objects can be restored.
+	ec *ecCfg
+
+	// cache is set if the objects are cached.
+	cache *ObjectCache
 }
 
 func defaultCfg() *cfg {
 	return &cfg{
-		assembly:     true,
-		log:          &logger.Logger{Logger: zap.L()},
-		localStorage: new(storageEngineWrapper),
-		clientCache:  new(clientCacheWrapper),
+		assembly:            true,
+		assemblyConcurrency: DefaultAssemblyConcurrency,
+		log:                 &logger.Logger{Logger: zap.L()},
+		localStorage:        new(storageEngineWrapper),
+		clientCache:         new(clientCacheWrapper),
 	}
 }
 
@@ -86,6 +119,41 @@ func WithoutAssembly() Option {
 	}
 }
 
+// WithAssemblyConcurrency returns option to set the maximum number
+// of the child objects fetched concurrently during the assembly.
+// Values less than 1 mean the sequential fetching.
+func WithAssemblyConcurrency(n int) Option {
+	return func(c *cfg) {
+		c.assemblyConcurrency = n
+	}
+}
+
+// WithAssemblyMemoryLimit returns option to limit the total size of
+// the child payloads buffered by the concurrent assemblies of the service.
+// Zero value means no limit.
+func WithAssemblyMemoryLimit(sz uint64) Option {
+	return func(c *cfg) {
+		if sz == 0 {
+			c.assemblyMemory = nil
+			c.assemblyMemoryLimit = 0
+
+			return
+		}
+
+		c.assemblyMemoryLimit = int64(sz)
+		c.assemblyMemory = semaphore.NewWeighted(c.assemblyMemoryLimit)
+	}
+}
+
+// WithMaxSizeSource returns option to set the source of the maximum
+// object size. It allows to find the children overlapping the requested
+// range without reading their headers.
+func WithMaxSizeSource(v MaxSizeSource) Option {
+	return func(c *cfg) {
+		c.maxSizeSrc = v
+	}
+}
+
 // WithLocalStorageEngine returns option to set local storage
 // instance.
 func WithLocalStorageEngine(e *engine.StorageEngine) Option {
@@ -130,3 +198,10 @@ func WithKeyStorage(store *util.KeyStorage) Option {
 		c.keyStore = store
 	}
 }
+
+// WithObjectCache returns option to serve the objects from the cache.
+func WithObjectCache(oc *ObjectCache) Option {
+	return func(c *cfg) {
+		c.cache = oc
+	}
+}
@@ -201,7 +201,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		headPrm.WithAddress(exec.address())
 		headPrm.WithRaw(exec.isRaw())
 
-		r, err := e.engine.Head(headPrm)
+		r, err := e.engine.Head(exec.context(), headPrm)
 		if err != nil {
 			return nil, err
 		}
@@ -212,7 +212,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		getRange.WithAddress(exec.address())
 		getRange.WithPayloadRange(rng)
 
-		r, err := e.engine.GetRange(getRange)
+		r, err := e.engine.GetRange(exec.context(), getRange)
 		if err != nil {
 			return nil, err
 		}
@@ -222,7 +222,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		var getPrm engine.GetPrm
 		getPrm.WithAddress(exec.address())
 
-		r, err := e.engine.Get(getPrm)
+		r, err := e.engine.Get(exec.context(), getPrm)
 		if err != nil {
 			return nil, err
 		}
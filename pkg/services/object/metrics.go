@@ -2,10 +2,12 @@ package object
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/util"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 )
 
 type (
@@ -44,11 +46,48 @@ type (
 		AddRangeReqDuration(time.Duration)
 		AddRangeHashReqDuration(time.Duration)
 
+		// AddRequestDuration must observe the duration of the request
+		// with the specified method and response status.
+		AddRequestDuration(method, status string, d time.Duration)
+
 		AddPutPayload(int)
 		AddGetPayload(int)
 	}
 )
 
+// statusOK is a status label of the successful requests.
+const statusOK = "OK"
+
+// requestStatus returns the status label of the request finished with err.
+func requestStatus(err error) string {
+	switch {
+	case err == nil:
+		return statusOK
+	case errors.As(err, new(apistatus.ObjectNotFound)):
+		return "OBJECT_NOT_FOUND"
+	case errors.As(err, new(apistatus.ObjectAlreadyRemoved)):
+		return "OBJECT_ALREADY_REMOVED"
+	case errors.As(err, new(apistatus.ObjectAccessDenied)):
+		return "OBJECT_ACCESS_DENIED"
+	case errors.As(err, new(apistatus.ObjectLocked)):
+		return "LOCKED"
+	case errors.As(err, new(apistatus.LockNonRegularObject)):
+		return "LOCK_NON_REGULAR_OBJECT"
+	case errors.As(err, new(apistatus.ObjectOutOfRange)):
+		return "OUT_OF_RANGE"
+	case errors.As(err, new(apistatus.ContainerNotFound)):
+		return "CONTAINER_NOT_FOUND"
+	case errors.As(err, new(apistatus.SessionTokenNotFound)):
+		return "TOKEN_NOT_FOUND"
+	case errors.As(err, new(apistatus.SessionTokenExpired)):
+		return "TOKEN_EXPIRED"
+	case errors.As(err, new(apistatus.NodeUnderMaintenance)):
+		return "NODE_UNDER_MAINTENANCE"
+	default:
+		return "INTERNAL"
+	}
+}
+
 func NewMetricCollector(next ServiceServer, register MetricRegister, enabled bool) *MetricCollector {
 	return &MetricCollector{
 		next:    next,
@@ -63,6 +102,7 @@ func (m MetricCollector) Get(req *object.GetRequest, stream GetObjectStream) (er
 		defer func() {
 			m.metrics.IncGetReqCounter(err == nil)
 			m.metrics.AddGetReqDuration(time.Since(t))
+			m.metrics.AddRequestDuration("Get", requestStatus(err), time.Since(t))
 		}()
 		err = m.next.Get(req, &getStreamMetric{
 			ServerStream: stream,
@@ -101,6 +141,7 @@ func (m MetricCollector) Head(ctx context.Context, request *object.HeadRequest)
 
 		m.metrics.IncHeadReqCounter(err == nil)
 		m.metrics.AddHeadReqDuration(time.Since(t))
+		m.metrics.AddRequestDuration("Head", requestStatus(err), time.Since(t))
 
 		return res, err
 	}
@@ -115,6 +156,7 @@ func (m MetricCollector) Search(req *object.SearchRequest, stream SearchStream)
 
 		m.metrics.IncSearchReqCounter(err == nil)
 		m.metrics.AddSearchReqDuration(time.Since(t))
+		m.metrics.AddRequestDuration("Search", requestStatus(err), time.Since(t))
 
 		return err
 	}
@@ -129,6 +171,7 @@ func (m MetricCollector) Delete(ctx context.Context, request *object.DeleteReque
 
 		m.metrics.IncDeleteReqCounter(err == nil)
 		m.metrics.AddDeleteReqDuration(time.Since(t))
+		m.metrics.AddRequestDuration("Delete", requestStatus(err), time.Since(t))
 		return res, err
 	}
 	return m.next.Delete(ctx, request)
@@ -142,6 +185,7 @@ func (m MetricCollector) GetRange(req *object.GetRangeRequest, stream GetObjectR
 
 		m.metrics.IncRangeReqCounter(err == nil)
 		m.metrics.AddRangeReqDuration(time.Since(t))
+		m.metrics.AddRequestDuration("GetRange", requestStatus(err), time.Since(t))
 
 		return err
 	}
@@ -156,6 +200,7 @@ func (m MetricCollector) GetRangeHash(ctx context.Context, request *object.GetRa
 
 		m.metrics.IncRangeHashReqCounter(err == nil)
 		m.metrics.AddRangeHashReqDuration(time.Since(t))
+		m.metrics.AddRequestDuration("GetRangeHash", requestStatus(err), time.Since(t))
 
 		return res, err
 	}
@@ -193,6 +238,7 @@ func (s putStreamMetric) CloseAndRecv() (*object.PutResponse, error) {
 
 	s.metrics.IncPutReqCounter(err == nil)
 	s.metrics.AddPutReqDuration(time.Since(s.start))
+	s.metrics.AddRequestDuration("Put", requestStatus(err), time.Since(s.start))
 
 	return res, err
 }
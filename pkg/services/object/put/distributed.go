@@ -36,6 +36,9 @@ type distributedTarget struct {
 
 	relay func(nodeDesc) error
 
+	// ec is set if the container objects must be erasure coded.
+	ec *ecPlacement
+
 	fmt *object.FormatValidator
 
 	log *logger.Logger
@@ -141,6 +144,10 @@ func (t *distributedTarget) Close() (*transformer.AccessIdentifiers, error) {
 		return nil, fmt.Errorf("(%T) could not validate payload content: %w", t, err)
 	}
 
+	if t.needErasureCoding() {
+		return t.placeChunks()
+	}
+
 	if len(t.obj.Children()) > 0 {
 		// enabling extra broadcast for linking objects
 		t.traversal.extraBroadcastEnabled = true
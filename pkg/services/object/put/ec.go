@@ -0,0 +1,198 @@
+package putsvc
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
+	svcutil "github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/transformer"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// parameters of the erasure coded object placement.
+type ecPlacement struct {
+	policy erasurecode.Policy
+
+	// keyStorage provides the key the chunks are signed with.
+	keyStorage *svcutil.KeyStorage
+
+	netState netmap.State
+
+	// chunkTargetInitializer creates the target saving the chunk on the node.
+	// Unlike nodeTargetInitializer, the chunks are sent on behalf of the node.
+	chunkTargetInitializer func(nodeDesc) preparedObjectTarget
+}
+
+// needErasureCoding checks whether the object must be split into erasure
+// coded chunks instead of being replicated. Only regular objects with the
+// payload are encoded; linking objects, tombstones, locks and other service
+// objects are replicated as usual.
+func (t *distributedTarget) needErasureCoding() bool {
+	return t.ec != nil &&
+		t.obj.Type() == objectSDK.TypeRegular &&
+		len(t.obj.Children()) == 0 &&
+		len(t.obj.Payload()) > 0 &&
+		!erasurecode.IsChunk(t.obj)
+}
+
+// placeChunks encodes the object and saves chunk #i on the i-th container node
+// of the object placement. If saving fails, the chunk is sent to the next unused
+// container node. The operation succeeds only if all the chunks are saved.
+func (t *distributedTarget) placeChunks() (*transformer.AccessIdentifiers, error) {
+	id, _ := t.obj.ID()
+
+	key, err := t.ec.keyStorage.GetKey(nil)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not receive private key: %w", t, err)
+	}
+
+	chunks, err := erasurecode.Encode(erasurecode.EncodePrm{
+		Object: t.obj,
+		Policy: t.ec.policy,
+		Key:    key,
+		Epoch:  t.ec.netState.CurrentEpoch(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not encode object: %w", t, err)
+	}
+
+	nodes, err := t.containerNodes(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nodes) < len(chunks) {
+		return nil, fmt.Errorf("(%T) not enough container nodes for erasure coding policy %s: %d",
+			t, t.ec.policy, len(nodes))
+	}
+
+	var (
+		mtx     sync.Mutex
+		lastErr error
+		next    int
+		pending = make([]int, len(chunks))
+	)
+
+	for i := range pending {
+		pending[i] = i
+	}
+
+	for len(pending) > 0 && next < len(nodes) {
+		var (
+			wg     sync.WaitGroup
+			failed []int
+		)
+
+		for _, i := range pending {
+			if next >= len(nodes) {
+				mtx.Lock()
+				failed = append(failed, i)
+				mtx.Unlock()
+				continue
+			}
+
+			node := nodes[next]
+			next++
+
+			isLocal := t.isLocalKey(node.PublicKey())
+
+			var workerPool util.WorkerPool
+
+			if isLocal {
+				workerPool = t.localPool
+			} else {
+				workerPool = t.remotePool
+			}
+
+			wg.Add(1)
+
+			chunk := chunks[i]
+			idx := i
+
+			if err := workerPool.Submit(func() {
+				defer wg.Done()
+
+				err := t.sendChunk(nodeDesc{local: isLocal, info: node}, chunk)
+				if err != nil {
+					svcutil.LogServiceError(t.log, "PUT", node.Addresses(), err)
+
+					mtx.Lock()
+					lastErr = err
+					failed = append(failed, idx)
+					mtx.Unlock()
+				}
+			}); err != nil {
+				wg.Done()
+
+				svcutil.LogWorkerPoolError(t.log, "PUT", err)
+
+				mtx.Lock()
+				failed = append(failed, idx)
+				mtx.Unlock()
+			}
+		}
+
+		wg.Wait()
+
+		pending = failed
+	}
+
+	if len(pending) > 0 {
+		return nil, errIncompletePut{singleErr: lastErr}
+	}
+
+	return new(transformer.AccessIdentifiers).
+		WithSelfID(id), nil
+}
+
+// containerNodes returns the list of distinct container nodes in the order
+// of the object placement.
+func (t *distributedTarget) containerNodes(id oid.ID) ([]placement.Node, error) {
+	traverser, err := placement.NewTraverser(
+		append(t.traversal.opts, placement.ForObject(id), placement.WithoutSuccessTracking())...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not create object placement traverser: %w", t, err)
+	}
+
+	var (
+		nodes []placement.Node
+		seen  = make(map[string]struct{})
+	)
+
+	for {
+		addrs := traverser.Next()
+		if len(addrs) == 0 {
+			break
+		}
+
+		for i := range addrs {
+			key := string(addrs[i].PublicKey())
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			nodes = append(nodes, addrs[i])
+		}
+	}
+
+	return nodes, nil
+}
+
+func (t *distributedTarget) sendChunk(node nodeDesc, chunk *objectSDK.Object) error {
+	target := t.ec.chunkTargetInitializer(node)
+
+	if err := target.WriteObject(chunk, object.ContentMeta{}); err != nil {
+		return fmt.Errorf("could not write chunk header: %w", err)
+	} else if _, err := target.Close(); err != nil {
+		return fmt.Errorf("could not close chunk stream: %w", err)
+	}
+	return nil
+}
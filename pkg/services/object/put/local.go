@@ -1,6 +1,7 @@
 package putsvc
 
 import (
+	"context"
 	"fmt"
 
 	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
@@ -13,16 +14,18 @@ import (
 type ObjectStorage interface {
 	// Put must save passed object
 	// and return any appeared error.
-	Put(*object.Object) error
+	Put(context.Context, *object.Object) error
 	// Delete must delete passed objects
 	// and return any appeared error.
-	Delete(tombstone oid.Address, toDelete []oid.ID) error
+	Delete(ctx context.Context, tombstone oid.Address, toDelete []oid.ID) error
 	// Lock must lock passed objects
 	// and return any appeared error.
-	Lock(locker oid.Address, toLock []oid.ID) error
+	Lock(ctx context.Context, locker oid.Address, toLock []oid.ID) error
 }
 
 type localTarget struct {
+	ctx context.Context
+
 	storage ObjectStorage
 
 	obj  *object.Object
@@ -39,12 +42,12 @@ func (t *localTarget) WriteObject(obj *object.Object, meta objectCore.ContentMet
 func (t *localTarget) Close() (*transformer.AccessIdentifiers, error) {
 	switch t.meta.Type() {
 	case object.TypeTombstone:
-		err := t.storage.Delete(objectCore.AddressOf(t.obj), t.meta.Objects())
+		err := t.storage.Delete(t.ctx, objectCore.AddressOf(t.obj), t.meta.Objects())
 		if err != nil {
 			return nil, fmt.Errorf("could not delete objects from tombstone locally: %w", err)
 		}
 	case object.TypeLock:
-		err := t.storage.Lock(objectCore.AddressOf(t.obj), t.meta.Objects())
+		err := t.storage.Lock(t.ctx, objectCore.AddressOf(t.obj), t.meta.Objects())
 		if err != nil {
 			return nil, fmt.Errorf("could not lock object from lock objects locally: %w", err)
 		}
@@ -52,7 +55,7 @@ func (t *localTarget) Close() (*transformer.AccessIdentifiers, error) {
 		// objects that do not change meta storage
 	}
 
-	if err := t.storage.Put(t.obj); err != nil {
+	if err := t.storage.Put(t.ctx, t.obj); err != nil {
 		return nil, fmt.Errorf("(%T) could not put object to local storage: %w", t, err)
 	}
 
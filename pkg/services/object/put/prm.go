@@ -2,6 +2,7 @@ package putsvc
 
 import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
 	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
@@ -15,9 +16,15 @@ type PutInitPrm struct {
 
 	cnr containerSDK.Container
 
+	// ecPolicy is set if the container objects are erasure coded.
+	ecPolicy *erasurecode.Policy
+
 	traverseOpts []placement.Option
 
 	relay func(client.NodeInfo, client.MultiAddressClient) error
+
+	uploadID     string
+	uploadOffset uint64
 }
 
 type PutChunkPrm struct {
@@ -48,6 +55,17 @@ func (p *PutInitPrm) WithRelay(f func(client.NodeInfo, client.MultiAddressClient
 	return p
 }
 
+// WithUpload sets the identifier of the resumable upload and the payload
+// offset the upload is continued from.
+func (p *PutInitPrm) WithUpload(id string, offset uint64) *PutInitPrm {
+	if p != nil {
+		p.uploadID = id
+		p.uploadOffset = offset
+	}
+
+	return p
+}
+
 func (p *PutChunkPrm) WithChunk(v []byte) *PutChunkPrm {
 	if p != nil {
 		p.chunk = v
@@ -54,6 +54,14 @@ type cfg struct {
 
 	clientConstructor ClientConstructor
 
+	uploads UploadStorage
+
+	// uploadLifetime is the number of epochs the state
+	// of the resumable upload is kept after the last write.
+	uploadLifetime uint64
+
+	activeUploads uploadLocks
+
 	log *logger.Logger
 }
 
@@ -141,6 +149,15 @@ func WithClientConstructor(v ClientConstructor) Option {
 	}
 }
 
+// WithUploadStorage returns option to enable resumable uploads with the
+// states kept in the storage for the given number of epochs after the last write.
+func WithUploadStorage(s UploadStorage, lifetime uint64) Option {
+	return func(c *cfg) {
+		c.uploads = s
+		c.uploadLifetime = lifetime
+	}
+}
+
 func WithLogger(l *logger.Logger) Option {
 	return func(c *cfg) {
 		c.log = l
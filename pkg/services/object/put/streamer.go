@@ -7,12 +7,16 @@ import (
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/transformer"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
 	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Streamer struct {
@@ -20,11 +24,19 @@ type Streamer struct {
 
 	ctx context.Context
 
+	// span covers the stream from Init to Close.
+	span trace.Span
+
 	target transformer.ObjectTarget
 
 	relay func(client.NodeInfo, client.MultiAddressClient) error
 
 	maxPayloadSz uint64 // network config
+
+	// uploadKey is set if the object is written by the resumable upload.
+	uploadKey string
+	// releaseUpload allows the other streams to write the upload.
+	releaseUpload func()
 }
 
 var errNotInit = errors.New("stream not initialized")
@@ -32,12 +44,23 @@ var errNotInit = errors.New("stream not initialized")
 var errInitRecall = errors.New("init recall")
 
 func (p *Streamer) Init(prm *PutInitPrm) error {
+	if p.span == nil {
+		p.ctx, p.span = tracing.StartSpanFromContext(p.ctx, "putService.Put",
+			trace.WithAttributes(
+				attribute.Bool("local", prm.common.LocalOnly()),
+			))
+	}
+
 	// initialize destination target
 	if err := p.initTarget(prm); err != nil {
+		if !errors.Is(err, errInitRecall) {
+			p.span.End()
+		}
 		return fmt.Errorf("(%T) could not initialize object target: %w", p, err)
 	}
 
 	if err := p.target.WriteHeader(prm.hdr); err != nil {
+		p.span.End()
 		return fmt.Errorf("(%T) could not write header to target: %w", p, err)
 	}
 	return nil
@@ -115,21 +138,32 @@ func (p *Streamer) initTarget(prm *PutInitPrm) error {
 		}
 	}
 
+	withoutHomomorphicHash := containerSDK.IsHomomorphicHashingDisabled(prm.cnr)
+
+	targetInit := func() transformer.ObjectTarget {
+		return transformer.NewFormatTarget(&transformer.FormatterParams{
+			Key:          sessionKey,
+			NextTarget:   p.newCommonTarget(prm),
+			SessionToken: sToken,
+			NetworkState: p.networkState,
+		})
+	}
+
+	var limiter transformer.ObjectTarget
+
+	if prm.uploadID != "" {
+		limiter, err = p.initUpload(prm, withoutHomomorphicHash, targetInit)
+		if err != nil {
+			return fmt.Errorf("(%T) could not init resumable upload: %w", p, err)
+		}
+	} else {
+		limiter = transformer.NewPayloadSizeLimiter(p.maxPayloadSz, withoutHomomorphicHash, targetInit)
+	}
+
 	p.target = &validatingTarget{
 		fmt:              p.fmtValidator,
 		unpreparedObject: true,
-		nextTarget: transformer.NewPayloadSizeLimiter(
-			p.maxPayloadSz,
-			containerSDK.IsHomomorphicHashingDisabled(prm.cnr),
-			func() transformer.ObjectTarget {
-				return transformer.NewFormatTarget(&transformer.FormatterParams{
-					Key:          sessionKey,
-					NextTarget:   p.newCommonTarget(prm),
-					SessionToken: sToken,
-					NetworkState: p.networkState,
-				})
-			},
-		),
+		nextTarget:       limiter,
 	}
 
 	return nil
@@ -157,6 +191,13 @@ func (p *Streamer) preparePrm(prm *PutInitPrm) error {
 
 	prm.cnr = cnrInfo.Value
 
+	ecPolicy, ok, err := erasurecode.ContainerPolicy(prm.cnr)
+	if err != nil {
+		return fmt.Errorf("(%T) could not read container erasure coding policy: %w", p, err)
+	} else if ok {
+		prm.ecPolicy = &ecPolicy
+	}
+
 	// add common options
 	prm.traverseOpts = append(prm.traverseOpts,
 		// set processing container
@@ -188,8 +229,37 @@ func (p *Streamer) preparePrm(prm *PutInitPrm) error {
 }
 
 func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
+	var ec *ecPlacement
+	if prm.ecPolicy != nil && !prm.common.LocalOnly() {
+		ec = &ecPlacement{
+			policy:     *prm.ecPolicy,
+			keyStorage: p.keyStorage,
+			netState:   p.networkState,
+			chunkTargetInitializer: func(node nodeDesc) preparedObjectTarget {
+				if node.local {
+					return &localTarget{
+						ctx:     p.ctx,
+						storage: p.localStore,
+					}
+				}
+
+				rt := &remoteTarget{
+					ctx:               p.ctx,
+					keyStorage:        p.keyStorage,
+					clientConstructor: p.clientConstructor,
+				}
+
+				client.NodeInfoFromNetmapElement(&rt.nodeInfo, node.info)
+
+				return rt
+			},
+		}
+	}
+
 	var relay func(nodeDesc) error
-	if p.relay != nil {
+	// objects of erasure coded containers are encoded by the first node
+	// since chunks must be distributed over distinct container nodes
+	if p.relay != nil && ec == nil {
 		relay = func(node nodeDesc) error {
 			var info client.NodeInfo
 
@@ -221,6 +291,7 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 		nodeTargetInitializer: func(node nodeDesc) preparedObjectTarget {
 			if node.local {
 				return &localTarget{
+					ctx:     p.ctx,
 					storage: p.localStore,
 				}
 			}
@@ -237,6 +308,7 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 			return rt
 		},
 		relay: relay,
+		ec:    ec,
 		fmt:   p.fmtValidator,
 		log:   p.log,
 
@@ -261,11 +333,19 @@ func (p *Streamer) Close() (*PutResponse, error) {
 		return nil, errNotInit
 	}
 
+	defer p.span.End()
+
+	if p.releaseUpload != nil {
+		defer p.releaseUpload()
+	}
+
 	ids, err := p.target.Close()
 	if err != nil {
 		return nil, fmt.Errorf("(%T) could not close object target: %w", p, err)
 	}
 
+	p.finishUpload()
+
 	id := ids.ParentID()
 	if id != nil {
 		return &PutResponse{
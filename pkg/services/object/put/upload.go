@@ -0,0 +1,242 @@
+package putsvc
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/status"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/transformer"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	"go.uber.org/zap"
+)
+
+const (
+	// XHeaderUploadID is the request X-header with the identifier of the
+	// resumable upload chosen by the client. Objects are cut into parts and
+	// the written parts are remembered by the node, so the upload interrupted
+	// by the connection failure can be continued by the request with the
+	// same upload ID. The header is ignored for the objects signed by the client.
+	XHeaderUploadID = session.ReservedXHeaderPrefix + "UPLOAD_ID"
+	// XHeaderUploadOffset is the request X-header with the payload offset
+	// the resumed upload is continued from. It must be equal to the offset
+	// acknowledged by the node, zero by default.
+	XHeaderUploadOffset = session.ReservedXHeaderPrefix + "UPLOAD_OFFSET"
+
+	// UploadOffsetDetailID is the identifier of the status detail with the
+	// acknowledged payload offset of the resumed upload.
+	UploadOffsetDetailID = 0x55504c44
+)
+
+// UploadStorage is a persistent storage of the resumable upload states.
+// The uploads are identified by the keys built from the container, the
+// object owner and the upload ID chosen by the client, so the uploads of
+// the different users don't intersect.
+type UploadStorage interface {
+	// Get must return the state of the upload, nil if there is no such upload.
+	Get(id string) ([]byte, error)
+	// Put must save the state of the upload until the expiration epoch.
+	Put(id string, exp uint64, state []byte) error
+	// Delete must remove the state of the upload.
+	Delete(id string) error
+}
+
+var (
+	errUploadsDisabled  = errors.New("resumable uploads are disabled")
+	errUploadMismatch   = errors.New("object header doesn't match the resumed upload")
+	errUploadInProgress = errors.New("upload is being written by another stream")
+)
+
+// uploadLocks tracks the resumable uploads written by the active streams,
+// so that the state of an upload is changed by a single stream only.
+type uploadLocks struct {
+	mtx    sync.Mutex
+	active map[string]struct{}
+}
+
+// lock marks the upload as active. Returns false if it is already active.
+func (l *uploadLocks) lock(id string) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if _, ok := l.active[id]; ok {
+		return false
+	}
+
+	if l.active == nil {
+		l.active = make(map[string]struct{})
+	}
+
+	l.active[id] = struct{}{}
+
+	return true
+}
+
+func (l *uploadLocks) unlock(id string) {
+	l.mtx.Lock()
+	delete(l.active, id)
+	l.mtx.Unlock()
+}
+
+// UploadOffsetError is returned when the payload of the resumable upload doesn't
+// start from the acknowledged offset. The offset is passed to the client in the
+// status detail, see UploadOffset.
+type UploadOffsetError struct {
+	// Offset is the acknowledged payload offset of the upload.
+	Offset uint64
+}
+
+func (e UploadOffsetError) Error() string {
+	return fmt.Sprintf("upload must be continued from the payload offset %d", e.Offset)
+}
+
+// ToStatusV2 implements apistatus.StatusV2 interface.
+func (e UploadOffsetError) ToStatusV2() *status.Status {
+	var st apistatus.ServerInternal
+	apistatus.WriteInternalServerErr(&st, e)
+
+	val := make([]byte, 8)
+	binary.BigEndian.PutUint64(val, e.Offset)
+
+	var d status.Detail
+	d.SetID(UploadOffsetDetailID)
+	d.SetValue(val)
+
+	res := st.ToStatusV2()
+	res.AppendDetails(d)
+
+	return res
+}
+
+// UploadOffset returns the acknowledged payload offset of the resumable
+// upload from the error returned by the node.
+func UploadOffset(err error) (uint64, bool) {
+	var st apistatus.StatusV2
+	if !errors.As(err, &st) {
+		return 0, false
+	}
+
+	var (
+		off uint64
+		ok  bool
+	)
+
+	st.ToStatusV2().IterateDetails(func(d *status.Detail) bool {
+		if d.ID() == UploadOffsetDetailID && len(d.Value()) == 8 {
+			off, ok = binary.BigEndian.Uint64(d.Value()), true
+		}
+
+		return ok
+	})
+
+	return off, ok
+}
+
+// uploadKey returns the key of the upload with the given ID
+// of the object owner in the container.
+func uploadKey(cnr cid.ID, owner user.ID, id string) string {
+	return cnr.EncodeToString() + "/" + owner.EncodeToString() + "/" + id
+}
+
+// initUpload returns the payload size limiter continuing the upload
+// from the saved checkpoint if there is any. The upload can't be written
+// by the other streams until the stream is closed or abandoned.
+func (p *Streamer) initUpload(prm *PutInitPrm, withoutHomomorphicHash bool, targetInit transformer.TargetInitializer) (_ transformer.ObjectTarget, err error) {
+	if p.uploads == nil {
+		return nil, errUploadsDisabled
+	}
+
+	cnr, _ := prm.hdr.ContainerID()
+
+	owner := prm.hdr.OwnerID()
+
+	key := uploadKey(cnr, *owner, prm.uploadID)
+
+	if !p.activeUploads.lock(key) {
+		return nil, errUploadInProgress
+	}
+
+	var once sync.Once
+
+	release := func() {
+		once.Do(func() {
+			p.activeUploads.unlock(key)
+		})
+	}
+
+	defer func() {
+		if err != nil {
+			release()
+		}
+	}()
+
+	state, err := p.uploads.Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("could not read upload state: %w", err)
+	}
+
+	var cp *transformer.Checkpoint
+
+	if state != nil {
+		cp = new(transformer.Checkpoint)
+		if err := cp.Unmarshal(state); err != nil {
+			return nil, fmt.Errorf("could not decode upload state: %w", err)
+		}
+
+		cpCnr, _ := cp.Parent.ContainerID()
+		cpOwner := cp.Parent.OwnerID()
+
+		if !cnr.Equals(cpCnr) || !owner.Equals(*cpOwner) {
+			return nil, errUploadMismatch
+		}
+	}
+
+	var acked uint64
+	if cp != nil {
+		acked = cp.Written
+	}
+
+	if prm.uploadOffset != acked {
+		return nil, UploadOffsetError{Offset: acked}
+	}
+
+	p.uploadKey = key
+	p.releaseUpload = release
+
+	// the stream can be abandoned without Close
+	if done := p.ctx.Done(); done != nil {
+		go func() {
+			<-done
+			release()
+		}()
+	}
+
+	return transformer.NewResumablePayloadSizeLimiter(p.maxPayloadSz, withoutHomomorphicHash, targetInit, cp,
+		func(cp transformer.Checkpoint) error {
+			state, err := cp.Marshal()
+			if err != nil {
+				return err
+			}
+
+			return p.uploads.Put(p.uploadKey, p.networkState.CurrentEpoch()+p.uploadLifetime, state)
+		},
+	), nil
+}
+
+// finishUpload removes the state of the completed upload.
+func (p *Streamer) finishUpload() {
+	if p.uploadKey == "" {
+		return
+	}
+
+	if err := p.uploads.Delete(p.uploadKey); err != nil {
+		p.log.Warn("could not remove state of the completed upload",
+			zap.String("upload", p.uploadKey),
+			zap.Error(err),
+		)
+	}
+}
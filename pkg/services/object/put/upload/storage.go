@@ -0,0 +1,168 @@
+package upload
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// Storage is a persistent storage of the resumable upload states.
+// Every state is stored until the expiration epoch.
+type Storage struct {
+	db *bbolt.DB
+
+	l *logger.Logger
+}
+
+var uploadsBucket = []byte("uploads")
+
+const epochOffset = 8
+
+// Option allows setting optional parameters of the Storage.
+type Option func(*Storage)
+
+// WithLogger returns an option to specify logger.
+func WithLogger(l *logger.Logger) Option {
+	return func(s *Storage) {
+		s.l = l
+	}
+}
+
+// NewStorage opens the Storage at the given path.
+func NewStorage(path string, opts ...Option) (*Storage, error) {
+	s := &Storage{
+		l: &logger.Logger{Logger: zap.L()},
+	}
+
+	for i := range opts {
+		opts[i](s)
+	}
+
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{
+		Timeout: 100 * time.Millisecond,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can't open bbolt at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(uploadsBucket)
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("could not init uploads bucket: %w", err)
+	}
+
+	s.db = db
+
+	return s, nil
+}
+
+// Get returns the state of the upload. Returns nil if there is no such upload.
+func (s *Storage) Get(id string) ([]byte, error) {
+	var state []byte
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(uploadsBucket).Get([]byte(id))
+		if len(v) >= epochOffset {
+			state = slice.Copy(v[epochOffset:])
+		}
+
+		return nil
+	})
+
+	return state, err
+}
+
+// Put saves the state of the upload which is kept until the expiration epoch.
+func (s *Storage) Put(id string, exp uint64, state []byte) error {
+	v := make([]byte, epochOffset, epochOffset+len(state))
+	binary.LittleEndian.PutUint64(v, exp)
+	v = append(v, state...)
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(uploadsBucket).Put([]byte(id), v)
+	})
+}
+
+// Delete removes the state of the upload.
+func (s *Storage) Delete(id string) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(uploadsBucket).Delete([]byte(id))
+	})
+}
+
+// RemoveOld removes all the uploads expired since the provided epoch.
+// The states of the removed uploads are passed to h if it is not nil,
+// e.g. to remove the objects stored by the abandoned uploads.
+func (s *Storage) RemoveOld(epoch uint64, h func(id string, state []byte)) {
+	type expired struct {
+		id    string
+		state []byte
+	}
+
+	var removed []expired
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		c := tx.Bucket(uploadsBucket).Cursor()
+
+		for k, v := c.First(); k != nil; {
+			if len(v) < epochOffset || binary.LittleEndian.Uint64(v) <= epoch {
+				key := slice.Copy(k)
+
+				var state []byte
+				if len(v) >= epochOffset {
+					state = slice.Copy(v[epochOffset:])
+				}
+
+				if err := c.Delete(); err != nil {
+					return err
+				}
+
+				removed = append(removed, expired{id: string(key), state: state})
+
+				// Next skips the element following the deleted one
+				k, v = c.Seek(key)
+
+				continue
+			}
+
+			k, v = c.Next()
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.l.Error("could not remove expired uploads",
+			zap.Uint64("epoch", epoch),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	if len(removed) > 0 {
+		s.l.Debug("expired uploads removed",
+			zap.Uint64("epoch", epoch),
+			zap.Int("count", len(removed)),
+		)
+	}
+
+	if h != nil {
+		for i := range removed {
+			h(removed[i].id, removed[i].state)
+		}
+	}
+}
+
+// Close closes the database.
+func (s *Storage) Close() error {
+	return s.db.Close()
+}
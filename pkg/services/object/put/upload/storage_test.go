@@ -0,0 +1,58 @@
+package upload
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "uploads")
+
+	s, err := NewStorage(path)
+	require.NoError(t, err)
+
+	state, err := s.Get("missing")
+	require.NoError(t, err)
+	require.Nil(t, state)
+
+	const num = 10
+
+	for i := 0; i < num; i++ {
+		require.NoError(t, s.Put(strconv.Itoa(i), uint64(i), []byte{byte(i)}))
+	}
+
+	require.NoError(t, s.Delete("0"))
+
+	removed := make(map[string][]byte)
+	s.RemoveOld(5, func(id string, state []byte) {
+		removed[id] = state
+	})
+
+	require.Len(t, removed, 5)
+	for i := 1; i <= 5; i++ {
+		require.Equal(t, []byte{byte(i)}, removed[strconv.Itoa(i)])
+	}
+
+	require.NoError(t, s.Close())
+
+	s, err = NewStorage(path)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, s.Close())
+	})
+
+	for i := 0; i < num; i++ {
+		state, err := s.Get(strconv.Itoa(i))
+		require.NoError(t, err)
+
+		if i <= 5 {
+			require.Nil(t, state, i)
+		} else {
+			require.Equal(t, []byte{byte(i)}, state)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package putsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	usertest "github.com/TrueCloudLab/frostfs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadOffset(t *testing.T) {
+	_, ok := UploadOffset(errors.New("some error"))
+	require.False(t, ok)
+
+	_, ok = UploadOffset(apistatus.ServerInternal{})
+	require.False(t, ok)
+
+	// the status is transferred to the client
+	err := apistatus.ErrFromStatus(apistatus.FromStatusV2(UploadOffsetError{Offset: 42}.ToStatusV2()))
+
+	off, ok := UploadOffset(fmt.Errorf("wrapped: %w", err))
+	require.True(t, ok)
+	require.EqualValues(t, 42, off)
+}
+
+type memUploads map[string][]byte
+
+func (m memUploads) Get(id string) ([]byte, error) {
+	return m[id], nil
+}
+
+func (m memUploads) Put(id string, _ uint64, state []byte) error {
+	m[id] = state
+	return nil
+}
+
+func (m memUploads) Delete(id string) error {
+	delete(m, id)
+	return nil
+}
+
+func TestUploadLock(t *testing.T) {
+	svc := NewService(WithUploadStorage(make(memUploads), 1))
+
+	cnr := cidtest.ID()
+	owner := usertest.ID()
+
+	newPrm := func(id string, off uint64) *PutInitPrm {
+		obj := object.New()
+		obj.SetContainerID(cnr)
+		obj.SetOwnerID(owner)
+
+		return new(PutInitPrm).WithObject(obj).WithUpload(id, off)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s1, err := svc.Put(ctx)
+	require.NoError(t, err)
+
+	_, err = s1.initUpload(newPrm("upload", 0), true, nil)
+	require.NoError(t, err)
+
+	s2, err := svc.Put(context.Background())
+	require.NoError(t, err)
+
+	_, err = s2.initUpload(newPrm("upload", 0), true, nil)
+	require.ErrorIs(t, err, errUploadInProgress)
+
+	// the abandoned stream releases the upload
+	cancel()
+	require.Eventually(t, func() bool {
+		_, err := s2.initUpload(newPrm("upload", 0), true, nil)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	t.Run("other owner", func(t *testing.T) {
+		// the upload IDs are scoped by the object owner
+		s, err := svc.Put(context.Background())
+		require.NoError(t, err)
+
+		prm := newPrm("upload", 0)
+		prm.hdr.SetOwnerID(usertest.ID())
+
+		_, err = s.initUpload(prm, true, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("failed init", func(t *testing.T) {
+		s, err := svc.Put(context.Background())
+		require.NoError(t, err)
+
+		_, err = s.initUpload(newPrm("other", 10), true, nil)
+		require.ErrorAs(t, err, new(UploadOffsetError))
+
+		_, err = s.initUpload(newPrm("other", 0), true, nil)
+		require.NoError(t, err)
+	})
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object"
+	copysvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/copy"
 	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 )
 
 // Service implements Put operation of Object service v2.
@@ -19,9 +22,18 @@ type Option func(*cfg)
 
 type cfg struct {
 	svc        *putsvc.Service
+	copySvc    *copysvc.Service
+	copySrc    CopySourceChecker
 	keyStorage *util.KeyStorage
 }
 
+// CopySourceChecker checks the access to the source object of the copy.
+type CopySourceChecker interface {
+	// CheckCopySource must return an error if the sender of the request
+	// is not allowed to read the source object with the given header.
+	CheckCopySource(*objectV2.PutRequest, *objectSDK.Object) error
+}
+
 // NewService constructs Service instance from provided options.
 func NewService(opts ...Option) *Service {
 	c := new(cfg)
@@ -43,7 +55,10 @@ func (s *Service) Put(ctx context.Context) (object.PutObjectStream, error) {
 	}
 
 	return &streamer{
+		ctx:        ctx,
 		stream:     stream,
+		copySvc:    s.copySvc,
+		copySrc:    s.copySrc,
 		keyStorage: s.keyStorage,
 	}, nil
 }
@@ -54,6 +69,22 @@ func WithInternalService(v *putsvc.Service) Option {
 	}
 }
 
+// WithCopyService returns option to set the service
+// copying the objects requested by the PUT X-headers.
+func WithCopyService(v *copysvc.Service) Option {
+	return func(c *cfg) {
+		c.copySvc = v
+	}
+}
+
+// WithCopySourceChecker returns option to set the checker of the
+// source objects read by the copy service.
+func WithCopySourceChecker(v CopySourceChecker) Option {
+	return func(c *cfg) {
+		c.copySrc = v
+	}
+}
+
 func WithKeyStorage(ks *util.KeyStorage) Option {
 	return func(c *cfg) {
 		c.keyStorage = ks
@@ -1,6 +1,8 @@
 package putsvc
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/TrueCloudLab/frostfs-api-go/v2/object"
@@ -10,14 +12,20 @@ import (
 	"github.com/TrueCloudLab/frostfs-api-go/v2/signature"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
 	"github.com/TrueCloudLab/frostfs-node/pkg/network"
+	copysvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/copy"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/internal"
 	internalclient "github.com/TrueCloudLab/frostfs-node/pkg/services/object/internal/client"
 	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 )
 
 type streamer struct {
+	ctx        context.Context
 	stream     *putsvc.Streamer
+	copySvc    *copysvc.Service
+	copySrc    CopySourceChecker
+	copyPrm    *copysvc.Prm // set if the object is copied
 	keyStorage *util.KeyStorage
 	saveChunks bool
 	init       *object.PutRequest
@@ -26,6 +34,11 @@ type streamer struct {
 	*sizes // only for relay streams
 }
 
+var (
+	errCopyDisabled = errors.New("object copying is disabled")
+	errCopyPayload  = errors.New("payload must not be sent with the copied object")
+)
+
 type sizes struct {
 	payloadSz uint64 // value from the header
 
@@ -35,6 +48,17 @@ type sizes struct {
 func (s *streamer) Send(req *object.PutRequest) (err error) {
 	switch v := req.GetBody().GetObjectPart().(type) {
 	case *object.PutObjectPartInit:
+		s.copyPrm, err = toCopyPrm(v, req)
+		if err != nil || s.copyPrm != nil {
+			if s.copyPrm != nil && s.copySrc != nil {
+				s.copyPrm.WithSourceChecker(func(src *objectSDK.Object) error {
+					return s.copySrc.CheckCopySource(req, src)
+				})
+			}
+
+			return err
+		}
+
 		var initPrm *putsvc.PutInitPrm
 
 		initPrm, err = s.toInitPrm(v, req)
@@ -62,6 +86,10 @@ func (s *streamer) Send(req *object.PutRequest) (err error) {
 			s.init = req
 		}
 	case *object.PutObjectPartChunk:
+		if s.copyPrm != nil {
+			return errCopyPayload
+		}
+
 		if s.saveChunks {
 			s.writtenPayload += uint64(len(v.GetChunk()))
 
@@ -104,6 +132,10 @@ func (s *streamer) Send(req *object.PutRequest) (err error) {
 }
 
 func (s *streamer) CloseAndRecv() (*object.PutResponse, error) {
+	if s.copyPrm != nil {
+		return s.copyObject()
+	}
+
 	if s.saveChunks {
 		// check payload size correctness
 		if s.writtenPayload != s.payloadSz {
@@ -119,6 +151,19 @@ func (s *streamer) CloseAndRecv() (*object.PutResponse, error) {
 	return fromPutResponse(resp), nil
 }
 
+func (s *streamer) copyObject() (*object.PutResponse, error) {
+	if s.copySvc == nil {
+		return nil, errCopyDisabled
+	}
+
+	res, err := s.copySvc.Copy(s.ctx, *s.copyPrm)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not copy object: %w", s, err)
+	}
+
+	return putResponse(res.ObjectID()), nil
+}
+
 func (s *streamer) relayRequest(info client.NodeInfo, c client.MultiAddressClient) error {
 	// open stream
 	resp := new(object.PutResponse)
@@ -1,11 +1,17 @@
 package putsvc
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+
 	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	refsV2 "github.com/TrueCloudLab/frostfs-api-go/v2/refs"
+	copysvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/copy"
 	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 )
 
 func (s *streamer) toInitPrm(part *objectV2.PutObjectPartInit, req *objectV2.PutRequest) (*putsvc.PutInitPrm, error) {
@@ -19,12 +25,92 @@ func (s *streamer) toInitPrm(part *objectV2.PutObjectPartInit, req *objectV2.Put
 		return nil, err
 	}
 
-	return new(putsvc.PutInitPrm).
+	prm := new(putsvc.PutInitPrm).
 		WithObject(
 			object.NewFromV2(oV2),
 		).
 		WithRelay(s.relayRequest).
-		WithCommonPrm(commonPrm), nil
+		WithCommonPrm(commonPrm)
+
+	if err := setUpload(prm, commonPrm.XHeaders()); err != nil {
+		return nil, err
+	}
+
+	return prm, nil
+}
+
+// toCopyPrm returns the parameters of the object copying requested by the
+// X-headers, nil if the object is not copied. Copying is ignored for the
+// objects signed by the client since X-headers are forwarded with the
+// split object parts.
+func toCopyPrm(part *objectV2.PutObjectPartInit, req *objectV2.PutRequest) (*copysvc.Prm, error) {
+	if part.GetSignature() != nil {
+		return nil, nil
+	}
+
+	commonPrm, err := util.CommonPrmFromV2(req)
+	if err != nil {
+		return nil, err
+	}
+
+	src, rewrite, err := copysvc.ParseXHeaders(commonPrm.XHeaders())
+	if err != nil || src == nil {
+		return nil, err
+	}
+
+	oV2 := new(objectV2.Object)
+	oV2.SetHeader(part.GetHeader())
+
+	hdr := object.NewFromV2(oV2)
+
+	cnr, ok := hdr.ContainerID()
+	if !ok {
+		return nil, errors.New("missing container ID")
+	}
+
+	prm := new(copysvc.Prm)
+	prm.SetCommonParameters(commonPrm)
+	prm.WithSource(*src)
+	prm.WithContainerID(cnr)
+	prm.WithAttributes(hdr.Attributes(), rewrite)
+
+	return prm, nil
+}
+
+// maxUploadIDLength is the maximum length of the resumable upload identifier.
+const maxUploadIDLength = 128
+
+// setUpload sets the resumable upload parameters from the request X-headers.
+// X-headers are kept in the common parameters to be forwarded further.
+func setUpload(p *putsvc.PutInitPrm, xhdrs []string) error {
+	var (
+		id  string
+		off uint64
+		err error
+	)
+
+	for i := 0; i+1 < len(xhdrs); i += 2 {
+		switch xhdrs[i] {
+		case putsvc.XHeaderUploadID:
+			id = xhdrs[i+1]
+			if len(id) > maxUploadIDLength {
+				return fmt.Errorf("upload ID is longer than %d bytes", maxUploadIDLength)
+			}
+		case putsvc.XHeaderUploadOffset:
+			off, err = strconv.ParseUint(xhdrs[i+1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid upload offset: %w", err)
+			}
+		}
+	}
+
+	if id == "" && off != 0 {
+		return errors.New("upload offset without upload ID")
+	}
+
+	p.WithUpload(id, off)
+
+	return nil
 }
 
 func toChunkPrm(req *objectV2.PutObjectPartChunk) *putsvc.PutChunkPrm {
@@ -33,8 +119,12 @@ func toChunkPrm(req *objectV2.PutObjectPartChunk) *putsvc.PutChunkPrm {
 }
 
 func fromPutResponse(r *putsvc.PutResponse) *objectV2.PutResponse {
+	return putResponse(r.ObjectID())
+}
+
+func putResponse(id oid.ID) *objectV2.PutResponse {
 	var idV2 refsV2.ObjectID
-	r.ObjectID().WriteToV2(&idV2)
+	id.WriteToV2(&idV2)
 
 	body := new(objectV2.PutResponseBody)
 	body.SetObjectID(&idV2)
@@ -28,6 +28,9 @@ type execCtx struct {
 	log *logger.Logger
 
 	curProcEpoch uint64
+
+	// page collects the found objects if the limit is set
+	page *pageWriter
 }
 
 const (
@@ -36,6 +39,11 @@ const (
 )
 
 func (exec *execCtx) prepare() {
+	if exec.prm.limit > 0 {
+		exec.page = newPageWriter(exec.prm.limit, exec.prm.cursor)
+		return
+	}
+
 	if _, ok := exec.prm.writer.(*uniqueIDWriter); !ok {
 		exec.prm.writer = newUniqueAddressWriter(exec.prm.writer)
 	}
@@ -118,7 +126,12 @@ func (exec *execCtx) generateTraverser(cnr cid.ID) (*placement.Traverser, bool)
 }
 
 func (exec *execCtx) writeIDList(ids []oid.ID) {
-	err := exec.prm.writer.WriteIDs(ids)
+	var err error
+	if exec.page != nil {
+		err = exec.page.WriteIDs(ids)
+	} else {
+		err = exec.prm.writer.WriteIDs(ids)
+	}
 
 	switch {
 	default:
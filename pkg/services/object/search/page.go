@@ -0,0 +1,105 @@
+package searchsvc
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+const (
+	// XHeaderLimit is the request X-header with the maximum number of
+	// objects to return. If it is set, the objects are returned in a single
+	// page, so the search can be continued with XHeaderCursor.
+	XHeaderLimit = session.ReservedXHeaderPrefix + "SEARCH_LIMIT"
+	// XHeaderCursor is the request X-header with the cursor returned by NextCursor
+	// for the previous page. Clients must treat the cursor as an opaque string.
+	XHeaderCursor = session.ReservedXHeaderPrefix + "SEARCH_CURSOR"
+)
+
+// NextCursor returns the cursor to request the page following the found
+// objects with XHeaderCursor. Empty cursor means that the search is complete.
+func NextCursor(ids []oid.ID, limit int) string {
+	if limit <= 0 || len(ids) < limit {
+		return ""
+	}
+	return ids[len(ids)-1].EncodeToString()
+}
+
+// ParseCursor decodes the cursor returned by NextCursor.
+func ParseCursor(cursor string) (oid.ID, error) {
+	var id oid.ID
+	return id, id.DecodeString(cursor)
+}
+
+func lessID(a, b oid.ID) bool {
+	return bytes.Compare(a[:], b[:]) < 0
+}
+
+// pageWriter collects the first objects after the cursor in the order of IDs.
+// Every node returns its first objects, so the first objects of the merged
+// list are the first objects in the container.
+type pageWriter struct {
+	mtx sync.Mutex
+
+	limit  int
+	cursor *oid.ID
+
+	ids []oid.ID
+}
+
+func newPageWriter(limit int, cursor *oid.ID) *pageWriter {
+	return &pageWriter{
+		limit:  limit,
+		cursor: cursor,
+	}
+}
+
+func (w *pageWriter) WriteIDs(list []oid.ID) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	for i := range list {
+		if w.cursor == nil || lessID(*w.cursor, list[i]) {
+			w.ids = append(w.ids, list[i])
+		}
+	}
+
+	// nodes not supporting the pagination return all the objects
+	if len(w.ids) > 2*w.limit {
+		w.trim()
+	}
+
+	return nil
+}
+
+// trim leaves the first unique objects.
+func (w *pageWriter) trim() {
+	sort.Slice(w.ids, func(i, j int) bool {
+		return lessID(w.ids[i], w.ids[j])
+	})
+
+	res := w.ids[:0]
+	for i := range w.ids {
+		if len(res) != 0 && res[len(res)-1] == w.ids[i] {
+			continue
+		}
+		res = append(res, w.ids[i])
+
+		if len(res) == w.limit {
+			break
+		}
+	}
+	w.ids = res
+}
+
+// flush writes the page to the writer.
+func (w *pageWriter) flush(to IDListWriter) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	w.trim()
+	return to.WriteIDs(w.ids)
+}
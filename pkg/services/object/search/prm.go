@@ -18,6 +18,9 @@ type Prm struct {
 
 	filters object.SearchFilters
 
+	limit  int
+	cursor *oid.ID
+
 	forwarder RequestForwarder
 }
 
@@ -56,3 +59,15 @@ func (p *Prm) WithContainerID(id cid.ID) {
 func (p *Prm) WithSearchFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
+
+// SetLimit sets the maximum number of the objects to find. If the limit is set,
+// the objects are written once in the ascending order of their IDs.
+func (p *Prm) SetLimit(limit int) {
+	p.limit = limit
+}
+
+// SetCursor sets the ID of the last object of the previous page, only the
+// objects with greater IDs are found. It is applied only if the limit is set.
+func (p *Prm) SetCursor(id oid.ID) {
+	p.cursor = &id
+}
@@ -3,11 +3,21 @@ package searchsvc
 import (
 	"context"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // Search serves a request to select the objects.
 func (s *Service) Search(ctx context.Context, prm Prm) error {
+	ctx, span := tracing.StartSpanFromContext(ctx, "searchService.Search",
+		trace.WithAttributes(
+			attribute.String("container_id", prm.cnr.EncodeToString()),
+			attribute.Bool("local", prm.common.LocalOnly()),
+		))
+	defer span.End()
+
 	exec := &execCtx{
 		svc: s,
 		ctx: ctx,
@@ -20,6 +30,10 @@ func (s *Service) Search(ctx context.Context, prm Prm) error {
 
 	exec.execute()
 
+	if exec.page != nil && exec.statusError.err == nil {
+		return exec.page.flush(prm.writer)
+	}
+
 	return exec.statusError.err
 }
 
@@ -1,25 +1,38 @@
 package searchsvc
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"testing"
 
 	clientcore "github.com/TrueCloudLab/frostfs-node/pkg/core/client"
 	netmapcore "github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/network"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger/test"
+	checksumtest "github.com/TrueCloudLab/frostfs-sdk-go/checksum/test"
 	"github.com/TrueCloudLab/frostfs-sdk-go/container"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
 	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	usertest "github.com/TrueCloudLab/frostfs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -314,6 +327,68 @@ func TestGetRemoteSmall(t *testing.T) {
 			require.Contains(t, w.ids, id)
 		}
 	})
+	t.Run("paginated", func(t *testing.T) {
+		var addr oid.Address
+		addr.SetContainer(id)
+
+		ns, as := testNodeMatrix(t, placementDim)
+
+		builder := &testPlacementBuilder{
+			vectors: map[string][][]netmap.NodeInfo{
+				addr.EncodeToString(): ns,
+			},
+		}
+
+		// nodes return all the objects in any order, some objects are on both nodes
+		ids := generateIDs(10)
+
+		c1 := newTestStorage()
+		c1.addResult(id, append([]oid.ID{ids[9]}, ids[:6]...), nil)
+
+		c2 := newTestStorage()
+		c2.addResult(id, ids[4:], nil)
+
+		svc := newSvc(builder, &testClientCache{
+			clients: map[string]*testStorage{
+				as[0][0]: c1,
+				as[0][1]: c2,
+			},
+		})
+
+		expected := append([]oid.ID(nil), ids...)
+		sort.Slice(expected, func(i, j int) bool {
+			return bytes.Compare(expected[i][:], expected[j][:]) < 0
+		})
+
+		const limit = 3
+
+		var (
+			res    []oid.ID
+			cursor string
+		)
+		for {
+			w := new(simpleIDWriter)
+
+			p := newPrm(id, w)
+			p.SetLimit(limit)
+			if cursor != "" {
+				c, err := ParseCursor(cursor)
+				require.NoError(t, err)
+				p.SetCursor(c)
+			}
+
+			require.NoError(t, svc.Search(ctx, p))
+			require.LessOrEqual(t, len(w.ids), limit)
+
+			res = append(res, w.ids...)
+
+			if cursor = NextCursor(w.ids, limit); cursor == "" {
+				break
+			}
+		}
+
+		require.Equal(t, expected, res)
+	})
 }
 
 func TestGetFromPastEpoch(t *testing.T) {
@@ -425,3 +500,152 @@ func TestGetFromPastEpoch(t *testing.T) {
 	require.NoError(t, err)
 	assertContains(ids11, ids12, ids21, ids22)
 }
+
+type testIndexSource map[cid.ID][]meta.AttributeIndex
+
+func (s testIndexSource) AttributeIndexes(cnr cid.ID) ([]meta.AttributeIndex, error) {
+	return s[cnr], nil
+}
+
+type testEpochState struct{}
+
+func (testEpochState) CurrentEpoch() uint64 { return 0 }
+
+func TestSearchIndexed(t *testing.T) {
+	dir := t.TempDir()
+	cnr := cidtest.ID()
+
+	e := engine.New()
+	_, err := e.AddShard(
+		shard.WithBlobStorOptions(blobstor.WithStorages([]blobstor.SubStorage{
+			{Storage: fstree.New(fstree.WithPath(filepath.Join(dir, "blob")))},
+		})),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(dir, "meta")),
+			meta.WithEpochState(testEpochState{}),
+			meta.WithIndexSource(testIndexSource{cnr: {
+				{Attribute: "FilePath", Type: meta.IndexString},
+				{Attribute: "Size", Type: meta.IndexNumeric},
+			}})),
+		shard.WithPiloramaOptions(pilorama.WithPath(filepath.Join(dir, "pilorama"))))
+	require.NoError(t, err)
+	require.NoError(t, e.Open())
+	require.NoError(t, e.Init())
+	t.Cleanup(func() { _ = e.Close() })
+
+	paths := []string{"a/1", "a/2", "a/3", "b/1", "ab"}
+	sizes := []string{"7", "07", "8", "7", "9"}
+	ids := make([]oid.ID, len(paths))
+	for i := range paths {
+		var path, size objectSDK.Attribute
+		path.SetKey("FilePath")
+		path.SetValue(paths[i])
+		size.SetKey("Size")
+		size.SetValue(sizes[i])
+
+		obj := objectSDK.New()
+		obj.SetContainerID(cnr)
+		obj.SetID(oidtest.ID())
+		obj.SetOwnerID(usertest.ID())
+		obj.SetPayloadChecksum(checksumtest.Checksum())
+		obj.SetAttributes(path, size)
+		require.NoError(t, engine.Put(e, obj))
+
+		ids[i], _ = obj.ID()
+	}
+
+	storage := &storageEngineWrapper{storage: e}
+
+	search := func(t *testing.T, fs objectSDK.SearchFilters, limit int, cursor *oid.ID) ([]oid.ID, bool) {
+		var prm Prm
+		prm.WithContainerID(cnr)
+		prm.WithSearchFilters(fs)
+		prm.SetLimit(limit)
+		if cursor != nil {
+			prm.SetCursor(*cursor)
+		}
+
+		exec := &execCtx{ctx: context.Background(), prm: prm}
+		res, ok, err := storage.searchIndexed(exec)
+		require.NoError(t, err)
+
+		if ok {
+			regular, err := storage.search(exec)
+			require.NoError(t, err)
+			require.ElementsMatch(t, regular, res)
+		}
+		return res, ok
+	}
+
+	expected := func(idx ...int) []oid.ID {
+		res := make([]oid.ID, 0, len(idx))
+		for _, i := range idx {
+			res = append(res, ids[i])
+		}
+		sort.Slice(res, func(i, j int) bool { return lessID(res[i], res[j]) })
+		return res
+	}
+
+	t.Run("prefix", func(t *testing.T) {
+		var fs objectSDK.SearchFilters
+		fs.AddFilter("FilePath", "a/", objectSDK.MatchCommonPrefix)
+
+		res, ok := search(t, fs, 0, nil)
+		require.True(t, ok)
+		require.ElementsMatch(t, expected(0, 1, 2), res)
+	})
+	t.Run("equal with other filters", func(t *testing.T) {
+		var fs objectSDK.SearchFilters
+		fs.AddFilter("Size", "7", objectSDK.MatchStringEqual)
+		fs.AddFilter("FilePath", "b/", objectSDK.MatchCommonPrefix)
+
+		res, ok := search(t, fs, 0, nil)
+		require.True(t, ok)
+		require.Equal(t, expected(3), res)
+	})
+	t.Run("numeric values are compared as strings", func(t *testing.T) {
+		var fs objectSDK.SearchFilters
+		fs.AddFilter("Size", "7", objectSDK.MatchStringEqual)
+
+		res, ok := search(t, fs, 0, nil)
+		require.True(t, ok)
+		require.ElementsMatch(t, expected(0, 3), res)
+	})
+	t.Run("page", func(t *testing.T) {
+		var fs objectSDK.SearchFilters
+		fs.AddFilter("Size", "7", objectSDK.MatchStringEqual)
+
+		all := expected(0, 3)
+
+		res, ok := search(t, fs, 1, nil)
+		require.True(t, ok)
+		require.Equal(t, all[:1], res)
+
+		res, ok = search(t, fs, 1, &res[0])
+		require.True(t, ok)
+		require.Equal(t, all[1:], res)
+
+		res, ok = search(t, fs, 1, &res[0])
+		require.True(t, ok)
+		require.Empty(t, res)
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter("FilePath", "a", objectSDK.MatchCommonPrefix)
+
+		_, ok = search(t, fs, 2, nil)
+		require.False(t, ok, "objects selected by prefix are not ordered by IDs")
+	})
+	t.Run("fallback", func(t *testing.T) {
+		var fs objectSDK.SearchFilters
+		fs.AddFilter("Size", "1", objectSDK.MatchCommonPrefix)
+
+		_, ok := search(t, fs, 0, nil)
+		require.False(t, ok, "prefix is not supported by numeric index")
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter("Other", "1", objectSDK.MatchStringEqual)
+
+		_, ok = search(t, fs, 0, nil)
+		require.False(t, ok, "attribute is not indexed")
+	})
+}
@@ -1,15 +1,21 @@
 package searchsvc
 
 import (
+	"errors"
+	"sort"
+	"strings"
 	"sync"
 
+	v2object "github.com/TrueCloudLab/frostfs-api-go/v2/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
 	internalclient "github.com/TrueCloudLab/frostfs-node/pkg/services/object/internal/client"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 )
 
@@ -118,11 +124,20 @@ func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oi
 }
 
 func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, error) {
+	ids, ok, err := e.searchIndexed(exec)
+	if ok || err != nil {
+		return ids, err
+	}
+
 	var selectPrm engine.SelectPrm
 	selectPrm.WithFilters(exec.searchFilters())
 	selectPrm.WithContainerID(exec.containerID())
+	selectPrm.WithLimit(exec.prm.limit)
+	if exec.prm.cursor != nil {
+		selectPrm.WithStartAfter(*exec.prm.cursor)
+	}
 
-	r, err := e.storage.Select(selectPrm)
+	r, err := e.storage.Select(exec.context(), selectPrm)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +145,128 @@ func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, error) {
 	return idsFromAddresses(r.AddressList()), nil
 }
 
+// searchIndexed selects the objects using the sorted attribute index if the
+// container has it for the attribute of any equality or prefix filter.
+// Returns false if the index can't be used.
+func (e *storageEngineWrapper) searchIndexed(exec *execCtx) ([]oid.ID, bool, error) {
+	paged := exec.prm.limit > 0
+
+	fs := exec.searchFilters()
+	for i := range fs {
+		if !indexableFilter(fs[i], paged) {
+			continue
+		}
+
+		var prm engine.SelectIndexedPrm
+		prm.WithContainerID(exec.containerID())
+		prm.WithAttribute(fs[i].Header())
+		if fs[i].Operation() == object.MatchCommonPrefix {
+			prm.WithPrefix(fs[i].Value())
+		} else {
+			prm.WithLowerBound(fs[i].Value(), true)
+			prm.WithUpperBound(fs[i].Value(), true)
+		}
+
+		rest := make(object.SearchFilters, 0, len(fs)-1)
+		rest = append(rest, fs[:i]...)
+		prm.WithFilters(append(rest, fs[i+1:]...))
+
+		var (
+			ids []oid.ID
+			err error
+		)
+		if paged {
+			ids, err = e.selectIndexedPage(exec, prm, fs[i])
+		} else {
+			var res engine.SelectIndexedRes
+			res, err = e.storage.SelectIndexed(exec.context(), prm)
+			ids = indexedPage(res.Objects(), fs[i], 0)
+		}
+		if err != nil {
+			if errors.Is(err, meta.ErrAttributeNotIndexed) || errors.Is(err, meta.ErrInvalidIndexQuery) {
+				continue
+			}
+			return nil, false, err
+		}
+
+		return ids, true, nil
+	}
+	return nil, false, nil
+}
+
+// selectIndexedPage selects the first objects after the cursor matching the
+// equality filter, in the order of IDs. The objects of a single value are
+// ordered by IDs in the index, so they are selected page by page until the
+// requested page is full.
+func (e *storageEngineWrapper) selectIndexedPage(exec *execCtx, prm engine.SelectIndexedPrm, f object.SearchFilter) ([]oid.ID, error) {
+	limit := exec.prm.limit
+	cursor := exec.prm.cursor
+
+	prm.WithLimit(limit)
+
+	var ids []oid.ID
+	for {
+		if cursor != nil {
+			prm.WithStartAfter(*cursor)
+		}
+
+		res, err := e.storage.SelectIndexed(exec.context(), prm)
+		if err != nil {
+			return nil, err
+		}
+
+		objs := res.Objects()
+		ids = append(ids, indexedPage(objs, f, limit-len(ids))...)
+		if len(ids) >= limit || len(objs) < limit {
+			return ids, nil
+		}
+
+		last := objs[len(objs)-1].Address().Object()
+		cursor = &last
+	}
+}
+
+// indexableFilter returns true if the filter can be served by the sorted
+// attribute index. The objects selected by the prefix are ordered by the
+// attribute values, so the paged search by prefix can't use the index.
+func indexableFilter(f object.SearchFilter, paged bool) bool {
+	if strings.HasPrefix(f.Header(), v2object.ReservedFilterPrefix) {
+		return false
+	}
+
+	switch f.Operation() {
+	case object.MatchStringEqual:
+		return true
+	case object.MatchCommonPrefix:
+		return !paged
+	default:
+		return false
+	}
+}
+
+// indexedPage returns the IDs of the objects selected by the index which match
+// the filter exactly, in the order of IDs, limited like the regular search.
+// Numeric indexes match the values by number, e.g. "7" and "07", so the
+// original values are compared.
+func indexedPage(objs []meta.IndexedObject, f object.SearchFilter, limit int) []oid.ID {
+	ids := make([]oid.ID, 0, len(objs))
+	for i := range objs {
+		v := objs[i].Value()
+		if f.Operation() == object.MatchCommonPrefix && !strings.HasPrefix(v, f.Value()) ||
+			f.Operation() == object.MatchStringEqual && v != f.Value() {
+			continue
+		}
+
+		ids = append(ids, objs[i].Address().Object())
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return lessID(ids[i], ids[j]) })
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids
+}
+
 func idsFromAddresses(addrs []oid.Address) []oid.ID {
 	ids := make([]oid.ID, len(addrs))
 
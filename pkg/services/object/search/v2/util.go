@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"sync"
 
 	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
@@ -136,9 +137,37 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 	p.WithContainerID(id)
 	p.WithSearchFilters(object.NewSearchFiltersFromV2(body.GetFilters()))
 
+	if err := setPage(p, commonPrm.XHeaders()); err != nil {
+		return nil, err
+	}
+
 	return p, nil
 }
 
+// setPage sets the search limit and cursor from the request X-headers.
+// X-headers are kept in the common parameters to be forwarded further.
+func setPage(p *searchsvc.Prm, xhdrs []string) error {
+	for i := 0; i+1 < len(xhdrs); i += 2 {
+		switch xhdrs[i] {
+		case searchsvc.XHeaderLimit:
+			limit, err := strconv.ParseUint(xhdrs[i+1], 10, 31)
+			if err != nil {
+				return fmt.Errorf("invalid search limit: %w", err)
+			}
+
+			p.SetLimit(int(limit))
+		case searchsvc.XHeaderCursor:
+			id, err := searchsvc.ParseCursor(xhdrs[i+1])
+			if err != nil {
+				return fmt.Errorf("invalid search cursor: %w", err)
+			}
+
+			p.SetCursor(id)
+		}
+	}
+	return nil
+}
+
 func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressClient, []byte) ([]oid.ID, error)) searchsvc.RequestForwarder {
 	return func(info client.NodeInfo, c client.MultiAddressClient) ([]oid.ID, error) {
 		var (
@@ -0,0 +1,284 @@
+package transformer
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/TrueCloudLab/tzhash/tz"
+)
+
+// Checkpoint is a state of the split object writing after the released
+// child object. It allows to continue the writing of the object payload
+// from the Written offset.
+type Checkpoint struct {
+	// Written is the payload size of the released children.
+	Written uint64
+
+	// SplitID is the split ID of the object.
+	SplitID *object.SplitID
+
+	// Parent is the parent object header without the payload checksums.
+	Parent *object.Object
+
+	// Children are the identifiers of the released children.
+	Children []oid.ID
+
+	// PayloadHashState is the binary state of the parent payload
+	// SHA-256 hasher.
+	PayloadHashState []byte
+
+	// HomomorphicHash is the homomorphic hash of the released children
+	// payload, empty if the homomorphic hashing is disabled.
+	HomomorphicHash []byte
+}
+
+// NewResumablePayloadSizeLimiter returns ObjectTarget instance like NewPayloadSizeLimiter
+// that passes the state of the writing to onCheckpoint after each released
+// child object. If cp is not nil, the writing is continued from the checkpoint:
+// the header passed to WriteHeader is ignored and the payload is expected to
+// be written from the cp.Written offset.
+func NewResumablePayloadSizeLimiter(maxSize uint64, withoutHomomorphicHash bool, targetInit TargetInitializer,
+	cp *Checkpoint, onCheckpoint func(Checkpoint) error) ObjectTarget {
+	s := NewPayloadSizeLimiter(maxSize, withoutHomomorphicHash, targetInit).(*payloadSizeLimiter)
+	s.onCheckpoint = onCheckpoint
+
+	if cp == nil {
+		return s
+	}
+
+	return &resumedTarget{
+		limiter: s,
+		cp:      cp,
+	}
+}
+
+// resumedTarget restores the state of the limiter on WriteHeader.
+type resumedTarget struct {
+	limiter *payloadSizeLimiter
+
+	cp *Checkpoint
+}
+
+func (t *resumedTarget) WriteHeader(*object.Object) error {
+	return t.limiter.restore(t.cp)
+}
+
+func (t *resumedTarget) Write(p []byte) (int, error) {
+	return t.limiter.Write(p)
+}
+
+func (t *resumedTarget) Close() (*AccessIdentifiers, error) {
+	return t.limiter.Close()
+}
+
+func (s *payloadSizeLimiter) restore(cp *Checkpoint) error {
+	if len(cp.Children) == 0 || cp.Written == 0 || cp.Written%s.maxSize != 0 {
+		return errors.New("invalid checkpoint: payload size is not aligned with the released children")
+	}
+
+	s.written = cp.Written
+	s.resumedAt = cp.Written
+	s.splitID = cp.SplitID
+	s.parent = cp.Parent
+	s.previous = cp.Children
+	s.parentTZ = cp.HomomorphicHash
+
+	s.parentHashers = payloadHashersForObject(s.parent, s.withoutHomomorphicHash)
+
+	err := s.parentHashers[0].hasher.(encoding.BinaryUnmarshaler).UnmarshalBinary(cp.PayloadHashState)
+	if err != nil {
+		return fmt.Errorf("invalid checkpoint payload hash state: %w", err)
+	}
+
+	if !s.withoutHomomorphicHash {
+		if len(cp.HomomorphicHash) != tz.Size {
+			return errors.New("invalid checkpoint homomorphic hash")
+		}
+
+		// the homomorphic hash of the rest payload is appended to the hash of the released children
+		h := s.parentHashers[1]
+		writeHash := h.checksumWriter
+		h.checksumWriter = func(sum []byte) error {
+			full, err := tz.Concat([][]byte{cp.HomomorphicHash, sum})
+			if err != nil {
+				return fmt.Errorf("could not concatenate homomorphic hashes: %w", err)
+			}
+
+			return writeHash(full)
+		}
+	}
+
+	s.current = fromObject(s.parent)
+	s.current.SetAttributes()
+	s.current.SetSplitID(s.splitID)
+	s.current.SetPreviousID(s.previous[len(s.previous)-1])
+
+	s.initializeCurrent()
+
+	return nil
+}
+
+// updateParentTZ appends the homomorphic hash of the released child
+// to the homomorphic hash of the parent payload.
+func (s *payloadSizeLimiter) updateParentTZ() error {
+	if s.withoutHomomorphicHash {
+		return nil
+	}
+
+	cs, ok := s.current.PayloadHomomorphicHash()
+	if !ok {
+		return errors.New("missing homomorphic hash of the released object")
+	}
+
+	if s.parentTZ == nil {
+		s.parentTZ = cs.Value()
+		return nil
+	}
+
+	h, err := tz.Concat([][]byte{s.parentTZ, cs.Value()})
+	if err != nil {
+		return fmt.Errorf("could not concatenate homomorphic hashes: %w", err)
+	}
+
+	s.parentTZ = h
+
+	return nil
+}
+
+func (s *payloadSizeLimiter) saveCheckpoint() error {
+	state, err := s.parentHashers[0].hasher.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("could not marshal payload hash state: %w", err)
+	}
+
+	err = s.onCheckpoint(Checkpoint{
+		Written:          s.written,
+		SplitID:          s.splitID,
+		Parent:           s.parent,
+		Children:         s.previous,
+		PayloadHashState: state,
+		HomomorphicHash:  s.parentTZ,
+	})
+	if err != nil {
+		return fmt.Errorf("could not save checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+// Marshal encodes the checkpoint into a binary format.
+func (cp Checkpoint) Marshal() ([]byte, error) {
+	parent, err := cp.Parent.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal parent header: %w", err)
+	}
+
+	buf := appendUvarint(nil, cp.Written)
+	buf = appendBytes(buf, cp.SplitID.ToV2())
+	buf = appendBytes(buf, parent)
+	buf = appendBytes(buf, cp.PayloadHashState)
+	buf = appendBytes(buf, cp.HomomorphicHash)
+	buf = appendUvarint(buf, uint64(len(cp.Children)))
+
+	var id [sha256Size]byte
+
+	for i := range cp.Children {
+		cp.Children[i].Encode(id[:])
+		buf = append(buf, id[:]...)
+	}
+
+	return buf, nil
+}
+
+// Unmarshal decodes the checkpoint from the binary format.
+func (cp *Checkpoint) Unmarshal(data []byte) error {
+	r := checkpointReader{data: data}
+
+	cp.Written = r.uvarint()
+	cp.SplitID = object.NewSplitIDFromV2(r.bytes())
+	parent := r.bytes()
+	cp.PayloadHashState = r.bytes()
+	cp.HomomorphicHash = r.bytes()
+	n := r.uvarint()
+
+	if r.err != nil {
+		return r.err
+	}
+
+	if cp.SplitID == nil {
+		return errors.New("invalid split ID")
+	}
+
+	cp.Parent = object.New()
+	if err := cp.Parent.Unmarshal(parent); err != nil {
+		return fmt.Errorf("could not unmarshal parent header: %w", err)
+	}
+
+	if uint64(len(r.data)) != n*sha256Size {
+		return errors.New("invalid children list")
+	}
+
+	cp.Children = make([]oid.ID, n)
+	for i := range cp.Children {
+		if err := cp.Children[i].Decode(r.data[i*sha256Size : (i+1)*sha256Size]); err != nil {
+			return fmt.Errorf("invalid child ID: %w", err)
+		}
+	}
+
+	return nil
+}
+
+const sha256Size = 32
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	return append(buf, tmp[:binary.PutUvarint(tmp[:], v)]...)
+}
+
+func appendBytes(buf []byte, v []byte) []byte {
+	return append(appendUvarint(buf, uint64(len(v))), v...)
+}
+
+var errInvalidCheckpoint = errors.New("invalid checkpoint encoding")
+
+type checkpointReader struct {
+	data []byte
+	err  error
+}
+
+func (r *checkpointReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = errInvalidCheckpoint
+		return 0
+	}
+
+	r.data = r.data[n:]
+
+	return v
+}
+
+func (r *checkpointReader) bytes() []byte {
+	ln := r.uvarint()
+	if r.err != nil {
+		return nil
+	}
+
+	if uint64(len(r.data)) < ln {
+		r.err = errInvalidCheckpoint
+		return nil
+	}
+
+	v := r.data[:ln:ln]
+	r.data = r.data[ln:]
+
+	return v
+}
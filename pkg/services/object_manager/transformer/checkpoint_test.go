@@ -0,0 +1,235 @@
+package transformer
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	usertest "github.com/TrueCloudLab/frostfs-sdk-go/user/test"
+	"github.com/TrueCloudLab/tzhash/tz"
+	"github.com/stretchr/testify/require"
+)
+
+// memTarget stores the written objects in memory.
+type memTarget struct {
+	objs *[]*object.Object
+
+	hdr     *object.Object
+	payload []byte
+}
+
+func (t *memTarget) WriteHeader(hdr *object.Object) error {
+	t.hdr = hdr
+	return nil
+}
+
+func (t *memTarget) Write(p []byte) (int, error) {
+	t.payload = append(t.payload, p...)
+	return len(p), nil
+}
+
+func (t *memTarget) Close() (*AccessIdentifiers, error) {
+	t.hdr.SetPayload(t.payload)
+	t.hdr.SetPayloadSize(uint64(len(t.payload)))
+
+	if err := object.CalculateAndSetID(t.hdr); err != nil {
+		return nil, err
+	}
+
+	// the header is reused by the limiter, so it is copied
+	data, err := t.hdr.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	obj := object.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return nil, err
+	}
+
+	id, _ := obj.ID()
+
+	*t.objs = append(*t.objs, obj)
+
+	ids := new(AccessIdentifiers).WithSelfID(id)
+
+	if par := t.hdr.Parent(); par != nil {
+		var parID oid.ID
+		parID.SetSHA256(sha256.Sum256(par.Payload()))
+
+		ids = ids.WithParentID(&parID).WithParent(par)
+	}
+
+	return ids, nil
+}
+
+var errInterrupted = errors.New("interrupted")
+
+func TestResumablePayloadSizeLimiter(t *testing.T) {
+	const (
+		maxSize  = 64
+		fullSize = 5*maxSize + 10
+	)
+
+	payload := make([]byte, fullSize)
+	_, _ = rand.Read(payload)
+
+	hdr := object.New()
+	hdr.SetContainerID(cidtest.ID())
+	hdr.SetOwnerID(usertest.ID())
+
+	var a object.Attribute
+	a.SetKey("key")
+	a.SetValue("value")
+	hdr.SetAttributes(a)
+
+	for _, withoutHomomorphicHash := range []bool{false, true} {
+		var (
+			objs []*object.Object
+			cps  []Checkpoint
+		)
+
+		targetInit := func() ObjectTarget {
+			return &memTarget{objs: &objs}
+		}
+
+		// the writing is interrupted on the second checkpoint
+		w := NewResumablePayloadSizeLimiter(maxSize, withoutHomomorphicHash, targetInit, nil, func(cp Checkpoint) error {
+			data, err := cp.Marshal()
+			require.NoError(t, err)
+
+			var res Checkpoint
+			require.NoError(t, res.Unmarshal(data))
+
+			cps = append(cps, res)
+			if len(cps) == 2 {
+				return errInterrupted
+			}
+			return nil
+		})
+
+		require.NoError(t, w.WriteHeader(hdr))
+
+		for off := 0; off < fullSize; off += 10 {
+			if _, err := w.Write(payload[off : off+10]); err != nil {
+				require.ErrorIs(t, err, errInterrupted)
+				break
+			}
+		}
+
+		cp := cps[len(cps)-1]
+		require.EqualValues(t, 2*maxSize, cp.Written)
+		require.Len(t, cp.Children, 2)
+
+		w = NewResumablePayloadSizeLimiter(maxSize, withoutHomomorphicHash, targetInit, &cp, func(Checkpoint) error {
+			return nil
+		})
+
+		require.NoError(t, w.WriteHeader(object.New()))
+
+		_, err := w.Write(payload[cp.Written:])
+		require.NoError(t, err)
+
+		ids, err := w.Close()
+		require.NoError(t, err)
+
+		par := ids.Parent()
+		require.NotNil(t, par)
+		require.EqualValues(t, fullSize, par.PayloadSize())
+		require.Equal(t, hdr.Attributes(), par.Attributes())
+
+		cs, ok := par.PayloadChecksum()
+		require.True(t, ok)
+		sum := sha256.Sum256(payload)
+		require.Equal(t, sum[:], cs.Value())
+
+		cs, ok = par.PayloadHomomorphicHash()
+		require.Equal(t, !withoutHomomorphicHash, ok)
+		if ok {
+			exp := tz.Sum(payload)
+			require.Equal(t, exp[:], cs.Value())
+		}
+
+		// children and the linking object
+		require.Len(t, objs, 7)
+
+		link := objs[len(objs)-1]
+		children := link.Children()
+		require.Len(t, children, 6)
+
+		var restored []byte
+		for i, child := range objs[:len(objs)-1] {
+			id, _ := child.ID()
+			require.Equal(t, children[i], id)
+			require.Equal(t, cp.SplitID, child.SplitID())
+
+			if i > 0 {
+				prev, ok := child.PreviousID()
+				require.True(t, ok)
+				require.Equal(t, children[i-1], prev)
+			}
+
+			restored = append(restored, child.Payload()...)
+		}
+
+		require.Equal(t, payload, restored)
+	}
+}
+
+func TestResumablePayloadSizeLimiter_InvalidHomomorphicHash(t *testing.T) {
+	const maxSize = 64
+
+	payload := make([]byte, 2*maxSize+10)
+	_, _ = rand.Read(payload)
+
+	hdr := object.New()
+	hdr.SetContainerID(cidtest.ID())
+	hdr.SetOwnerID(usertest.ID())
+
+	var objs []*object.Object
+
+	targetInit := func() ObjectTarget {
+		return &memTarget{objs: &objs}
+	}
+
+	var cp *Checkpoint
+
+	w := NewResumablePayloadSizeLimiter(maxSize, false, targetInit, nil, func(c Checkpoint) error {
+		cp = &c
+		return errInterrupted
+	})
+
+	require.NoError(t, w.WriteHeader(hdr))
+
+	_, err := w.Write(payload)
+	require.ErrorIs(t, err, errInterrupted)
+	require.NotNil(t, cp)
+
+	// the hash has valid size but can't be concatenated
+	cp.HomomorphicHash = make([]byte, tz.Size)
+	for i := range cp.HomomorphicHash {
+		cp.HomomorphicHash[i] = 0xFF
+	}
+
+	w = NewResumablePayloadSizeLimiter(maxSize, false, targetInit, cp, func(Checkpoint) error {
+		return nil
+	})
+
+	require.NoError(t, w.WriteHeader(object.New()))
+
+	_, err = w.Write(payload[cp.Written : cp.Written+10])
+	require.NoError(t, err)
+
+	_, err = w.Close()
+	require.Error(t, err)
+}
+
+func TestCheckpoint_Unmarshal(t *testing.T) {
+	var cp Checkpoint
+	require.Error(t, cp.Unmarshal(nil))
+	require.Error(t, cp.Unmarshal([]byte{1, 2, 3}))
+}
@@ -32,12 +32,22 @@ type payloadSizeLimiter struct {
 	splitID *object.SplitID
 
 	parAttrs []object.Attribute
+
+	// resumedAt is the payload offset the writing has been resumed from.
+	resumedAt uint64
+
+	// onCheckpoint is called after each released child object if set.
+	onCheckpoint func(Checkpoint) error
+
+	// parentTZ is the homomorphic hash of the released children payload,
+	// it is calculated only if checkpoints are enabled.
+	parentTZ []byte
 }
 
 type payloadChecksumHasher struct {
 	hasher hash.Hash
 
-	checksumWriter func([]byte)
+	checksumWriter func([]byte) error
 }
 
 // NewPayloadSizeLimiter returns ObjectTarget instance that restricts payload length
@@ -137,7 +147,7 @@ func payloadHashersForObject(obj *object.Object, withoutHomomorphicHash bool) []
 
 	hashers = append(hashers, &payloadChecksumHasher{
 		hasher: sha256.New(),
-		checksumWriter: func(binChecksum []byte) {
+		checksumWriter: func(binChecksum []byte) error {
 			if ln := len(binChecksum); ln != sha256.Size {
 				panic(fmt.Sprintf("wrong checksum length: expected %d, has %d", sha256.Size, ln))
 			}
@@ -149,13 +159,15 @@ func payloadHashersForObject(obj *object.Object, withoutHomomorphicHash bool) []
 			cs.SetSHA256(csSHA)
 
 			obj.SetPayloadChecksum(cs)
+
+			return nil
 		},
 	})
 
 	if !withoutHomomorphicHash {
 		hashers = append(hashers, &payloadChecksumHasher{
 			hasher: tz.New(),
-			checksumWriter: func(binChecksum []byte) {
+			checksumWriter: func(binChecksum []byte) error {
 				if ln := len(binChecksum); ln != tz.Size {
 					panic(fmt.Sprintf("wrong checksum length: expected %d, has %d", tz.Size, ln))
 				}
@@ -167,6 +179,8 @@ func payloadHashersForObject(obj *object.Object, withoutHomomorphicHash bool) []
 				cs.SetTillichZemor(csTZ)
 
 				obj.SetPayloadHomomorphicHash(cs)
+
+				return nil
 			},
 		})
 	}
@@ -181,13 +195,17 @@ func (s *payloadSizeLimiter) release(finalize bool) (*AccessIdentifiers, error)
 	withParent := finalize && len(s.previous) > 0
 
 	if withParent {
-		writeHashes(s.parentHashers)
+		if err := writeHashes(s.parentHashers); err != nil {
+			return nil, fmt.Errorf("could not write parent checksums: %w", err)
+		}
 		s.parent.SetPayloadSize(s.written)
 		s.current.SetParent(s.parent)
 	}
 
 	// release current object
-	writeHashes(s.currentHashers)
+	if err := writeHashes(s.currentHashers); err != nil {
+		return nil, fmt.Errorf("could not write checksums: %w", err)
+	}
 
 	// release current, get its id
 	if err := s.target.WriteHeader(s.current); err != nil {
@@ -215,10 +233,14 @@ func (s *payloadSizeLimiter) release(finalize bool) (*AccessIdentifiers, error)
 	return ids, nil
 }
 
-func writeHashes(hashers []*payloadChecksumHasher) {
+func writeHashes(hashers []*payloadChecksumHasher) error {
 	for i := range hashers {
-		hashers[i].checksumWriter(hashers[i].hasher.Sum(nil))
+		if err := hashers[i].checksumWriter(hashers[i].hasher.Sum(nil)); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 func (s *payloadSizeLimiter) initializeLinking(parHdr *object.Object) {
@@ -230,7 +252,7 @@ func (s *payloadSizeLimiter) initializeLinking(parHdr *object.Object) {
 
 func (s *payloadSizeLimiter) writeChunk(chunk []byte) error {
 	// statement is true if the previous write of bytes reached exactly the boundary.
-	if s.written > 0 && s.written%s.maxSize == 0 {
+	if s.written > s.resumedAt && s.written%s.maxSize == 0 {
 		if s.written == s.maxSize {
 			s.prepareFirstChild()
 		}
@@ -240,8 +262,20 @@ func (s *payloadSizeLimiter) writeChunk(chunk []byte) error {
 			return fmt.Errorf("could not release object: %w", err)
 		}
 
+		if s.onCheckpoint != nil {
+			if err := s.updateParentTZ(); err != nil {
+				return err
+			}
+		}
+
 		// initialize another object
 		s.initialize()
+
+		if s.onCheckpoint != nil {
+			if err := s.saveCheckpoint(); err != nil {
+				return err
+			}
+		}
 	}
 
 	var (
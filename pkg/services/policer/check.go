@@ -81,7 +81,7 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 			prm.MarkAsGarbage(addrWithType.Address)
 			prm.WithForceRemoval()
 
-			_, err := p.jobQueue.localStorage.Inhume(prm)
+			_, err := p.jobQueue.localStorage.Inhume(ctx, prm)
 			if err != nil {
 				p.log.Error("could not inhume object with missing container",
 					zap.Stringer("cid", idCnr),
@@ -93,6 +93,10 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 		return
 	}
 
+	if addrWithType.Type == object.TypeRegular && p.processErasureCoded(ctx, addr, cnr.Value) {
+		return
+	}
+
 	policy := cnr.Value.PlacementPolicy()
 
 	nn, err := p.placementBuilder.BuildPlacement(idCnr, &idObj, policy)
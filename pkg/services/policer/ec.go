@@ -0,0 +1,266 @@
+package policer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+
+	clientcore "github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	netmapCore "github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object/erasurecode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	ecsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/ec"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/replicator"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// WithErasureCoding returns option to enable repairing of erasure coded
+// objects. Missing chunks are restored and signed with the given key.
+func WithErasureCoding(collector *ecsvc.Collector, key *ecdsa.PrivateKey) Option {
+	return func(c *cfg) {
+		c.ecCollector = collector
+		c.ecKey = key
+	}
+}
+
+// processErasureCoded checks the chunks of the erasure coded object the
+// local chunk belongs to and restores the missing ones. Returns false if
+// the object is not a chunk and must be processed as a replicated object.
+func (p *Policer) processErasureCoded(ctx context.Context, addr oid.Address, cnr containerSDK.Container) bool {
+	if p.ecCollector == nil {
+		return false
+	}
+
+	if _, ok, _ := erasurecode.ContainerPolicy(cnr); !ok {
+		return false
+	}
+
+	var headPrm engine.HeadPrm
+	headPrm.WithAddress(addr)
+	headPrm.WithRaw(true)
+
+	res, err := p.jobQueue.localStorage.Head(ctx, headPrm)
+	if err != nil {
+		p.log.Error("could not get object header to check erasure coding",
+			zap.Stringer("object", addr),
+			zap.String("error", err.Error()),
+		)
+
+		// do not replicate the object if it can be a chunk
+		return true
+	}
+
+	local := res.Header()
+
+	info, ok, err := erasurecode.ReadChunkInfo(local)
+	if !ok {
+		return false
+	} else if err != nil {
+		p.log.Error("invalid erasure coded chunk",
+			zap.Stringer("object", addr),
+			zap.String("error", err.Error()),
+		)
+
+		return true
+	}
+
+	idCnr := addr.Container()
+
+	if p.parentRemoved(ctx, idCnr, info.Parent) {
+		// the chunk was not listed in the tombstone, e.g. it was
+		// restored after the removal, and must not be repaired
+		p.log.Debug("erasure coded object is removed, dropping the chunk",
+			zap.Stringer("object", addr),
+			zap.Stringer("parent", info.Parent),
+		)
+
+		p.cbRedundantCopy(addr)
+
+		return true
+	}
+
+	nn, err := p.placementBuilder.BuildPlacement(idCnr, &info.Parent, cnr.PlacementPolicy())
+	if err != nil {
+		p.log.Error("could not build placement vector for object",
+			zap.Stringer("cid", idCnr),
+			zap.String("error", err.Error()),
+		)
+
+		return true
+	}
+
+	nodes, infos := distinctNodes(nn)
+
+	chunks := p.ecCollector.Locate(ctx, idCnr, info.Parent, infos)
+
+	var (
+		present = make(map[int]struct{}, info.Policy.Total())
+		holders = make(map[string]struct{}, len(chunks))
+		// repairer is the holder of the chunk with the lowest index
+		// with the least public key
+		repairer *ecsvc.Chunk
+	)
+
+	for i := range chunks {
+		if chunks[i].Info.Policy != info.Policy {
+			continue
+		}
+
+		present[chunks[i].Info.Index] = struct{}{}
+		holders[string(chunks[i].Node.PublicKey())] = struct{}{}
+
+		if repairer == nil || chunks[i].Info.Index < repairer.Info.Index ||
+			chunks[i].Info.Index == repairer.Info.Index &&
+				bytes.Compare(chunks[i].Node.PublicKey(), repairer.Node.PublicKey()) < 0 {
+			repairer = &chunks[i]
+		}
+	}
+
+	if len(present) == info.Policy.Total() {
+		return true
+	}
+
+	if repairer == nil || !repairer.Local {
+		// another node is responsible for repairing
+		return true
+	}
+
+	p.log.Debug("shortage of erasure coded chunks detected",
+		zap.Stringer("object", addr),
+		zap.Stringer("parent", info.Parent),
+		zap.Int("present", len(present)),
+		zap.Int("total", info.Policy.Total()),
+	)
+
+	if len(present) < info.Policy.DataChunks {
+		p.log.Error("erasure coded object can not be restored",
+			zap.Stringer("parent", info.Parent),
+			zap.Int("present", len(present)),
+			zap.Int("required", info.Policy.DataChunks),
+		)
+
+		return true
+	}
+
+	parent, err := p.ecCollector.Restore(ctx, info.Parent, chunks)
+	if err != nil {
+		p.log.Error("could not restore erasure coded object",
+			zap.Stringer("parent", info.Parent),
+			zap.String("error", err.Error()),
+		)
+
+		return true
+	}
+
+	missing := make([]int, 0, info.Policy.Total()-len(present))
+	for i := 0; i < info.Policy.Total(); i++ {
+		if _, ok := present[i]; !ok {
+			missing = append(missing, i)
+		}
+	}
+
+	restored, err := erasurecode.Encode(erasurecode.EncodePrm{
+		Object:  parent,
+		Policy:  info.Policy,
+		Key:     p.ecKey,
+		Epoch:   local.CreationEpoch(),
+		Indices: missing,
+	})
+	if err != nil {
+		p.log.Error("could not encode erasure coded object",
+			zap.Stringer("parent", info.Parent),
+			zap.String("error", err.Error()),
+		)
+
+		return true
+	}
+
+	// place the missing chunks on the nodes that do not store the chunks yet
+	candidates := make([]netmap.NodeInfo, 0, len(nodes))
+	for i := range nodes {
+		if _, ok := holders[string(nodes[i].PublicKey())]; !ok && !p.netmapKeys.IsLocalKey(nodes[i].PublicKey()) {
+			candidates = append(candidates, nodes[i])
+		}
+	}
+
+	checkedNodes := newNodeCache()
+
+	for _, chunk := range restored {
+		select {
+		case <-ctx.Done():
+			return true
+		default:
+		}
+
+		var task replicator.Task
+		task.SetObject(chunk)
+		task.SetObjectAddress(objectcore.AddressOf(chunk))
+		task.SetNodes(candidates)
+		task.SetCopiesNumber(1)
+
+		p.replicator.HandleTask(ctx, task, checkedNodes)
+
+		for i := 0; i < len(candidates); i++ {
+			if checkedNodes.processStatus(candidates[i]) == 0 {
+				candidates = append(candidates[:i], candidates[i+1:]...)
+				i--
+			}
+		}
+	}
+
+	return true
+}
+
+// parentRemoved checks if the original object of the chunk is removed
+// according to the tombstones stored locally.
+func (p *Policer) parentRemoved(ctx context.Context, cnr cid.ID, parent oid.ID) bool {
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(parent)
+
+	var headPrm engine.HeadPrm
+	headPrm.WithAddress(addr)
+	headPrm.WithRaw(true)
+
+	_, err := p.jobQueue.localStorage.Head(ctx, headPrm)
+
+	return errors.As(err, new(apistatus.ObjectAlreadyRemoved))
+}
+
+// distinctNodes flattens placement vectors and returns distinct nodes
+// in the placement order along with their client descriptors.
+func distinctNodes(nn [][]netmap.NodeInfo) ([]netmap.NodeInfo, []clientcore.NodeInfo) {
+	var (
+		nodes []netmap.NodeInfo
+		infos []clientcore.NodeInfo
+		seen  = make(map[string]struct{})
+	)
+
+	for i := range nn {
+		for j := range nn[i] {
+			key := string(nn[i][j].PublicKey())
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+
+			var info clientcore.NodeInfo
+			if err := clientcore.NodeInfoFromRawNetmapElement(&info, netmapCore.Node(nn[i][j])); err != nil {
+				continue
+			}
+
+			nodes = append(nodes, nn[i][j])
+			infos = append(infos, info)
+		}
+	}
+
+	return nodes, infos
+}
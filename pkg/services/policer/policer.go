@@ -1,12 +1,14 @@
 package policer
 
 import (
+	"crypto/ecdsa"
 	"sync"
 	"time"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/container"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	ecsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/ec"
 	headsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/head"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/placement"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/replicator"
@@ -82,6 +84,10 @@ type cfg struct {
 
 	replicator *replicator.Replicator
 
+	ecCollector *ecsvc.Collector
+
+	ecKey *ecdsa.PrivateKey
+
 	cbRedundantCopy RedundantCopyCallback
 
 	taskPool *ants.Pool
@@ -7,6 +7,7 @@ import (
 
 	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"go.uber.org/zap"
 )
 
@@ -16,7 +17,7 @@ func (p *Policer) Run(ctx context.Context) {
 	}()
 
 	go p.poolCapacityWorker(ctx)
-	p.shardPolicyWorker(ctx)
+	p.shardPolicyWorker(shard.ContextWithIOClass(ctx, shard.IOClassReplication))
 }
 
 func (p *Policer) shardPolicyWorker(ctx context.Context) {
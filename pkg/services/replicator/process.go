@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
 	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
 	"go.uber.org/zap"
@@ -26,8 +27,11 @@ func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult)
 	}()
 
 	if task.obj == nil {
-		var err error
-		task.obj, err = engine.Get(p.localStorage, task.addr)
+		var getPrm engine.GetPrm
+		getPrm.WithAddress(task.addr)
+
+		res, err := p.localStorage.Get(shard.ContextWithIOClass(ctx, shard.IOClassReplication), getPrm)
+		task.obj = res.Object()
 		if err != nil {
 			p.log.Error("could not get object from local storage",
 				zap.Stringer("object", task.addr),
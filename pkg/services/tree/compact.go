@@ -0,0 +1,217 @@
+package tree
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	netmapSDK "github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	"go.uber.org/zap"
+)
+
+const defaultLogRetention = 72 * time.Hour
+
+// logCheckpoint is the synchronized height of the tree at some moment.
+type logCheckpoint struct {
+	at     time.Time
+	height uint64
+}
+
+// logAcks stores the heights the container nodes have synchronized the trees up to.
+type logAcks struct {
+	mtx sync.Mutex
+	// container -> tree -> node public key -> height
+	m map[cidSDK.ID]map[string]map[string]uint64
+}
+
+func (a *logAcks) init() {
+	a.m = make(map[cidSDK.ID]map[string]map[string]uint64)
+}
+
+// record stores the height acknowledged in the request if it is signed by
+// a container node other than the local one.
+func (a *logAcks) record(cnr cidSDK.ID, treeID string, nodes []netmapSDK.NodeInfo, localPos int, req *GetOpLogRequest) {
+	if err := verifyMessage(req); err != nil {
+		return
+	}
+
+	key := req.GetSignature().GetKey()
+	for i := range nodes {
+		if i == localPos || !bytes.Equal(nodes[i].PublicKey(), key) {
+			continue
+		}
+
+		a.mtx.Lock()
+		trees, ok := a.m[cnr]
+		if !ok {
+			trees = make(map[string]map[string]uint64)
+			a.m[cnr] = trees
+		}
+		acks, ok := trees[treeID]
+		if !ok {
+			acks = make(map[string]uint64)
+			trees[treeID] = acks
+		}
+		acks[string(key)] = req.GetBody().GetSyncedHeight()
+		a.mtx.Unlock()
+		return
+	}
+}
+
+// height returns the minimum of the local height and the heights acknowledged
+// by the other container nodes. Zero is returned if some node has not
+// acknowledged the height yet.
+func (a *logAcks) height(cnr cidSDK.ID, treeID string, nodes []netmapSDK.NodeInfo, localPos int, local uint64) uint64 {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	acks := a.m[cnr][treeID]
+
+	height := local
+	for i := range nodes {
+		if i == localPos {
+			continue
+		}
+
+		h, ok := acks[string(nodes[i].PublicKey())]
+		if !ok {
+			return 0
+		}
+		if h < height {
+			height = h
+		}
+	}
+	return height
+}
+
+// prune removes the acknowledgements for the containers which are not synchronized anymore.
+func (a *logAcks) prune(synced map[cidSDK.ID]map[string]uint64) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	for cnr := range a.m {
+		if _, ok := synced[cnr]; !ok {
+			delete(a.m, cnr)
+		}
+	}
+}
+
+// compactLoop periodically removes the operations which were synchronized
+// by all the container nodes more than the retention period ago. The nodes
+// report the synchronized height when they fetch the log, the log is not
+// compacted until every container node has reported it. The nodes which
+// have not been in the container are able to restore the tree from a snapshot.
+func (s *Service) compactLoop(ctx context.Context) {
+	tick := time.NewTicker(s.logCompactionInterval)
+	defer tick.Stop()
+
+	checkpoints := make(map[cidSDK.ID]map[string][]logCheckpoint)
+	for {
+		select {
+		case <-s.closeCh:
+			return
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+			s.compactLogs(checkpoints, time.Now())
+		}
+	}
+}
+
+func (s *Service) compactLogs(checkpoints map[cidSDK.ID]map[string][]logCheckpoint, now time.Time) {
+	s.cnrMapMtx.Lock()
+	synced := make(map[cidSDK.ID]map[string]uint64, len(s.cnrMap))
+	for cnr, trees := range s.cnrMap {
+		// inner maps are never modified in-place
+		synced[cnr] = trees
+	}
+	s.cnrMapMtx.Unlock()
+
+	s.acks.prune(synced)
+
+	acked := make(map[cidSDK.ID]map[string]uint64, len(synced))
+	for cnr, trees := range synced {
+		nodes, pos, err := s.getContainerNodes(cnr)
+		if err != nil {
+			s.log.Debug("could not get container nodes to compact tree operation log",
+				zap.Stringer("cid", cnr),
+				zap.Error(err))
+			continue
+		} else if pos < 0 {
+			continue
+		}
+
+		heights := make(map[string]uint64, len(trees))
+		for treeID, height := range trees {
+			heights[treeID] = s.acks.height(cnr, treeID, nodes, pos, height)
+		}
+		acked[cnr] = heights
+	}
+
+	s.compactAcked(checkpoints, acked, now)
+}
+
+// compactAcked compacts the logs up to the latest height acknowledged
+// by all the container nodes more than the retention period ago.
+func (s *Service) compactAcked(checkpoints map[cidSDK.ID]map[string][]logCheckpoint, acked map[cidSDK.ID]map[string]uint64, now time.Time) {
+	for cnr := range checkpoints {
+		if _, ok := acked[cnr]; !ok {
+			delete(checkpoints, cnr)
+		}
+	}
+
+	for cnr, trees := range acked {
+		cps, ok := checkpoints[cnr]
+		if !ok {
+			cps = make(map[string][]logCheckpoint, len(trees))
+			checkpoints[cnr] = cps
+		}
+		for treeID := range cps {
+			if _, ok := trees[treeID]; !ok {
+				delete(cps, treeID)
+			}
+		}
+
+		for treeID, height := range trees {
+			list := append(cps[treeID], logCheckpoint{at: now, height: height})
+
+			// find the latest checkpoint older than the retention period
+			n := 0
+			for n < len(list) && now.Sub(list[n].at) >= s.logRetention {
+				n++
+			}
+			if n != 0 {
+				s.compactLog(cnr, treeID, list[n-1].height)
+				list = list[n:]
+			}
+			cps[treeID] = list
+		}
+	}
+}
+
+func (s *Service) compactLog(cnr cidSDK.ID, treeID string, height uint64) {
+	if height == 0 {
+		return
+	}
+
+	err := s.forest.TreeCompactLog(cnr, treeID, height)
+	if errors.Is(err, pilorama.ErrTreeNotFound) {
+		return
+	} else if err != nil {
+		s.log.Error("could not compact tree operation log",
+			zap.Stringer("cid", cnr),
+			zap.String("tree", treeID),
+			zap.Uint64("height", height),
+			zap.Error(err))
+		return
+	}
+
+	s.log.Debug("tree operation log has been compacted",
+		zap.Stringer("cid", cnr),
+		zap.String("tree", treeID),
+		zap.Uint64("height", height))
+}
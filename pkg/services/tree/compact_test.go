@@ -0,0 +1,112 @@
+package tree
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	netmapSDK "github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCompactLogs(t *testing.T) {
+	d := pilorama.CIDDescriptor{CID: cidtest.ID(), Size: 1}
+	treeID := "sometree"
+	f := pilorama.NewMemoryForest()
+
+	for i := 0; i < 10; i++ {
+		_, err := f.TreeMove(d, treeID, &pilorama.Move{Parent: pilorama.RootID, Child: pilorama.RootID})
+		require.NoError(t, err)
+	}
+
+	s := &Service{
+		cfg: cfg{
+			log:          &logger.Logger{Logger: zap.NewNop()},
+			forest:       f,
+			logRetention: time.Hour,
+		},
+	}
+
+	horizon := func(t *testing.T) uint64 {
+		h, err := f.TreeLogHorizon(d.CID, treeID)
+		require.NoError(t, err)
+		return h
+	}
+
+	checkpoints := make(map[cidSDK.ID]map[string][]logCheckpoint)
+	now := time.Now()
+
+	s.compactAcked(checkpoints, map[cidSDK.ID]map[string]uint64{d.CID: {treeID: 4}}, now)
+	require.Equal(t, uint64(0), horizon(t))
+
+	acked := map[cidSDK.ID]map[string]uint64{d.CID: {treeID: 8}}
+	s.compactAcked(checkpoints, acked, now.Add(30*time.Minute))
+	require.Equal(t, uint64(0), horizon(t), "retention period has not passed")
+
+	s.compactAcked(checkpoints, acked, now.Add(time.Hour+31*time.Minute))
+	require.Equal(t, uint64(8), horizon(t), "the latest checkpoint older than retention must be used")
+	require.Len(t, checkpoints[d.CID][treeID], 1)
+
+	s.compactAcked(checkpoints, nil, now.Add(2*time.Hour))
+	require.Empty(t, checkpoints)
+}
+
+func TestLogAcks(t *testing.T) {
+	cnr := cidtest.ID()
+	treeID := "sometree"
+
+	privs := make([]*keys.PrivateKey, 3)
+	nodes := make([]netmapSDK.NodeInfo, len(privs))
+	for i := range privs {
+		p, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+
+		privs[i] = p
+		nodes[i].SetPublicKey(p.PublicKey().Bytes())
+	}
+
+	outsider, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	const localPos = 0
+
+	var acks logAcks
+	acks.init()
+
+	ack := func(t *testing.T, key *keys.PrivateKey, height uint64) {
+		rawCID := make([]byte, sha256.Size)
+		cnr.Encode(rawCID)
+
+		req := &GetOpLogRequest{
+			Body: &GetOpLogRequest_Body{
+				ContainerId:  rawCID,
+				TreeId:       treeID,
+				SyncedHeight: height,
+			},
+		}
+		require.NoError(t, SignMessage(req, &key.PrivateKey))
+
+		acks.record(cnr, treeID, nodes, localPos, req)
+	}
+
+	ack(t, privs[1], 5)
+	require.Equal(t, uint64(0), acks.height(cnr, treeID, nodes, localPos, 10),
+		"all the container nodes must acknowledge the height")
+
+	ack(t, outsider, 1)
+	ack(t, privs[2], 7)
+	require.Equal(t, uint64(5), acks.height(cnr, treeID, nodes, localPos, 10))
+	require.Equal(t, uint64(3), acks.height(cnr, treeID, nodes, localPos, 3))
+
+	ack(t, privs[1], 9)
+	require.Equal(t, uint64(7), acks.height(cnr, treeID, nodes, localPos, 10))
+
+	acks.prune(nil)
+	require.Equal(t, uint64(0), acks.height(cnr, treeID, nodes, localPos, 10))
+}
@@ -139,3 +139,76 @@ func (s *subTreeAcc) Send(r *GetSubTreeResponse) error {
 	}
 	return nil
 }
+
+func TestGetSubTreeSortedPages(t *testing.T) {
+	d := pilorama.CIDDescriptor{CID: cidtest.ID(), Size: 1}
+	treeID := "sometree"
+	p := pilorama.NewMemoryForest()
+
+	names := []string{"b", "dir/c", "a", "dir/a", "dir/b", "c"}
+	ids := make(map[string]uint64, len(names))
+	for _, name := range names {
+		meta := []pilorama.KeyValue{{Key: pilorama.AttributeFilename, Value: []byte(name)}}
+
+		lm, err := p.TreeAddByPath(d, treeID, pilorama.AttributeFilename, nil, meta)
+		require.NoError(t, err)
+
+		ids[name] = lm[0].Child
+	}
+
+	list := func(t *testing.T, b *GetSubTreeRequest_Body) ([]uint64, []byte) {
+		acc := subTreeAcc{errIndex: -1}
+		require.NoError(t, getSubTree(&acc, d.CID, b, p))
+
+		var (
+			res   []uint64
+			token []byte
+		)
+		for i := range acc.seen {
+			res = append(res, acc.seen[i].Body.NodeId)
+			if i != len(acc.seen)-1 {
+				require.Nil(t, acc.seen[i].Body.ContinuationToken)
+			} else {
+				token = acc.seen[i].Body.ContinuationToken
+			}
+		}
+		return res, token
+	}
+
+	t.Run("sorted", func(t *testing.T) {
+		actual, token := list(t, &GetSubTreeRequest_Body{
+			TreeId: treeID,
+			Depth:  2,
+			SortBy: pilorama.AttributeFilename,
+		})
+		require.Equal(t, []uint64{0, ids["a"], ids["b"], ids["c"], ids["dir/a"], ids["dir/b"], ids["dir/c"]}, actual)
+		require.Nil(t, token)
+	})
+	t.Run("pages with prefix", func(t *testing.T) {
+		b := &GetSubTreeRequest_Body{
+			TreeId: treeID,
+			Depth:  2,
+			SortBy: pilorama.AttributeFilename,
+			Prefix: "dir/",
+			Limit:  2,
+		}
+
+		actual, token := list(t, b)
+		require.Equal(t, []uint64{0, ids["dir/a"], ids["dir/b"]}, actual)
+		require.NotNil(t, token)
+
+		b.ContinuationToken = token
+		actual, token = list(t, b)
+		require.Equal(t, []uint64{ids["dir/c"]}, actual)
+		require.Nil(t, token)
+	})
+	t.Run("start after", func(t *testing.T) {
+		actual, _ := list(t, &GetSubTreeRequest_Body{
+			TreeId:     treeID,
+			Depth:      2,
+			SortBy:     pilorama.AttributeFilename,
+			StartAfter: "c",
+		})
+		require.Equal(t, []uint64{0, ids["dir/a"], ids["dir/b"], ids["dir/c"]}, actual)
+	})
+}
@@ -33,6 +33,9 @@ type cfg struct {
 	replicatorWorkerCount     int
 	replicatorTimeout         time.Duration
 	containerCacheSize        int
+	// log compaction parameters
+	logCompactionInterval time.Duration
+	logRetention          time.Duration
 }
 
 // Option represents configuration option for a tree service.
@@ -116,3 +119,16 @@ func WithReplicationTimeout(t time.Duration) Option {
 		}
 	}
 }
+
+// WithLogCompaction enables periodic compaction of the operation log.
+// Operations are removed if they have been synchronized from all
+// the container nodes at least retention time ago. Zero interval
+// disables compaction, zero retention means the default one.
+func WithLogCompaction(interval, retention time.Duration) Option {
+	return func(c *cfg) {
+		c.logCompactionInterval = interval
+		if retention > 0 {
+			c.logRetention = retention
+		}
+	}
+}
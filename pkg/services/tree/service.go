@@ -14,6 +14,8 @@ import (
 	netmapSDK "github.com/TrueCloudLab/frostfs-sdk-go/netmap"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Service represents tree-service capable of working with multiple
@@ -37,6 +39,9 @@ type Service struct {
 	cnrMap map[cidSDK.ID]map[string]uint64
 	// cnrMapMtx protects cnrMap
 	cnrMapMtx sync.Mutex
+
+	// acks stores the heights the other container nodes have synchronized the trees up to.
+	acks logAcks
 }
 
 var _ TreeServiceServer = (*Service)(nil)
@@ -48,6 +53,7 @@ func New(opts ...Option) *Service {
 	s.replicatorChannelCapacity = defaultReplicatorCapacity
 	s.replicatorWorkerCount = defaultReplicatorWorkerCount
 	s.replicatorTimeout = defaultReplicatorSendTimeout
+	s.logRetention = defaultLogRetention
 
 	for i := range opts {
 		opts[i](&s.cfg)
@@ -64,6 +70,7 @@ func New(opts ...Option) *Service {
 	s.replicationTasks = make(chan replicationTask, s.replicatorWorkerCount)
 	s.containerCache.init(s.containerCacheSize)
 	s.cnrMap = make(map[cidSDK.ID]map[string]uint64)
+	s.acks.init()
 	s.syncChan = make(chan struct{})
 	s.syncPool, _ = ants.NewPool(defaultSyncWorkerCount)
 
@@ -74,6 +81,9 @@ func New(opts ...Option) *Service {
 func (s *Service) Start(ctx context.Context) {
 	go s.replicateLoop(ctx)
 	go s.syncLoop(ctx)
+	if s.logCompactionInterval > 0 {
+		go s.compactLoop(ctx)
+	}
 
 	select {
 	case <-s.closeCh:
@@ -412,10 +422,50 @@ func (s *Service) GetSubTree(req *GetSubTreeRequest, srv TreeService_GetSubTreeS
 }
 
 func getSubTree(srv TreeService_GetSubTreeServer, cid cidSDK.ID, b *GetSubTreeRequest_Body, forest pilorama.Forest) error {
+	// Only the children of the root node are filtered and paginated,
+	// the children of other nodes are just sorted.
+	prm := pilorama.ListChildrenPrm{SortBy: b.GetSortBy()}
+	rootPrm := pilorama.ListChildrenPrm{
+		SortBy:     b.GetSortBy(),
+		Prefix:     b.GetPrefix(),
+		StartAfter: b.GetStartAfter(),
+		Cursor:     b.GetContinuationToken(),
+		Limit:      int(b.GetLimit()),
+	}
+
+	// The continuation token is attached to the last message,
+	// so every message is sent when the next one is ready.
+	var pending *GetSubTreeResponse
+	send := func(resp *GetSubTreeResponse) error {
+		var err error
+		if pending != nil {
+			err = srv.Send(pending)
+		}
+		pending = resp
+		return err
+	}
+
 	// Traverse the tree in a DFS manner. Because we need to support arbitrary depth,
 	// recursive implementation is not suitable here, so we maintain explicit stack.
 	stack := [][]uint64{{b.GetRootId()}}
 
+	var next []byte
+
+	if len(rootPrm.Cursor) != 0 {
+		// the root node has already been returned with the first page
+		if b.GetDepth() == 1 {
+			return nil
+		}
+
+		children, nextCursor, err := listChildren(forest, cid, b.GetTreeId(), b.GetRootId(), rootPrm)
+		if err != nil {
+			return err
+		}
+
+		next = nextCursor
+		stack = [][]uint64{nil, children}
+	}
+
 	for {
 		if len(stack) == 0 {
 			break
@@ -431,7 +481,7 @@ func getSubTree(srv TreeService_GetSubTreeServer, cid cidSDK.ID, b *GetSubTreeRe
 		if err != nil {
 			return err
 		}
-		err = srv.Send(&GetSubTreeResponse{
+		err = send(&GetSubTreeResponse{
 			Body: &GetSubTreeResponse_Body{
 				NodeId:    nodeID,
 				ParentId:  p,
@@ -444,7 +494,13 @@ func getSubTree(srv TreeService_GetSubTreeServer, cid cidSDK.ID, b *GetSubTreeRe
 		}
 
 		if b.GetDepth() == 0 || uint32(len(stack)) < b.GetDepth() {
-			children, err := forest.TreeGetChildren(cid, b.GetTreeId(), nodeID)
+			var children []uint64
+
+			if len(stack) == 1 {
+				children, next, err = listChildren(forest, cid, b.GetTreeId(), nodeID, rootPrm)
+			} else {
+				children, _, err = listChildren(forest, cid, b.GetTreeId(), nodeID, prm)
+			}
 			if err != nil {
 				return err
 			}
@@ -453,7 +509,21 @@ func getSubTree(srv TreeService_GetSubTreeServer, cid cidSDK.ID, b *GetSubTreeRe
 			}
 		}
 	}
-	return nil
+
+	if pending == nil {
+		return nil
+	}
+
+	pending.Body.ContinuationToken = next
+	return srv.Send(pending)
+}
+
+func listChildren(forest pilorama.Forest, cid cidSDK.ID, treeID string, nodeID uint64, prm pilorama.ListChildrenPrm) ([]uint64, []byte, error) {
+	if prm.SortBy == "" && prm.Prefix == "" && prm.StartAfter == "" && len(prm.Cursor) == 0 && prm.Limit == 0 {
+		children, err := forest.TreeGetChildren(cid, treeID, nodeID)
+		return children, nil, err
+	}
+	return forest.TreeListChildren(cid, treeID, nodeID, prm)
 }
 
 // Apply locally applies operation from the remote node to the tree.
@@ -532,9 +602,14 @@ func (s *Service) GetOpLog(req *GetOpLogRequest, srv TreeService_GetOpLogServer)
 		return nil
 	}
 
+	s.acks.record(cid, b.GetTreeId(), ns, pos, req)
+
 	h := b.GetHeight()
 	for {
 		lm, err := s.forest.TreeGetOpLog(cid, b.GetTreeId(), h)
+		if errors.Is(err, pilorama.ErrLogCompacted) {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
 		if err != nil || lm.Time == 0 {
 			return err
 		}
@@ -556,6 +631,55 @@ func (s *Service) GetOpLog(req *GetOpLogRequest, srv TreeService_GetOpLogServer)
 	}
 }
 
+func (s *Service) GetSnapshot(req *GetSnapshotRequest, srv TreeService_GetSnapshotServer) error {
+	b := req.GetBody()
+
+	var cid cidSDK.ID
+	if err := cid.Decode(b.GetContainerId()); err != nil {
+		return err
+	}
+
+	ns, pos, err := s.getContainerNodes(cid)
+	if err != nil {
+		return err
+	}
+	if pos < 0 {
+		var cli TreeService_GetSnapshotClient
+		var outErr error
+		err := s.forEachNode(srv.Context(), ns, func(c TreeServiceClient) bool {
+			cli, outErr = c.GetSnapshot(srv.Context(), req)
+			return true
+		})
+		if err != nil {
+			return err
+		} else if outErr != nil {
+			return outErr
+		}
+		for resp, err := cli.Recv(); err == nil; resp, err = cli.Recv() {
+			if err := srv.Send(resp); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return s.forest.TreeSnapshot(cid, b.GetTreeId(), func(height uint64, nodes []pilorama.SnapshotNode) error {
+		body := &GetSnapshotResponse_Body{
+			Height: height,
+			Nodes:  make([]*SnapshotNode, len(nodes)),
+		}
+		for i := range nodes {
+			body.Nodes[i] = &SnapshotNode{
+				NodeId:    nodes[i].ID,
+				ParentId:  nodes[i].Parent,
+				Timestamp: nodes[i].Timestamp,
+				Meta:      nodes[i].Meta.Bytes(),
+			}
+		}
+		return srv.Send(&GetSnapshotResponse{Body: body})
+	})
+}
+
 func (s *Service) TreeList(ctx context.Context, req *TreeListRequest) (*TreeListResponse, error) {
 	var cid cidSDK.ID
 
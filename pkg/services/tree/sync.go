@@ -18,7 +18,9 @@ import (
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 // ErrNotInContainer is returned when operation could not be performed
@@ -141,11 +143,39 @@ func (s *Service) SynchronizeTree(ctx context.Context, cid cid.ID, treeID string
 
 func (s *Service) synchronizeTree(ctx context.Context, d pilorama.CIDDescriptor, from uint64,
 	treeID string, nodes []netmapSDK.NodeInfo) uint64 {
+	// the height is persisted, so the synchronization is continued after the restart
+	synced, err := s.forest.TreeLastSyncHeight(d.CID, treeID)
+	if err == nil && from < synced {
+		from = synced
+	}
+
+	horizon, err := s.forest.TreeLogHorizon(d.CID, treeID)
+	if err == nil && from < horizon {
+		// operations below the horizon are ignored anyway
+		from = horizon
+	} else if errors.Is(err, pilorama.ErrTreeRestoring) {
+		s.log.Warn("removing partially restored tree",
+			zap.Stringer("cid", d.CID),
+			zap.String("tree", treeID))
+
+		if err := s.forest.TreeDrop(d.CID, treeID); err != nil {
+			s.log.Error("could not remove partially restored tree",
+				zap.Stringer("cid", d.CID),
+				zap.String("tree", treeID),
+				zap.Error(err))
+			return from
+		}
+	}
+
 	s.log.Debug("synchronize tree",
 		zap.Stringer("cid", d.CID),
 		zap.String("tree", treeID),
 		zap.Uint64("from", from))
 
+	// the height the tree is synchronized up to from all the nodes,
+	// it is reported to the other nodes as an acknowledgement
+	acked := from
+
 	newHeight := uint64(math.MaxUint64)
 	for _, n := range nodes {
 		height := from
@@ -164,7 +194,24 @@ func (s *Service) synchronizeTree(ctx context.Context, d pilorama.CIDDescriptor,
 
 			treeClient := NewTreeServiceClient(cc)
 			for {
-				h, err := s.synchronizeSingle(ctx, d, treeID, height, treeClient)
+				h, err := s.synchronizeSingle(ctx, d, treeID, height, acked, treeClient)
+				if status.Code(err) == codes.FailedPrecondition {
+					// The operation log is compacted on the remote node.
+					h, err = s.restoreTree(ctx, d, treeID, treeClient)
+					if err != nil {
+						s.log.Warn("could not restore tree from snapshot",
+							zap.Stringer("cid", d.CID),
+							zap.String("tree", treeID),
+							zap.String("address", addr),
+							zap.Error(err))
+						return true
+					}
+					if from < h {
+						from = h
+					}
+					height = h
+					continue
+				}
 				if height < h {
 					height = h
 				}
@@ -183,10 +230,21 @@ func (s *Service) synchronizeTree(ctx context.Context, d pilorama.CIDDescriptor,
 	if newHeight == math.MaxUint64 {
 		newHeight = from
 	}
+
+	if synced < newHeight {
+		err := s.forest.TreeUpdateLastSyncHeight(d.CID, treeID, newHeight)
+		if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+			s.log.Warn("could not update tree synchronization height",
+				zap.Stringer("cid", d.CID),
+				zap.String("tree", treeID),
+				zap.Uint64("height", newHeight),
+				zap.Error(err))
+		}
+	}
 	return newHeight
 }
 
-func (s *Service) synchronizeSingle(ctx context.Context, d pilorama.CIDDescriptor, treeID string, height uint64, treeClient TreeServiceClient) (uint64, error) {
+func (s *Service) synchronizeSingle(ctx context.Context, d pilorama.CIDDescriptor, treeID string, height, acked uint64, treeClient TreeServiceClient) (uint64, error) {
 	rawCID := make([]byte, sha256.Size)
 	d.CID.Encode(rawCID)
 
@@ -194,9 +252,10 @@ func (s *Service) synchronizeSingle(ctx context.Context, d pilorama.CIDDescripto
 		newHeight := height
 		req := &GetOpLogRequest{
 			Body: &GetOpLogRequest_Body{
-				ContainerId: rawCID,
-				TreeId:      treeID,
-				Height:      newHeight,
+				ContainerId:  rawCID,
+				TreeId:       treeID,
+				Height:       newHeight,
+				SyncedHeight: acked,
 			},
 		}
 		if err := SignMessage(req, s.key); err != nil {
@@ -234,6 +293,110 @@ func (s *Service) synchronizeSingle(ctx context.Context, d pilorama.CIDDescripto
 	}
 }
 
+// restoreTree creates the tree from the snapshot fetched from the remote node.
+// The local tree, if any, is replaced. The log is compacted only below the height
+// acknowledged by all the container nodes, so the local node lagging behind the
+// horizon has not been in the container and has no operations below the snapshot
+// height unknown to the other nodes. The local operations above the snapshot
+// height are applied again after the restoration. Returns the height the log
+// synchronization must be continued from.
+func (s *Service) restoreTree(ctx context.Context, d pilorama.CIDDescriptor, treeID string, treeClient TreeServiceClient) (uint64, error) {
+	exists, err := s.forest.TreeExists(d.CID, treeID)
+	if err != nil {
+		return 0, err
+	}
+
+	rawCID := make([]byte, sha256.Size)
+	d.CID.Encode(rawCID)
+
+	req := &GetSnapshotRequest{
+		Body: &GetSnapshotRequest_Body{
+			ContainerId: rawCID,
+			TreeId:      treeID,
+		},
+	}
+	if err := SignMessage(req, s.key); err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	c, err := treeClient.GetSnapshot(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("can't initialize client: %w", err)
+	}
+
+	res, err := c.Recv()
+	if err != nil {
+		return 0, err
+	}
+
+	height := res.GetBody().GetHeight()
+
+	var local []pilorama.Move
+	if exists {
+		local, err = s.localOperations(d, treeID, height)
+		if err != nil {
+			return 0, fmt.Errorf("could not read local operations: %w", err)
+		}
+	}
+
+	s.log.Info("restoring tree from snapshot",
+		zap.Stringer("cid", d.CID),
+		zap.String("tree", treeID),
+		zap.Uint64("height", height),
+		zap.Bool("replace", exists),
+		zap.Int("local operations", len(local)))
+
+	err = s.forest.TreeRestore(d, treeID, height, func() ([]pilorama.SnapshotNode, error) {
+		if res == nil {
+			var err error
+			if res, err = c.Recv(); err != nil {
+				return nil, err
+			}
+		}
+
+		nodes := make([]pilorama.SnapshotNode, len(res.GetBody().GetNodes()))
+		for i, n := range res.GetBody().GetNodes() {
+			nodes[i] = pilorama.SnapshotNode{
+				ID:        n.GetNodeId(),
+				Parent:    n.GetParentId(),
+				Timestamp: n.GetTimestamp(),
+			}
+			if err := nodes[i].Meta.FromBytes(n.GetMeta()); err != nil {
+				return nil, err
+			}
+		}
+		res = nil
+		return nodes, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range local {
+		if err := s.forest.TreeApply(d, treeID, &local[i], false); err != nil {
+			return 0, fmt.Errorf("could not apply local operation: %w", err)
+		}
+	}
+	return height, nil
+}
+
+// localOperations returns the local log operations starting from the height.
+func (s *Service) localOperations(d pilorama.CIDDescriptor, treeID string, height uint64) ([]pilorama.Move, error) {
+	var res []pilorama.Move
+	for {
+		lm, err := s.forest.TreeGetOpLog(d.CID, treeID, height)
+		if err != nil || lm.Time == 0 {
+			return res, err
+		}
+
+		res = append(res, lm)
+		height = lm.Time + 1
+	}
+}
+
 // ErrAlreadySyncing is returned when a service synchronization has already
 // been started.
 var ErrAlreadySyncing = errors.New("service is being synchronized")
@@ -0,0 +1,40 @@
+package tracing
+
+// Exporter is a type of the exporter the collected spans are sent to.
+type Exporter string
+
+const (
+	// ExporterOTLPGRPC sends spans to the OpenTelemetry collector via OTLP over gRPC.
+	ExporterOTLPGRPC Exporter = "otlp_grpc"
+
+	// ExporterStdout writes spans to the standard output.
+	ExporterStdout Exporter = "stdout"
+
+	// ExporterFile writes spans to the file.
+	ExporterFile Exporter = "file"
+)
+
+// Config groups tracing parameters.
+type Config struct {
+	// Enabled is true if spans must be collected and exported.
+	Enabled bool
+
+	// Exporter is the type of the span exporter.
+	Exporter Exporter
+
+	// Endpoint is the address of the OTLP collector for ExporterOTLPGRPC
+	// or the path to the output file for ExporterFile.
+	Endpoint string
+
+	// Insecure disables TLS for the connection to the OTLP collector.
+	Insecure bool
+
+	// Service is the name of the service the spans are reported for.
+	Service string
+
+	// InstanceID identifies the service instance, e.g. a public key of the node.
+	InstanceID string
+
+	// Version is the version of the service.
+	Version string
+}
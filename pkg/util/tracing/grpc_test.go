@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestPropagation(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)))
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	t.Run("no span", func(t *testing.T) {
+		ctx := InjectToOutgoingContext(context.Background())
+		_, ok := metadata.FromOutgoingContext(ctx)
+		require.False(t, ok)
+	})
+
+	ctx, span := StartSpanFromContext(context.Background(), "client")
+	defer span.End()
+
+	out := InjectToOutgoingContext(ctx)
+	md, ok := metadata.FromOutgoingContext(out)
+	require.True(t, ok)
+
+	// Emulate the transport passing outgoing metadata to the server.
+	in := metadata.NewIncomingContext(context.Background(), md)
+
+	var serverSpan trace.SpanContext
+	interceptor := NewUnaryServerInterceptor()
+	_, err := interceptor(in, nil, &grpc.UnaryServerInfo{FullMethod: "/test/Method"},
+		func(ctx context.Context, _ interface{}) (interface{}, error) {
+			serverSpan = trace.SpanContextFromContext(ctx)
+			return nil, nil
+		})
+	require.NoError(t, err)
+
+	require.Equal(t, span.SpanContext().TraceID(), serverSpan.TraceID())
+	require.NotEqual(t, span.SpanContext().SpanID(), serverSpan.SpanID())
+
+	ended := rec.Ended()
+	require.Len(t, ended, 1)
+	require.Equal(t, "/test/Method", ended[0].Name())
+	require.Equal(t, span.SpanContext().SpanID(), ended[0].Parent().SpanID())
+}
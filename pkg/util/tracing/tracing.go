@@ -0,0 +1,158 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer used for all spans of the node.
+const instrumentationName = "github.com/TrueCloudLab/frostfs-node"
+
+// propagator is used to pass span context between the nodes.
+var propagator propagation.TextMapPropagator = propagation.TraceContext{}
+
+var (
+	providerMtx sync.Mutex
+	provider    *sdktrace.TracerProvider
+	closer      io.Closer
+	applied     *Config
+)
+
+// Setup configures the global tracer provider according to the config.
+// The provider set by the previous call is shut down unless the config
+// has not changed. Tracing is disabled if cfg.Enabled is false.
+func Setup(ctx context.Context, cfg Config) error {
+	providerMtx.Lock()
+	defer providerMtx.Unlock()
+
+	if applied != nil && *applied == cfg {
+		return nil
+	}
+
+	if err := shutdown(ctx); err != nil {
+		return err
+	}
+
+	if !cfg.Enabled {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		applied = &cfg
+		return nil
+	}
+
+	exp, c, err := newExporter(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceNameKey.String(cfg.Service),
+		semconv.ServiceInstanceIDKey.String(cfg.InstanceID),
+		semconv.ServiceVersionKey.String(cfg.Version),
+	)
+
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(res),
+	)
+	closer = c
+	applied = &cfg
+
+	otel.SetTracerProvider(provider)
+
+	return nil
+}
+
+// Shutdown flushes the collected spans and stops the tracer provider.
+func Shutdown(ctx context.Context) error {
+	providerMtx.Lock()
+	defer providerMtx.Unlock()
+
+	return shutdown(ctx)
+}
+
+func shutdown(ctx context.Context) error {
+	applied = nil
+
+	if provider == nil {
+		return nil
+	}
+
+	err := provider.Shutdown(ctx)
+	if closer != nil {
+		if cErr := closer.Close(); err == nil {
+			err = cErr
+		}
+	}
+
+	provider = nil
+	closer = nil
+
+	if err != nil {
+		return fmt.Errorf("could not shutdown tracer provider: %w", err)
+	}
+	return nil
+}
+
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, io.Closer, error) {
+	switch cfg.Exporter {
+	case ExporterOTLPGRPC:
+		if cfg.Endpoint == "" {
+			return nil, nil, errors.New("OTLP collector endpoint is not set")
+		}
+
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+
+		exp, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not create OTLP exporter: %w", err)
+		}
+		return exp, nil, nil
+	case ExporterStdout:
+		exp, err := stdouttrace.New()
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not create stdout exporter: %w", err)
+		}
+		return exp, nil, nil
+	case ExporterFile:
+		if cfg.Endpoint == "" {
+			return nil, nil, errors.New("trace file path is not set")
+		}
+
+		f, err := os.OpenFile(cfg.Endpoint, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o640)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not open trace file: %w", err)
+		}
+
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, nil, fmt.Errorf("could not create file exporter: %w", err)
+		}
+		return exp, f, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown trace exporter: %q", cfg.Exporter)
+	}
+}
+
+// StartSpanFromContext starts a new span with the given name. The span is
+// a child of the span stored in ctx, if any. The returned context contains
+// the new span and must be passed to the nested calls.
+func StartSpanFromContext(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}